@@ -138,6 +138,24 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["node"] = nodeGroup
 
+	relayerFacade, ok := ws.facade.(shared.RelayerFacadeHandler)
+	if ok {
+		relayerGroup, errCreate := groups.NewRelayerGroup(relayerFacade)
+		if errCreate != nil {
+			return errCreate
+		}
+		groupsMap["relayer"] = relayerGroup
+	}
+
+	executorFacade, ok := ws.facade.(shared.ScCallsExecutorFacadeHandler)
+	if ok {
+		executorGroup, errCreate := groups.NewExecutorGroup(executorFacade)
+		if errCreate != nil {
+			return errCreate
+		}
+		groupsMap["executor"] = executorGroup
+	}
+
 	ws.groups = groupsMap
 
 	return nil
@@ -155,6 +155,33 @@ func TestWebServer_StartHttpServer(t *testing.T) {
 	})
 }
 
+func TestWebServer_createGroups(t *testing.T) {
+	t.Parallel()
+
+	t.Run("relayer facade should also create the relayer group", func(t *testing.T) {
+		t.Parallel()
+
+		ws, _ := NewWebServerHandler(createMockArgsNewWebServer())
+		err := ws.createGroups()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(ws.groups))
+		assert.NotNil(t, ws.groups["node"])
+		assert.NotNil(t, ws.groups["relayer"])
+	})
+	t.Run("SC calls executor facade should also create the executor group", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsNewWebServer()
+		args.Facade = &facade.ScCallsExecutorFacadeStub{}
+		ws, _ := NewWebServerHandler(args)
+		err := ws.createGroups()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(ws.groups))
+		assert.NotNil(t, ws.groups["node"])
+		assert.NotNil(t, ws.groups["executor"])
+	})
+}
+
 func TestWebServer_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
@@ -35,6 +35,7 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/status", Open: true},
 					{Name: "/status/list", Open: true},
+					{Name: "/metrics", Open: true},
 					{Name: "/debug", Open: true},
 					{Name: "/peerinfo", Open: true},
 				},
@@ -43,6 +44,38 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 	}
 }
 
+func getRelayerRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"relayer": {
+				Routes: []config.RouteConfig{
+					{Name: "/misbehaviour", Open: true},
+					{Name: "/signatures", Open: true},
+					{Name: "/fees", Open: true},
+					{Name: "/fees/csv", Open: true},
+					{Name: "/latency", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func getExecutorRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"executor": {
+				Routes: []config.RouteConfig{
+					{Name: "/pending", Open: true},
+					{Name: "/executions", Open: true},
+					{Name: "/senders", Open: true},
+					{Name: "/quarantined", Open: true},
+					{Name: "/quarantined/release", Open: true},
+				},
+			},
+		},
+	}
+}
+
 func loadResponse(rsp io.Reader, destination interface{}) {
 	jsonParser := json.NewDecoder(rsp)
 	err := jsonParser.Decode(destination)
@@ -4,3 +4,12 @@ import "errors"
 
 // ErrGettingMetrics signals that an error occurred while getting the metrics
 var ErrGettingMetrics = errors.New("error getting metrics")
+
+// ErrGettingDailyFees signals that an error occurred while getting the daily fees
+var ErrGettingDailyFees = errors.New("error getting daily fees")
+
+// ErrInvalidQuarantineRelease signals that an invalid quarantine release request was provided
+var ErrInvalidQuarantineRelease = errors.New("invalid quarantine release request")
+
+// ErrGettingSendersStatus signals that an error occurred while getting the senders status
+var ErrGettingSendersStatus = errors.New("error getting senders status")
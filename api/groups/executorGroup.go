@@ -0,0 +1,195 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	pendingOperationsPath = "/pending"
+	executionsPath        = "/executions"
+	sendersPath           = "/senders"
+	quarantinedPath       = "/quarantined"
+	releasePath           = "/quarantined/release"
+)
+
+type executorGroup struct {
+	*baseGroup
+	facade    shared.ScCallsExecutorFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewExecutorGroup returns a new instance of executorGroup
+func NewExecutorGroup(facade shared.ScCallsExecutorFacadeHandler) (*executorGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for executor group", errors.ErrNilFacadeHandler)
+	}
+
+	eg := &executorGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    pendingOperationsPath,
+			Method:  http.MethodGet,
+			Handler: eg.pendingOperations,
+		},
+		{
+			Path:    executionsPath,
+			Method:  http.MethodGet,
+			Handler: eg.recentExecutions,
+		},
+		{
+			Path:    sendersPath,
+			Method:  http.MethodGet,
+			Handler: eg.sendersStatus,
+		},
+		{
+			Path:    quarantinedPath,
+			Method:  http.MethodGet,
+			Handler: eg.quarantined,
+		},
+		{
+			Path:    releasePath,
+			Method:  http.MethodPost,
+			Handler: eg.releaseFromQuarantine,
+		},
+	}
+	eg.endpoints = endpoints
+
+	return eg, nil
+}
+
+// pendingOperations returns the pending operations along with the filter decision for each of them
+func (eg *executorGroup) pendingOperations(c *gin.Context) {
+	operations := eg.getFacade().GetPendingOperations()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  operations,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// recentExecutions returns the most recent executions with their hashes and outcomes
+func (eg *executorGroup) recentExecutions(c *gin.Context) {
+	executions := eg.getFacade().GetRecentExecutions()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  executions,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// sendersStatus returns the balance, the nonce and the state of the accounts used to send the execution transactions
+func (eg *executorGroup) sendersStatus(c *gin.Context) {
+	status, err := eg.getFacade().GetSendersStatus(c.Request.Context())
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrGettingSendersStatus.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  status,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// quarantined returns the IDs of the quarantined operations
+func (eg *executorGroup) quarantined(c *gin.Context) {
+	ids := eg.getFacade().GetQuarantined()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  ids,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// releaseFromQuarantine allows the requested quarantined operations to be executed again
+func (eg *executorGroup) releaseFromQuarantine(c *gin.Context) {
+	request := core.ScCallsQuarantineRelease{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrInvalidQuarantineRelease.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	released := eg.getFacade().ReleaseFromQuarantine(request)
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  released,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (eg *executorGroup) getFacade() shared.ScCallsExecutorFacadeHandler {
+	eg.mutFacade.RLock()
+	defer eg.mutFacade.RUnlock()
+
+	return eg.facade
+}
+
+// UpdateFacade will update the facade
+func (eg *executorGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(shared.ScCallsExecutorFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for executor group", errors.ErrFacadeWrongTypeAssertion)
+	}
+
+	eg.mutFacade.Lock()
+	eg.facade = castFacade
+	eg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eg *executorGroup) IsInterfaceNil() bool {
+	return eg == nil
+}
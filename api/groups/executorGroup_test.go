@@ -0,0 +1,300 @@
+package groups
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	mockFacade "github.com/multiversx/mx-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExecutorGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		eg, err := NewExecutorGroup(nil)
+
+		assert.True(t, check.IfNil(eg))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		eg, err := NewExecutorGroup(&mockFacade.ScCallsExecutorFacadeStub{})
+
+		assert.False(t, check.IfNil(eg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetPendingOperations(t *testing.T) {
+	t.Parallel()
+
+	operations := []*core.ScCallPendingOperation{
+		{
+			ID:             1,
+			From:           "0x0000000000000000000000000000000000000001",
+			To:             "erd1receiver",
+			Token:          "TKN-123456",
+			Amount:         "37",
+			Nonce:          2,
+			FilterDecision: core.ScCallAllowed,
+		},
+		{
+			ID:             2,
+			FilterDecision: core.ScCallDeniedByFilter,
+		},
+	}
+	facade := mockFacade.ScCallsExecutorFacadeStub{
+		GetPendingOperationsCalled: func() []*core.ScCallPendingOperation {
+			return operations
+		},
+	}
+
+	eg, err := NewExecutorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(eg, "executor", getExecutorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/executor/pending", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	operationsRsp := struct {
+		Data  []*core.ScCallPendingOperation `json:"data"`
+		Error string                         `json:"error"`
+	}{}
+	loadResponse(resp.Body, &operationsRsp)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, operations, operationsRsp.Data)
+	assert.Empty(t, operationsRsp.Error)
+}
+
+func TestGetRecentExecutions(t *testing.T) {
+	t.Parallel()
+
+	executions := []*core.ScCallExecution{
+		{
+			ID:        1,
+			TxHash:    "hash",
+			Nonce:     3,
+			GasLimit:  50000000,
+			Status:    core.ScCallExecutionFailed,
+			Error:     "transaction failed",
+			Timestamp: 1000,
+		},
+	}
+	facade := mockFacade.ScCallsExecutorFacadeStub{
+		GetRecentExecutionsCalled: func() []*core.ScCallExecution {
+			return executions
+		},
+	}
+
+	eg, err := NewExecutorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(eg, "executor", getExecutorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/executor/executions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	executionsRsp := struct {
+		Data  []*core.ScCallExecution `json:"data"`
+		Error string                  `json:"error"`
+	}{}
+	loadResponse(resp.Body, &executionsRsp)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, executions, executionsRsp.Data)
+	assert.Empty(t, executionsRsp.Error)
+}
+
+func TestGetSendersStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade errors should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := errors.New("expected error")
+		facade := mockFacade.ScCallsExecutorFacadeStub{
+			GetSendersStatusCalled: func(ctx context.Context) ([]*core.ScCallsSenderStatus, error) {
+				return nil, expectedError
+			},
+		}
+
+		eg, err := NewExecutorGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eg, "executor", getExecutorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/executor/senders", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		statusRsp := generalResponse{}
+		loadResponse(resp.Body, &statusRsp)
+
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Nil(t, statusRsp.Data)
+		assert.True(t, strings.Contains(statusRsp.Error, expectedError.Error()))
+		assert.True(t, strings.Contains(statusRsp.Error, ErrGettingSendersStatus.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		senderStatus := []*core.ScCallsSenderStatus{
+			{
+				Address: "erd1sender",
+				Nonce:   37,
+				Balance: "1000",
+				Active:  true,
+			},
+		}
+		facade := mockFacade.ScCallsExecutorFacadeStub{
+			GetSendersStatusCalled: func(ctx context.Context) ([]*core.ScCallsSenderStatus, error) {
+				return senderStatus, nil
+			},
+		}
+
+		eg, err := NewExecutorGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eg, "executor", getExecutorRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/executor/senders", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		statusRsp := struct {
+			Data  []*core.ScCallsSenderStatus `json:"data"`
+			Error string                      `json:"error"`
+		}{}
+		loadResponse(resp.Body, &statusRsp)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, senderStatus, statusRsp.Data)
+		assert.Empty(t, statusRsp.Error)
+	})
+}
+
+func TestGetQuarantined(t *testing.T) {
+	t.Parallel()
+
+	facade := mockFacade.ScCallsExecutorFacadeStub{
+		GetQuarantinedCalled: func() []uint64 {
+			return []uint64{3, 7}
+		},
+	}
+
+	eg, err := NewExecutorGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(eg, "executor", getExecutorRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/executor/quarantined", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	quarantinedRsp := struct {
+		Data  []uint64 `json:"data"`
+		Error string   `json:"error"`
+	}{}
+	loadResponse(resp.Body, &quarantinedRsp)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []uint64{3, 7}, quarantinedRsp.Data)
+	assert.Empty(t, quarantinedRsp.Error)
+}
+
+func TestReleaseFromQuarantine(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mockFacade.ScCallsExecutorFacadeStub{
+			ReleaseFromQuarantineCalled: func(request core.ScCallsQuarantineRelease) []uint64 {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		eg, err := NewExecutorGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eg, "executor", getExecutorRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/executor/quarantined/release", strings.NewReader(`{"ids": "7"}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		releaseRsp := generalResponse{}
+		loadResponse(resp.Body, &releaseRsp)
+
+		require.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(releaseRsp.Error, ErrInvalidQuarantineRelease.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mockFacade.ScCallsExecutorFacadeStub{
+			ReleaseFromQuarantineCalled: func(request core.ScCallsQuarantineRelease) []uint64 {
+				assert.Equal(t, core.ScCallsQuarantineRelease{IDs: []uint64{3, 7}}, request)
+				return []uint64{7}
+			},
+		}
+
+		eg, err := NewExecutorGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(eg, "executor", getExecutorRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/executor/quarantined/release", strings.NewReader(`{"ids": [3, 7]}`))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		releaseRsp := struct {
+			Data  []uint64 `json:"data"`
+			Error string   `json:"error"`
+		}{}
+		loadResponse(resp.Body, &releaseRsp)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, []uint64{7}, releaseRsp.Data)
+		assert.Empty(t, releaseRsp.Error)
+	})
+}
+
+func TestExecutorGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		eg, _ := NewExecutorGroup(&mockFacade.ScCallsExecutorFacadeStub{})
+
+		err := eg.UpdateFacade(nil)
+		assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("wrong facade type should error", func(t *testing.T) {
+		eg, _ := NewExecutorGroup(&mockFacade.ScCallsExecutorFacadeStub{})
+
+		err := eg.UpdateFacade(&mockFacade.RelayerFacadeStub{})
+		assert.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		eg, _ := NewExecutorGroup(&mockFacade.ScCallsExecutorFacadeStub{})
+
+		newFacade := &mockFacade.ScCallsExecutorFacadeStub{}
+
+		err := eg.UpdateFacade(newFacade)
+		assert.Nil(t, err)
+		assert.True(t, eg.getFacade() == newFacade)
+	})
+}
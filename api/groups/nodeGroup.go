@@ -16,6 +16,7 @@ const (
 	clientQueryParam = "name"
 	statusPath       = "/status"
 	statusListPath   = "/status/list"
+	prometheusPath   = "/metrics"
 )
 
 type nodeGroup struct {
@@ -46,6 +47,11 @@ func NewNodeGroup(facade shared.FacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.statusListMetrics,
 		},
+		{
+			Path:    prometheusPath,
+			Method:  http.MethodGet,
+			Handler: ng.prometheusMetrics,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -99,6 +105,13 @@ func (ng *nodeGroup) statusMetrics(c *gin.Context) {
 	)
 }
 
+// prometheusMetrics returns the numeric metrics in the format expected by Prometheus
+func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
+	metrics := ng.getFacade().GetPrometheusMetrics()
+
+	c.String(http.StatusOK, metrics)
+}
+
 func (ng *nodeGroup) getFacade() shared.FacadeHandler {
 	ng.mutFacade.RLock()
 	defer ng.mutFacade.RUnlock()
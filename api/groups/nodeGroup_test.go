@@ -131,6 +131,29 @@ func TestGetStatusList(t *testing.T) {
 	assert.Empty(t, statusRsp.Error)
 }
 
+func TestGetPrometheusMetrics(t *testing.T) {
+	t.Parallel()
+
+	metrics := "bridge_num_batches{handler=\"eth-client\"} 4\n"
+	facade := mockFacade.RelayerFacadeStub{
+		GetPrometheusMetricsCalled: func() string {
+			return metrics
+		},
+	}
+
+	ng, err := NewNodeGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(ng, "node", getNodeRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/node/metrics", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, metrics, resp.Body.String())
+}
+
 func TestNodeGroup_UpdateFacade(t *testing.T) {
 	t.Parallel()
 
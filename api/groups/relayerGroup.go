@@ -0,0 +1,174 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-bridge-eth-go/api/shared"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/api/errors"
+	chainAPIShared "github.com/multiversx/mx-chain-go/api/shared"
+)
+
+const (
+	misbehaviourPath = "/misbehaviour"
+	signaturesPath   = "/signatures"
+	feesPath         = "/fees"
+	feesCSVPath      = "/fees/csv"
+	latencyPath      = "/latency"
+	csvContentType   = "text/csv"
+)
+
+type relayerGroup struct {
+	*baseGroup
+	facade    shared.RelayerFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewRelayerGroup returns a new instance of relayerGroup
+func NewRelayerGroup(facade shared.RelayerFacadeHandler) (*relayerGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for relayer group", errors.ErrNilFacadeHandler)
+	}
+
+	rg := &relayerGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*chainAPIShared.EndpointHandlerData{
+		{
+			Path:    misbehaviourPath,
+			Method:  http.MethodGet,
+			Handler: rg.misbehaviourEvidence,
+		},
+		{
+			Path:    signaturesPath,
+			Method:  http.MethodGet,
+			Handler: rg.signaturesProgress,
+		},
+		{
+			Path:    feesPath,
+			Method:  http.MethodGet,
+			Handler: rg.feeAccounting,
+		},
+		{
+			Path:    feesCSVPath,
+			Method:  http.MethodGet,
+			Handler: rg.dailyFeesCSV,
+		},
+		{
+			Path:    latencyPath,
+			Method:  http.MethodGet,
+			Handler: rg.transfersLatency,
+		},
+	}
+	rg.endpoints = endpoints
+
+	return rg, nil
+}
+
+// misbehaviourEvidence returns the gathered misbehaviour evidence about the other relayers
+func (rg *relayerGroup) misbehaviourEvidence(c *gin.Context) {
+	evidence := rg.getFacade().GetMisbehaviourEvidence()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  evidence,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// signaturesProgress returns the signatures collection progress of each half-bridge
+func (rg *relayerGroup) signaturesProgress(c *gin.Context) {
+	progress := rg.getFacade().GetSignaturesProgress()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  progress,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// feeAccounting returns the fees paid by the relayer, aggregated by day, chain and action, along with the tracked transactions
+func (rg *relayerGroup) feeAccounting(c *gin.Context) {
+	report := rg.getFacade().GetFeeAccountingReport()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  report,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// dailyFeesCSV returns the fees paid by the relayer, aggregated by day, chain and action, in the CSV format
+func (rg *relayerGroup) dailyFeesCSV(c *gin.Context) {
+	csvData, err := rg.getFacade().GetDailyFeesCSV()
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			chainAPIShared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", ErrGettingDailyFees.Error(), err.Error()),
+				Code:  chainAPIShared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.Data(http.StatusOK, csvContentType, csvData)
+}
+
+// transfersLatency returns the timelines of the recent batches, from creation to execution
+func (rg *relayerGroup) transfersLatency(c *gin.Context) {
+	timelines := rg.getFacade().GetBatchTimelines()
+
+	c.JSON(
+		http.StatusOK,
+		chainAPIShared.GenericAPIResponse{
+			Data:  timelines,
+			Error: "",
+			Code:  chainAPIShared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func (rg *relayerGroup) getFacade() shared.RelayerFacadeHandler {
+	rg.mutFacade.RLock()
+	defer rg.mutFacade.RUnlock()
+
+	return rg.facade
+}
+
+// UpdateFacade will update the facade
+func (rg *relayerGroup) UpdateFacade(newFacade shared.FacadeHandler) error {
+	if check.IfNil(newFacade) {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(shared.RelayerFacadeHandler)
+	if !ok {
+		return fmt.Errorf("%w for relayer group", errors.ErrFacadeWrongTypeAssertion)
+	}
+
+	rg.mutFacade.Lock()
+	rg.facade = castFacade
+	rg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rg *relayerGroup) IsInterfaceNil() bool {
+	return rg == nil
+}
@@ -0,0 +1,290 @@
+package groups
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	mockFacade "github.com/multiversx/mx-bridge-eth-go/testsCommon/facade"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRelayerGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		rg, err := NewRelayerGroup(nil)
+
+		assert.True(t, check.IfNil(rg))
+		assert.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+	})
+	t.Run("should work", func(t *testing.T) {
+		rg, err := NewRelayerGroup(&mockFacade.RelayerFacadeStub{})
+
+		assert.False(t, check.IfNil(rg))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGetMisbehaviourEvidence(t *testing.T) {
+	t.Parallel()
+
+	evidence := []*core.MisbehaviourEvidence{
+		{
+			Type:             core.UnknownMessageHash,
+			BatchID:          37,
+			RelayerPublicKey: []byte("pk"),
+			PeerIDs:          []string{"pid"},
+		},
+	}
+	facade := mockFacade.RelayerFacadeStub{
+		GetMisbehaviourEvidenceCalled: func() []*core.MisbehaviourEvidence {
+			return evidence
+		},
+	}
+
+	rg, err := NewRelayerGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(rg, "relayer", getRelayerRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/relayer/misbehaviour", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	evidenceRsp := struct {
+		Data  []*core.MisbehaviourEvidence `json:"data"`
+		Error string                       `json:"error"`
+	}{}
+	loadResponse(resp.Body, &evidenceRsp)
+
+	assert.Equal(t, evidence, evidenceRsp.Data)
+
+	require.Equal(t, resp.Code, http.StatusOK)
+	assert.Empty(t, evidenceRsp.Error)
+}
+
+func TestGetSignaturesProgress(t *testing.T) {
+	t.Parallel()
+
+	progress := map[string]*core.SignaturesProgress{
+		"half-bridge": {
+			BatchID:        37,
+			ActionID:       38,
+			Quorum:         2,
+			NumSignatures:  1,
+			Signers:        []string{"relayer1"},
+			MissingSigners: []string{"relayer2"},
+		},
+	}
+	facade := mockFacade.RelayerFacadeStub{
+		GetSignaturesProgressCalled: func() map[string]*core.SignaturesProgress {
+			return progress
+		},
+	}
+
+	rg, err := NewRelayerGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(rg, "relayer", getRelayerRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/relayer/signatures", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	progressRsp := struct {
+		Data  map[string]*core.SignaturesProgress `json:"data"`
+		Error string                              `json:"error"`
+	}{}
+	loadResponse(resp.Body, &progressRsp)
+
+	assert.Equal(t, progress, progressRsp.Data)
+
+	require.Equal(t, resp.Code, http.StatusOK)
+	assert.Empty(t, progressRsp.Error)
+}
+
+func TestGetFeeAccounting(t *testing.T) {
+	t.Parallel()
+
+	report := &core.FeeAccountingReport{
+		Daily: []*core.DailyFees{
+			{
+				Day:             "2024-05-06",
+				Chain:           "MultiversX",
+				Action:          core.SignAction,
+				NumTransactions: 2,
+				GasUsed:         1000,
+				Fee:             "50000",
+			},
+		},
+		Transactions: []*core.RelayerTransaction{
+			{
+				TxHash:    "hash",
+				Chain:     "MultiversX",
+				BatchID:   37,
+				Direction: "ToMultiversX",
+				Action:    core.SignAction,
+				Status:    core.RelayerTxResolved,
+				GasUsed:   500,
+				Fee:       "25000",
+			},
+		},
+	}
+	facade := mockFacade.RelayerFacadeStub{
+		GetFeeAccountingReportCalled: func() *core.FeeAccountingReport {
+			return report
+		},
+	}
+
+	rg, err := NewRelayerGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(rg, "relayer", getRelayerRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/relayer/fees", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	feesRsp := struct {
+		Data  *core.FeeAccountingReport `json:"data"`
+		Error string                    `json:"error"`
+	}{}
+	loadResponse(resp.Body, &feesRsp)
+
+	assert.Equal(t, report, feesRsp.Data)
+
+	require.Equal(t, resp.Code, http.StatusOK)
+	assert.Empty(t, feesRsp.Error)
+}
+
+func TestGetDailyFeesCSV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedError := errors.New("expected error")
+		facade := mockFacade.RelayerFacadeStub{
+			GetDailyFeesCSVCalled: func() ([]byte, error) {
+				return nil, expectedError
+			},
+		}
+
+		rg, err := NewRelayerGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(rg, "relayer", getRelayerRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/relayer/fees/csv", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		csvRsp := struct {
+			Error string `json:"error"`
+		}{}
+		loadResponse(resp.Body, &csvRsp)
+
+		require.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(csvRsp.Error, ErrGettingDailyFees.Error()))
+		assert.True(t, strings.Contains(csvRsp.Error, expectedError.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		csvData := "day,chain,action,numTransactions,gasUsed,fee\n2024-05-06,MultiversX,Sign,2,1000,50000\n"
+		facade := mockFacade.RelayerFacadeStub{
+			GetDailyFeesCSVCalled: func() ([]byte, error) {
+				return []byte(csvData), nil
+			},
+		}
+
+		rg, err := NewRelayerGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(rg, "relayer", getRelayerRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/relayer/fees/csv", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, csvContentType, resp.Header().Get("Content-Type"))
+		assert.Equal(t, csvData, resp.Body.String())
+	})
+}
+
+func TestGetBatchTimelines(t *testing.T) {
+	t.Parallel()
+
+	timelines := []*core.BatchTimeline{
+		{
+			Direction:        "FromMultiversX",
+			BatchID:          37,
+			BlockNumber:      1234,
+			CreatedAt:        1000,
+			ProposedAt:       1060,
+			QuorumReachedAt:  1120,
+			ExecutedAt:       1300,
+			StatusSetAt:      1400,
+			LatencyInSeconds: 300,
+		},
+	}
+	facade := mockFacade.RelayerFacadeStub{
+		GetBatchTimelinesCalled: func() []*core.BatchTimeline {
+			return timelines
+		},
+	}
+
+	rg, err := NewRelayerGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(rg, "relayer", getRelayerRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/relayer/latency", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	latencyRsp := struct {
+		Data  []*core.BatchTimeline `json:"data"`
+		Error string                `json:"error"`
+	}{}
+	loadResponse(resp.Body, &latencyRsp)
+
+	assert.Equal(t, timelines, latencyRsp.Data)
+
+	require.Equal(t, resp.Code, http.StatusOK)
+	assert.Empty(t, latencyRsp.Error)
+}
+
+func TestRelayerGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		rg, _ := NewRelayerGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := rg.UpdateFacade(nil)
+		assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+	})
+	t.Run("wrong facade type should error", func(t *testing.T) {
+		rg, _ := NewRelayerGroup(&mockFacade.RelayerFacadeStub{})
+
+		err := rg.UpdateFacade(&mockFacade.ScCallsExecutorFacadeStub{})
+		assert.True(t, errors.Is(err, apiErrors.ErrFacadeWrongTypeAssertion))
+	})
+	t.Run("should work", func(t *testing.T) {
+		rg, _ := NewRelayerGroup(&mockFacade.RelayerFacadeStub{})
+
+		newFacade := &mockFacade.RelayerFacadeStub{}
+
+		err := rg.UpdateFacade(newFacade)
+		assert.Nil(t, err)
+		assert.True(t, rg.getFacade() == newFacade)
+	})
+}
@@ -1,6 +1,8 @@
 package shared
 
 import (
+	"context"
+
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	"github.com/multiversx/mx-bridge-eth-go/core"
@@ -16,15 +18,37 @@ type GroupHandler interface {
 	IsInterfaceNil() bool
 }
 
-// FacadeHandler defines all the methods that a facade should implement
+// FacadeHandler defines the methods that all the facades should implement
 type FacadeHandler interface {
 	RestApiInterface() string
 	PprofEnabled() bool
 	GetMetrics(name string) (core.GeneralMetrics, error)
 	GetMetricsList() core.GeneralMetrics
+	GetPrometheusMetrics() string
 	IsInterfaceNil() bool
 }
 
+// RelayerFacadeHandler defines the methods that the relayer facade should implement on top of the common facade ones
+type RelayerFacadeHandler interface {
+	FacadeHandler
+	GetMisbehaviourEvidence() []*core.MisbehaviourEvidence
+	GetSignaturesProgress() map[string]*core.SignaturesProgress
+	GetFeeAccountingReport() *core.FeeAccountingReport
+	GetDailyFeesCSV() ([]byte, error)
+	GetBatchTimelines() []*core.BatchTimeline
+}
+
+// ScCallsExecutorFacadeHandler defines the methods that the SC calls executor facade should implement on top of the
+// common facade ones
+type ScCallsExecutorFacadeHandler interface {
+	FacadeHandler
+	GetPendingOperations() []*core.ScCallPendingOperation
+	GetRecentExecutions() []*core.ScCallExecution
+	GetSendersStatus(ctx context.Context) ([]*core.ScCallsSenderStatus, error)
+	GetQuarantined() []uint64
+	ReleaseFromQuarantine(request core.ScCallsQuarantineRelease) []uint64
+}
+
 // UpgradeableHttpServerHandler defines the actions that an upgradeable http server need to do
 type UpgradeableHttpServerHandler interface {
 	StartHttpServer() error
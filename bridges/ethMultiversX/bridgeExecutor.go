@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -33,6 +34,10 @@ type ArgsBridgeExecutor struct {
 	StatusHandler                core.StatusHandler
 	SignaturesHolder             SignaturesHolder
 	BalanceValidator             BalanceValidator
+	SignaturesProgressHandler    core.SignaturesProgressHandler
+	TransactionsRecorder         core.TransactionsRecorder
+	LatencyRecorder              core.LatencyRecorder
+	Direction                    batchProcessor.Direction
 	MaxQuorumRetriesOnEthereum   uint64
 	MaxQuorumRetriesOnMultiversX uint64
 	MaxRestriesOnWasProposed     uint64
@@ -47,6 +52,10 @@ type bridgeExecutor struct {
 	statusHandler                core.StatusHandler
 	sigsHolder                   SignaturesHolder
 	balanceValidator             BalanceValidator
+	signaturesProgressHandler    core.SignaturesProgressHandler
+	transactionsRecorder         core.TransactionsRecorder
+	latencyRecorder              core.LatencyRecorder
+	direction                    batchProcessor.Direction
 	maxQuorumRetriesOnEthereum   uint64
 	maxQuorumRetriesOnMultiversX uint64
 	maxRetriesOnWasProposed      uint64
@@ -95,6 +104,18 @@ func checkArgs(args ArgsBridgeExecutor) error {
 	if check.IfNil(args.BalanceValidator) {
 		return ErrNilBalanceValidator
 	}
+	if check.IfNil(args.SignaturesProgressHandler) {
+		return ErrNilSignaturesProgressHandler
+	}
+	if check.IfNil(args.TransactionsRecorder) {
+		return ErrNilTransactionsRecorder
+	}
+	if check.IfNil(args.LatencyRecorder) {
+		return ErrNilLatencyRecorder
+	}
+	if args.Direction != batchProcessor.ToMultiversX && args.Direction != batchProcessor.FromMultiversX {
+		return fmt.Errorf("%w: %s", ErrInvalidDirection, args.Direction)
+	}
 	if args.MaxQuorumRetriesOnEthereum < minRetries {
 		return fmt.Errorf("%w for args.MaxQuorumRetriesOnEthereum, got: %d, minimum: %d",
 			clients.ErrInvalidValue, args.MaxQuorumRetriesOnEthereum, minRetries)
@@ -120,6 +141,10 @@ func createBridgeExecutor(args ArgsBridgeExecutor) *bridgeExecutor {
 		timeForWaitOnEthereum:        args.TimeForWaitOnEthereum,
 		sigsHolder:                   args.SignaturesHolder,
 		balanceValidator:             args.BalanceValidator,
+		signaturesProgressHandler:    args.SignaturesProgressHandler,
+		transactionsRecorder:         args.TransactionsRecorder,
+		latencyRecorder:              args.LatencyRecorder,
+		direction:                    args.Direction,
 		maxQuorumRetriesOnEthereum:   args.MaxQuorumRetriesOnEthereum,
 		maxQuorumRetriesOnMultiversX: args.MaxQuorumRetriesOnMultiversX,
 		maxRetriesOnWasProposed:      args.MaxRestriesOnWasProposed,
@@ -155,6 +180,7 @@ func (executor *bridgeExecutor) GetBatchFromMultiversX(ctx context.Context) (*br
 	batch, err := executor.multiversXClient.GetPendingBatch(ctx)
 	if err == nil {
 		executor.statusHandler.SetIntMetric(core.MetricNumBatches, int(batch.ID)-1)
+		executor.latencyRecorder.RecordBatchCreation(string(executor.direction), batch.ID, batch.BlockNumber)
 	}
 	return batch, err
 }
@@ -165,10 +191,23 @@ func (executor *bridgeExecutor) StoreBatchFromMultiversX(batch *bridgeCore.Trans
 		return ErrNilBatch
 	}
 
-	executor.batch = batch
+	executor.storeBatch(batch)
 	return nil
 }
 
+// storeBatch saves the provided batch and clears the signatures progress published for a previous batch
+func (executor *bridgeExecutor) storeBatch(batch *bridgeCore.TransferBatch) {
+	isNewBatch := executor.batch == nil || executor.batch.ID != batch.ID
+	executor.batch = batch
+	if !isNewBatch {
+		return
+	}
+
+	executor.statusHandler.SetStringMetric(core.MetricSignaturesProgress, "")
+	executor.statusHandler.SetStringMetric(core.MetricMissingSigners, "")
+	executor.signaturesProgressHandler.SetSignaturesProgress(executor.statusHandler.Name(), nil)
+}
+
 // GetStoredBatch returns the stored batch
 func (executor *bridgeExecutor) GetStoredBatch() *bridgeCore.TransferBatch {
 	return executor.batch
@@ -253,7 +292,15 @@ func (executor *bridgeExecutor) WasTransferProposedOnMultiversX(ctx context.Cont
 		return false, ErrNilBatch
 	}
 
-	return executor.multiversXClient.WasProposedTransfer(ctx, executor.batch)
+	wasProposed, err := executor.multiversXClient.WasProposedTransfer(ctx, executor.batch)
+	if err != nil {
+		return false, err
+	}
+	if wasProposed {
+		executor.recordStep(core.ProposedStep)
+	}
+
+	return wasProposed, nil
 }
 
 // ProposeTransferOnMultiversX propose the transfer on MultiversX
@@ -269,6 +316,8 @@ func (executor *bridgeExecutor) ProposeTransferOnMultiversX(ctx context.Context)
 
 	executor.log.Info("proposed transfer", "hash", hash,
 		"batch ID", executor.batch.ID, "action ID", executor.actionID)
+	executor.recordTransaction(core.ProposeTransferAction, hash)
+	executor.recordStep(core.ProposedStep)
 
 	return nil
 }
@@ -310,6 +359,7 @@ func (executor *bridgeExecutor) ProposeSetStatusOnMultiversX(ctx context.Context
 
 	executor.log.Info("proposed set status", "hash", hash,
 		"batch ID", executor.batch.ID)
+	executor.recordTransaction(core.ProposeSetStatusAction, hash)
 
 	return nil
 }
@@ -327,13 +377,27 @@ func (executor *bridgeExecutor) SignActionOnMultiversX(ctx context.Context) erro
 	}
 
 	executor.log.Info("signed proposed transfer", "hash", hash, "action ID", executor.actionID)
+	executor.recordTransaction(core.SignAction, hash)
 
 	return nil
 }
 
 // ProcessQuorumReachedOnMultiversX returns true if the proposed transfer reached the set quorum
 func (executor *bridgeExecutor) ProcessQuorumReachedOnMultiversX(ctx context.Context) (bool, error) {
-	return executor.multiversXClient.QuorumReached(ctx, executor.actionID)
+	isQuorumReached, err := executor.multiversXClient.QuorumReached(ctx, executor.actionID)
+	if err != nil {
+		return false, err
+	}
+
+	progress, err := executor.multiversXClient.GetSignaturesProgress(ctx, executor.actionID)
+	executor.updateSignaturesProgress(progress, err)
+
+	// on the MultiversX to Ethereum direction, the quorum on MultiversX is reached for the set status action
+	if isQuorumReached && executor.direction == batchProcessor.ToMultiversX {
+		executor.recordStep(core.QuorumReachedStep)
+	}
+
+	return isQuorumReached, nil
 }
 
 // WaitForTransferConfirmation waits for the confirmation of a transfer
@@ -399,7 +463,15 @@ func (executor *bridgeExecutor) GetBatchStatusesFromEthereum(ctx context.Context
 
 // WasActionPerformedOnMultiversX returns true if the action was already performed
 func (executor *bridgeExecutor) WasActionPerformedOnMultiversX(ctx context.Context) (bool, error) {
-	return executor.multiversXClient.WasExecuted(ctx, executor.actionID)
+	wasPerformed, err := executor.multiversXClient.WasExecuted(ctx, executor.actionID)
+	if err != nil {
+		return false, err
+	}
+	if wasPerformed {
+		executor.recordPerformedActionStep()
+	}
+
+	return wasPerformed, nil
 }
 
 // PerformActionOnMultiversX sends the perform-action transaction on the MultiversX chain
@@ -415,6 +487,7 @@ func (executor *bridgeExecutor) PerformActionOnMultiversX(ctx context.Context) e
 
 	executor.log.Info("sent perform action transaction", "hash", hash,
 		"batch ID", executor.batch.ID, "action ID", executor.actionID)
+	executor.recordTransaction(core.PerformAction, hash)
 
 	return nil
 }
@@ -456,7 +529,8 @@ func (executor *bridgeExecutor) GetAndStoreBatchFromEthereum(ctx context.Context
 	if err != nil {
 		return err
 	}
-	executor.batch = batch
+	executor.storeBatch(batch)
+	executor.latencyRecorder.RecordBatchCreation(string(executor.direction), batch.ID, batch.BlockNumber)
 
 	return nil
 }
@@ -522,7 +596,17 @@ func (executor *bridgeExecutor) WasTransferPerformedOnEthereum(ctx context.Conte
 		return false, ErrNilBatch
 	}
 
-	return executor.ethereumClient.WasExecuted(ctx, executor.batch.ID)
+	wasExecuted, err := executor.ethereumClient.WasExecuted(ctx, executor.batch.ID)
+	if err != nil {
+		return false, err
+	}
+	if wasExecuted {
+		// the signatures gathered for this batch (and the previous ones) are no longer needed
+		executor.sigsHolder.PruneExecutedBatch(executor.batch.ID)
+		executor.recordStep(core.ExecutedStep)
+	}
+
+	return wasExecuted, nil
 }
 
 // SignTransferOnEthereum generates the message hash for batch and broadcast the signature
@@ -541,7 +625,9 @@ func (executor *bridgeExecutor) SignTransferOnEthereum() error {
 		"batch ID", executor.batch.ID)
 
 	executor.msgHash = hash
-	executor.ethereumClient.BroadcastSignatureForMessageHash(hash)
+	executor.ethereumClient.BroadcastSignatureForMessageHash(hash, executor.batch.ID)
+	executor.recordStep(core.ProposedStep)
+
 	return nil
 }
 
@@ -569,10 +655,39 @@ func (executor *bridgeExecutor) PerformTransferOnEthereum(ctx context.Context) e
 
 	executor.log.Info("sent execute transfer", "hash", hash,
 		"batch ID", executor.batch.ID)
+	executor.recordTransaction(core.ExecuteTransferAction, hash)
 
 	return nil
 }
 
+func (executor *bridgeExecutor) recordTransaction(action core.RelayerTxAction, hash string) {
+	batchID := uint64(0)
+	if executor.batch != nil {
+		batchID = executor.batch.ID
+	}
+
+	executor.transactionsRecorder.RecordTransaction(batchID, string(executor.direction), action, hash)
+}
+
+func (executor *bridgeExecutor) recordStep(step core.TransferStep) {
+	if executor.batch == nil {
+		return
+	}
+
+	executor.latencyRecorder.RecordStep(string(executor.direction), executor.batch.ID, step)
+}
+
+// recordPerformedActionStep records the step reached by performing an action on MultiversX: the transfer execution
+// on the Ethereum to MultiversX direction and the set status on the MultiversX to Ethereum direction
+func (executor *bridgeExecutor) recordPerformedActionStep() {
+	if executor.direction == batchProcessor.ToMultiversX {
+		executor.recordStep(core.ExecutedStep)
+		return
+	}
+
+	executor.recordStep(core.StatusSetStep)
+}
+
 func (executor *bridgeExecutor) checkCumulatedTransfers(ctx context.Context, ethTokens []common.Address, mvxTokens [][]byte, amounts []*big.Int, direction batchProcessor.Direction) error {
 	for i, ethToken := range ethTokens {
 		err := executor.balanceValidator.CheckToken(ctx, ethToken, mvxTokens[i], amounts[i], direction)
@@ -617,7 +732,41 @@ func (executor *bridgeExecutor) getCumulatedTransfers(ethTokens []common.Address
 
 // ProcessQuorumReachedOnEthereum returns true if the proposed transfer reached the set quorum
 func (executor *bridgeExecutor) ProcessQuorumReachedOnEthereum(ctx context.Context) (bool, error) {
-	return executor.ethereumClient.IsQuorumReached(ctx, executor.msgHash)
+	isQuorumReached, err := executor.ethereumClient.IsQuorumReached(ctx, executor.msgHash)
+	if err != nil {
+		return false, err
+	}
+
+	progress, err := executor.ethereumClient.GetSignaturesProgress(ctx, executor.msgHash)
+	executor.updateSignaturesProgress(progress, err)
+
+	if isQuorumReached {
+		executor.recordStep(core.QuorumReachedStep)
+	}
+
+	return isQuorumReached, nil
+}
+
+// updateSignaturesProgress publishes the signatures collection progress. Errors are only logged as they should
+// not block the quorum checks
+func (executor *bridgeExecutor) updateSignaturesProgress(progress *bridgeCore.SignaturesProgress, err error) {
+	if err != nil {
+		executor.log.Debug("can not fetch the signatures progress", "error", err)
+		return
+	}
+
+	if executor.batch != nil {
+		progress.BatchID = executor.batch.ID
+	}
+	progress.Timestamp = time.Now().Unix()
+
+	executor.statusHandler.SetStringMetric(core.MetricSignaturesProgress, fmt.Sprintf("%d/%d", progress.NumSignatures, progress.Quorum))
+	executor.statusHandler.SetStringMetric(core.MetricMissingSigners, strings.Join(progress.MissingSigners, ", "))
+	executor.signaturesProgressHandler.SetSignaturesProgress(executor.statusHandler.Name(), progress)
+
+	executor.log.Debug("signatures progress", "batch ID", progress.BatchID, "action ID", progress.ActionID,
+		"message hash", progress.MessageHash, "signatures", progress.NumSignatures, "quorum", progress.Quorum,
+		"missing signers", progress.MissingSigners)
 }
 
 // ProcessMaxQuorumRetriesOnEthereum checks if the retries on Ethereum were reached and increments the counter
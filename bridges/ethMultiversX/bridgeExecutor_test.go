@@ -14,11 +14,13 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
 	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-bridge-eth-go/status"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var expectedErr = errors.New("expected error")
@@ -35,6 +37,10 @@ func createMockExecutorArgs() ArgsBridgeExecutor {
 		TimeForWaitOnEthereum:        time.Second,
 		SignaturesHolder:             &testsCommon.SignaturesHolderStub{},
 		BalanceValidator:             &testsCommon.BalanceValidatorStub{},
+		SignaturesProgressHandler:    status.NewSignaturesProgressHolder(),
+		TransactionsRecorder:         &testsCommon.TransactionsRecorderStub{},
+		LatencyRecorder:              &testsCommon.LatencyRecorderStub{},
+		Direction:                    batchProcessor.ToMultiversX,
 		MaxQuorumRetriesOnEthereum:   minRetries,
 		MaxQuorumRetriesOnMultiversX: minRetries,
 		MaxRestriesOnWasProposed:     minRetries,
@@ -124,6 +130,46 @@ func TestNewBridgeExecutor(t *testing.T) {
 		assert.True(t, check.IfNil(executor))
 		assert.Equal(t, ErrNilBalanceValidator, err)
 	})
+	t.Run("nil signatures progress handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.SignaturesProgressHandler = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilSignaturesProgressHandler, err)
+	})
+	t.Run("nil transactions recorder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.TransactionsRecorder = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilTransactionsRecorder, err)
+	})
+	t.Run("nil latency recorder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.LatencyRecorder = nil
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.Equal(t, ErrNilLatencyRecorder, err)
+	})
+	t.Run("invalid direction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.Direction = "invalid"
+		executor, err := NewBridgeExecutor(args)
+
+		assert.True(t, check.IfNil(executor))
+		assert.True(t, errors.Is(err, ErrInvalidDirection))
+	})
 	t.Run("invalid MaxQuorumRetriesOnEthereum value", func(t *testing.T) {
 		t.Parallel()
 
@@ -852,6 +898,104 @@ func TestEthToMultiversXBridgeExecutor_IsQuorumReachedOnMultiversX(t *testing.T)
 	assert.True(t, wasCalled)
 }
 
+func TestEthToMultiversXBridgeExecutor_SignaturesProgressOnMultiversX(t *testing.T) {
+	t.Parallel()
+
+	t.Run("signatures progress errors should not block the quorum check", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		progressHolder := status.NewSignaturesProgressHolder()
+		args.SignaturesProgressHandler = progressHolder
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			QuorumReachedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return false, nil
+			},
+			GetSignaturesProgressCalled: func(ctx context.Context, actionID uint64) (*bridgeCore.SignaturesProgress, error) {
+				return nil, expectedErr
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+
+		isQuorumReached, err := executor.ProcessQuorumReachedOnMultiversX(context.Background())
+		assert.False(t, isQuorumReached)
+		assert.Nil(t, err)
+		assert.Empty(t, progressHolder.GetAllSignaturesProgress())
+	})
+	t.Run("should publish the signatures progress", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		progressHolder := status.NewSignaturesProgressHolder()
+		args.SignaturesProgressHandler = progressHolder
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		providedActionID := uint64(378276)
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			QuorumReachedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return false, nil
+			},
+			GetSignaturesProgressCalled: func(ctx context.Context, actionID uint64) (*bridgeCore.SignaturesProgress, error) {
+				assert.Equal(t, providedActionID, actionID)
+				return &bridgeCore.SignaturesProgress{
+					ActionID:       actionID,
+					Quorum:         3,
+					NumSignatures:  1,
+					Signers:        []string{"relayer1"},
+					MissingSigners: []string{"relayer2", "relayer3"},
+				}, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.actionID = providedActionID
+		executor.batch = &bridgeCore.TransferBatch{ID: 37}
+
+		isQuorumReached, err := executor.ProcessQuorumReachedOnMultiversX(context.Background())
+		assert.False(t, isQuorumReached)
+		assert.Nil(t, err)
+
+		progress := progressHolder.GetAllSignaturesProgress()["test"]
+		require.NotNil(t, progress)
+		assert.Equal(t, uint64(37), progress.BatchID)
+		assert.Equal(t, providedActionID, progress.ActionID)
+		assert.Equal(t, "1/3", statusHandler.GetStringMetric(bridgeCore.MetricSignaturesProgress))
+		assert.Equal(t, "relayer2, relayer3", statusHandler.GetStringMetric(bridgeCore.MetricMissingSigners))
+	})
+	t.Run("storing a new batch should clear the signatures progress", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		progressHolder := status.NewSignaturesProgressHolder()
+		args.SignaturesProgressHandler = progressHolder
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			GetSignaturesProgressCalled: func(ctx context.Context, actionID uint64) (*bridgeCore.SignaturesProgress, error) {
+				return &bridgeCore.SignaturesProgress{
+					Quorum:         3,
+					NumSignatures:  1,
+					MissingSigners: []string{"relayer2", "relayer3"},
+				}, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		_ = executor.StoreBatchFromMultiversX(&bridgeCore.TransferBatch{ID: 37})
+
+		_, _ = executor.ProcessQuorumReachedOnMultiversX(context.Background())
+		assert.Len(t, progressHolder.GetAllSignaturesProgress(), 1)
+
+		// same batch, the progress is kept
+		_ = executor.StoreBatchFromMultiversX(&bridgeCore.TransferBatch{ID: 37})
+		assert.Len(t, progressHolder.GetAllSignaturesProgress(), 1)
+		assert.Equal(t, "1/3", statusHandler.GetStringMetric(bridgeCore.MetricSignaturesProgress))
+
+		_ = executor.StoreBatchFromMultiversX(&bridgeCore.TransferBatch{ID: 38})
+		assert.Empty(t, progressHolder.GetAllSignaturesProgress())
+		assert.Empty(t, statusHandler.GetStringMetric(bridgeCore.MetricSignaturesProgress))
+		assert.Empty(t, statusHandler.GetStringMetric(bridgeCore.MetricMissingSigners))
+	})
+}
+
 func TestEthToMultiversXBridgeExecutor_WasActionPerformedOnMultiversX(t *testing.T) {
 	t.Parallel()
 
@@ -1226,13 +1370,44 @@ func TestMultiversXToEthBridgeExecutor_WasTransferPerformedOnEthereum(t *testing
 			},
 		}
 
+		prunedBatchID := uint64(0)
+		args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+			PruneExecutedBatchCalled: func(batchID uint64) {
+				prunedBatchID = batchID
+			},
+		}
+
 		executor, _ := NewBridgeExecutor(args)
 		executor.batch = providedBatch
 		executor.batch.ID = providedBatchID
 
-		_, err := executor.WasTransferPerformedOnEthereum(context.Background())
+		wasPerformed, err := executor.WasTransferPerformedOnEthereum(context.Background())
 		assert.Nil(t, err)
+		assert.True(t, wasPerformed)
 		assert.True(t, wasCalled)
+		assert.Equal(t, providedBatchID, prunedBatchID)
+	})
+	t.Run("not executed should not prune the signatures", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return false, nil
+			},
+		}
+		args.SignaturesHolder = &testsCommon.SignaturesHolderStub{
+			PruneExecutedBatchCalled: func(batchID uint64) {
+				assert.Fail(t, "should have not called PruneExecutedBatch")
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = providedBatch
+
+		wasPerformed, err := executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, wasPerformed)
 	})
 }
 
@@ -1274,7 +1449,8 @@ func TestMultiversXToEthBridgeExecutor_SignTransferOnEthereum(t *testing.T) {
 				wasCalledGenerateMessageHashCalled = true
 				return common.Hash{}, nil
 			},
-			BroadcastSignatureForMessageHashCalled: func(msgHash common.Hash) {
+			BroadcastSignatureForMessageHashCalled: func(msgHash common.Hash, batchID uint64) {
+				assert.Equal(t, providedBatch.ID, batchID)
 				wasCalledBroadcastSignatureForMessageHashCalled = true
 			},
 		}
@@ -1410,6 +1586,42 @@ func TestMultiversXToEthBridgeExecutor_IsQuorumReachedOnEthereum(t *testing.T) {
 		assert.True(t, wasCalled)
 		assert.True(t, isReached)
 	})
+	t.Run("should publish the signatures progress", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockExecutorArgs()
+		progressHolder := status.NewSignaturesProgressHolder()
+		args.SignaturesProgressHandler = progressHolder
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
+				return false, nil
+			},
+			GetSignaturesProgressCalled: func(ctx context.Context, msgHash common.Hash) (*bridgeCore.SignaturesProgress, error) {
+				return &bridgeCore.SignaturesProgress{
+					MessageHash:    msgHash.String(),
+					Quorum:         2,
+					NumSignatures:  1,
+					Signers:        []string{"0x01"},
+					MissingSigners: []string{"0x02"},
+				}, nil
+			},
+		}
+
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{ID: 38}
+
+		isReached, err := executor.ProcessQuorumReachedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		assert.False(t, isReached)
+
+		progress := progressHolder.GetAllSignaturesProgress()["test"]
+		require.NotNil(t, progress)
+		assert.Equal(t, uint64(38), progress.BatchID)
+		assert.Equal(t, "1/2", statusHandler.GetStringMetric(bridgeCore.MetricSignaturesProgress))
+		assert.Equal(t, "0x02", statusHandler.GetStringMetric(bridgeCore.MetricMissingSigners))
+	})
 }
 
 func TestMultiversXToEthBridgeExecutor_RetriesCountOnEthereum(t *testing.T) {
@@ -1428,6 +1640,237 @@ func TestMultiversXToEthBridgeExecutor_RetriesCountOnEthereum(t *testing.T) {
 	assert.Equal(t, uint64(0), executor.quorumRetriesOnEthereum)
 }
 
+func TestBridgeExecutor_RecordsSentTransactions(t *testing.T) {
+	t.Parallel()
+
+	type recordedTx struct {
+		batchID   uint64
+		direction string
+		action    bridgeCore.RelayerTxAction
+		hash      string
+	}
+	recorded := make([]recordedTx, 0)
+	args := createMockExecutorArgs()
+	args.Direction = batchProcessor.FromMultiversX
+	args.TransactionsRecorder = &testsCommon.TransactionsRecorderStub{
+		RecordTransactionCalled: func(batchID uint64, direction string, action bridgeCore.RelayerTxAction, txHash string) {
+			recorded = append(recorded, recordedTx{
+				batchID:   batchID,
+				direction: direction,
+				action:    action,
+				hash:      txHash,
+			})
+		},
+	}
+	args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+		ProposeTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error) {
+			return "propose transfer hash", nil
+		},
+		ProposeSetStatusCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error) {
+			return "propose set status hash", nil
+		},
+		SignCalled: func(ctx context.Context, actionID uint64) (string, error) {
+			return "sign hash", nil
+		},
+		PerformActionCalled: func(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error) {
+			return "perform action hash", nil
+		},
+	}
+	args.EthereumClient = &bridgeTests.EthereumClientStub{
+		GetQuorumSizeCalled: func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(1), nil
+		},
+		ExecuteTransferCalled: func(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error) {
+			return "execute transfer hash", nil
+		},
+	}
+	executor, _ := NewBridgeExecutor(args)
+	executor.batch = &bridgeCore.TransferBatch{ID: 37}
+
+	assert.Nil(t, executor.ProposeTransferOnMultiversX(context.Background()))
+	assert.Nil(t, executor.ProposeSetStatusOnMultiversX(context.Background()))
+	assert.Nil(t, executor.SignActionOnMultiversX(context.Background()))
+	assert.Nil(t, executor.PerformActionOnMultiversX(context.Background()))
+	assert.Nil(t, executor.PerformTransferOnEthereum(context.Background()))
+
+	direction := string(batchProcessor.FromMultiversX)
+	expected := []recordedTx{
+		{batchID: 37, direction: direction, action: bridgeCore.ProposeTransferAction, hash: "propose transfer hash"},
+		{batchID: 37, direction: direction, action: bridgeCore.ProposeSetStatusAction, hash: "propose set status hash"},
+		{batchID: 37, direction: direction, action: bridgeCore.SignAction, hash: "sign hash"},
+		{batchID: 37, direction: direction, action: bridgeCore.PerformAction, hash: "perform action hash"},
+		{batchID: 37, direction: direction, action: bridgeCore.ExecuteTransferAction, hash: "execute transfer hash"},
+	}
+	assert.Equal(t, expected, recorded)
+}
+
+type recordedStep struct {
+	direction   string
+	batchID     uint64
+	step        bridgeCore.TransferStep
+	blockNumber uint64
+}
+
+func createLatencyRecorder(recorded *[]recordedStep) *testsCommon.LatencyRecorderStub {
+	return &testsCommon.LatencyRecorderStub{
+		RecordBatchCreationCalled: func(direction string, batchID uint64, blockNumber uint64) {
+			*recorded = append(*recorded, recordedStep{
+				direction:   direction,
+				batchID:     batchID,
+				step:        bridgeCore.BatchCreatedStep,
+				blockNumber: blockNumber,
+			})
+		},
+		RecordStepCalled: func(direction string, batchID uint64, step bridgeCore.TransferStep) {
+			*recorded = append(*recorded, recordedStep{
+				direction: direction,
+				batchID:   batchID,
+				step:      step,
+			})
+		},
+	}
+}
+
+func TestBridgeExecutor_RecordsLatencySteps(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Ethereum to MultiversX", func(t *testing.T) {
+		t.Parallel()
+
+		recorded := make([]recordedStep, 0)
+		args := createMockExecutorArgs()
+		args.LatencyRecorder = createLatencyRecorder(&recorded)
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GetBatchCalled: func(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error) {
+				return &bridgeCore.TransferBatch{
+					ID:          nonce,
+					BlockNumber: 100,
+					Deposits:    []*bridgeCore.DepositTransfer{{Nonce: 1}},
+				}, true, nil
+			},
+			GetBatchSCMetadataCalled: func(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error) {
+				return make([]*contract.ERC20SafeERC20SCDeposit, 0), nil
+			},
+		}
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error) {
+				return true, nil
+			},
+			QuorumReachedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return true, nil
+			},
+			WasExecutedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return true, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+
+		assert.Nil(t, executor.GetAndStoreBatchFromEthereum(context.Background(), 37))
+		_, err := executor.WasTransferProposedOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		_, err = executor.ProcessQuorumReachedOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		_, err = executor.WasActionPerformedOnMultiversX(context.Background())
+		assert.Nil(t, err)
+
+		direction := string(batchProcessor.ToMultiversX)
+		expected := []recordedStep{
+			{direction: direction, batchID: 37, step: bridgeCore.BatchCreatedStep, blockNumber: 100},
+			{direction: direction, batchID: 37, step: bridgeCore.ProposedStep},
+			{direction: direction, batchID: 37, step: bridgeCore.QuorumReachedStep},
+			{direction: direction, batchID: 37, step: bridgeCore.ExecutedStep},
+		}
+		assert.Equal(t, expected, recorded)
+	})
+	t.Run("MultiversX to Ethereum", func(t *testing.T) {
+		t.Parallel()
+
+		recorded := make([]recordedStep, 0)
+		args := createMockExecutorArgs()
+		args.Direction = batchProcessor.FromMultiversX
+		args.LatencyRecorder = createLatencyRecorder(&recorded)
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			GetPendingBatchCalled: func(ctx context.Context) (*bridgeCore.TransferBatch, error) {
+				return &bridgeCore.TransferBatch{
+					ID:          38,
+					BlockNumber: 200,
+				}, nil
+			},
+			QuorumReachedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return true, nil
+			},
+			WasExecutedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return true, nil
+			},
+		}
+		args.EthereumClient = &bridgeTests.EthereumClientStub{
+			GenerateMessageHashCalled: func(batch *batchProcessor.ArgListsBatch, batchID uint64) (common.Hash, error) {
+				return common.HexToHash("0x1234"), nil
+			},
+			IsQuorumReachedCalled: func(ctx context.Context, msgHash common.Hash) (bool, error) {
+				return true, nil
+			},
+			WasExecutedCalled: func(ctx context.Context, batchID uint64) (bool, error) {
+				return true, nil
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+
+		batch, err := executor.GetBatchFromMultiversX(context.Background())
+		assert.Nil(t, err)
+		assert.Nil(t, executor.StoreBatchFromMultiversX(batch))
+		assert.Nil(t, executor.SignTransferOnEthereum())
+		_, err = executor.ProcessQuorumReachedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		_, err = executor.WasTransferPerformedOnEthereum(context.Background())
+		assert.Nil(t, err)
+		// the quorum reached on MultiversX is for the set status action and should not be recorded
+		_, err = executor.ProcessQuorumReachedOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		_, err = executor.WasActionPerformedOnMultiversX(context.Background())
+		assert.Nil(t, err)
+
+		direction := string(batchProcessor.FromMultiversX)
+		expected := []recordedStep{
+			{direction: direction, batchID: 38, step: bridgeCore.BatchCreatedStep, blockNumber: 200},
+			{direction: direction, batchID: 38, step: bridgeCore.ProposedStep},
+			{direction: direction, batchID: 38, step: bridgeCore.QuorumReachedStep},
+			{direction: direction, batchID: 38, step: bridgeCore.ExecutedStep},
+			{direction: direction, batchID: 38, step: bridgeCore.StatusSetStep},
+		}
+		assert.Equal(t, expected, recorded)
+	})
+	t.Run("negative answers should not record steps", func(t *testing.T) {
+		t.Parallel()
+
+		recorded := make([]recordedStep, 0)
+		args := createMockExecutorArgs()
+		args.LatencyRecorder = createLatencyRecorder(&recorded)
+		args.MultiversXClient = &bridgeTests.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error) {
+				return false, nil
+			},
+			QuorumReachedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return false, nil
+			},
+			WasExecutedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		executor, _ := NewBridgeExecutor(args)
+		executor.batch = &bridgeCore.TransferBatch{ID: 37}
+
+		_, err := executor.WasTransferProposedOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		_, err = executor.ProcessQuorumReachedOnMultiversX(context.Background())
+		assert.Nil(t, err)
+		_, err = executor.WasActionPerformedOnMultiversX(context.Background())
+		assert.Equal(t, expectedErr, err)
+
+		assert.Empty(t, recorded)
+	})
+}
+
 func TestWaitForTransferConfirmation(t *testing.T) {
 	t.Parallel()
 
@@ -17,6 +17,10 @@ func (disabled *disabledSignaturesHolder) Signatures(_ []byte) [][]byte {
 func (disabled *disabledSignaturesHolder) ClearStoredSignatures() {
 }
 
+// PruneExecutedBatch does nothing
+func (disabled *disabledSignaturesHolder) PruneExecutedBatch(_ uint64) {
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (disabled *disabledSignaturesHolder) IsInterfaceNil() bool {
 	return disabled == nil
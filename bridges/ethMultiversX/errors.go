@@ -40,3 +40,15 @@ var ErrNilSignaturesHolder = errors.New("nil signatures holder")
 
 // ErrNilBalanceValidator signals that a nil balance validator was provided
 var ErrNilBalanceValidator = errors.New("nil balance validator")
+
+// ErrNilSignaturesProgressHandler signals that a nil signatures progress handler was provided
+var ErrNilSignaturesProgressHandler = errors.New("nil signatures progress handler")
+
+// ErrNilTransactionsRecorder signals that a nil transactions recorder was provided
+var ErrNilTransactionsRecorder = errors.New("nil transactions recorder")
+
+// ErrInvalidDirection signals that an invalid transfer direction was provided
+var ErrInvalidDirection = errors.New("invalid direction")
+
+// ErrNilLatencyRecorder signals that a nil latency recorder was provided
+var ErrNilLatencyRecorder = errors.New("nil latency recorder")
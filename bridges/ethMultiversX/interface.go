@@ -31,6 +31,7 @@ type MultiversXClient interface {
 	ProposeTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error)
 	Sign(ctx context.Context, actionID uint64) (string, error)
 	WasSigned(ctx context.Context, actionID uint64) (bool, error)
+	GetSignaturesProgress(ctx context.Context, actionID uint64) (*bridgeCore.SignaturesProgress, error)
 	PerformAction(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error)
 	CheckClientAvailability(ctx context.Context) error
 	IsMintBurnToken(ctx context.Context, token []byte) (bool, error)
@@ -49,11 +50,12 @@ type EthereumClient interface {
 	WasExecuted(ctx context.Context, batchID uint64) (bool, error)
 	GenerateMessageHash(batch *batchProcessor.ArgListsBatch, batchId uint64) (common.Hash, error)
 
-	BroadcastSignatureForMessageHash(msgHash common.Hash)
+	BroadcastSignatureForMessageHash(msgHash common.Hash, batchID uint64)
 	ExecuteTransfer(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error)
 	GetTransactionsStatuses(ctx context.Context, batchId uint64) ([]byte, error)
 	GetQuorumSize(ctx context.Context) (*big.Int, error)
 	IsQuorumReached(ctx context.Context, msgHash common.Hash) (bool, error)
+	GetSignaturesProgress(ctx context.Context, msgHash common.Hash) (*bridgeCore.SignaturesProgress, error)
 	GetBatchSCMetadata(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error)
 	CheckClientAvailability(ctx context.Context) error
 	CheckRequiredBalance(ctx context.Context, erc20Address common.Address, value *big.Int) error
@@ -76,6 +78,7 @@ type TopologyProvider interface {
 type SignaturesHolder interface {
 	Signatures(messageHash []byte) [][]byte
 	ClearStoredSignatures()
+	PruneExecutedBatch(batchID uint64)
 	IsInterfaceNil() bool
 }
 
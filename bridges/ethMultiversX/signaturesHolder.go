@@ -1,24 +1,75 @@
 package ethmultiversx
 
 import (
-	"bytes"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/multiversx/mx-bridge-eth-go/clients"
 	"github.com/multiversx/mx-bridge-eth-go/core"
 )
 
+const (
+	minSignaturesExpiry       = time.Second
+	minMessageHashesPerSigner = 1
+	unknownBatchID            = uint64(0)
+)
+
+// ArgsSignaturesHolder is the DTO used in the signatures holder constructor
+type ArgsSignaturesHolder struct {
+	Expiry                    time.Duration
+	MaxMessageHashesPerSigner int
+}
+
+type storedSignature struct {
+	signedMessage *core.SignedMessage
+	ethMessage    *core.EthereumSignature
+	receivedAt    time.Time
+}
+
+type messageHashSignatures struct {
+	batchID uint64
+	// signatures are keyed by the signer's public key so a signer can only have one signature for a message hash
+	signatures map[string]*storedSignature
+}
+
 type signaturesHolder struct {
-	mut            sync.RWMutex
-	signedMessages map[string]*core.SignedMessage
-	ethMessages    []*core.EthereumSignature
+	expiry                    time.Duration
+	maxMessageHashesPerSigner int
+	getTimeHandler            func() time.Time
+
+	mut                 sync.RWMutex
+	messageHashes       map[string]*messageHashSignatures
+	lastExecutedBatchID uint64
 }
 
-// NewSignatureHolder creates a new signatureHolder
-func NewSignatureHolder() *signaturesHolder {
+// NewSignatureHolder creates a new signatureHolder. The stored signatures are grouped by message hash and batch,
+// are bounded by the maximum number of message hashes each signer can have stored and expire after the set duration
+func NewSignatureHolder(args ArgsSignaturesHolder) (*signaturesHolder, error) {
+	err := checkSignaturesHolderArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
 	return &signaturesHolder{
-		signedMessages: make(map[string]*core.SignedMessage),
-		ethMessages:    make([]*core.EthereumSignature, 0),
+		expiry:                    args.Expiry,
+		maxMessageHashesPerSigner: args.MaxMessageHashesPerSigner,
+		getTimeHandler:            time.Now,
+		messageHashes:             make(map[string]*messageHashSignatures),
+	}, nil
+}
+
+func checkSignaturesHolderArgs(args ArgsSignaturesHolder) error {
+	if args.Expiry < minSignaturesExpiry {
+		return fmt.Errorf("%w for args.Expiry, got: %v, minimum: %v",
+			clients.ErrInvalidValue, args.Expiry, minSignaturesExpiry)
+	}
+	if args.MaxMessageHashesPerSigner < minMessageHashesPerSigner {
+		return fmt.Errorf("%w for args.MaxMessageHashesPerSigner, got: %d, minimum: %d",
+			clients.ErrInvalidValue, args.MaxMessageHashesPerSigner, minMessageHashesPerSigner)
 	}
+
+	return nil
 }
 
 // ProcessNewMessage will store the new messages
@@ -30,8 +81,81 @@ func (sh *signaturesHolder) ProcessNewMessage(msg *core.SignedMessage, ethMsg *c
 	sh.mut.Lock()
 	defer sh.mut.Unlock()
 
-	sh.signedMessages[msg.UniqueID()] = msg
-	sh.ethMessages = append(sh.ethMessages, ethMsg)
+	now := sh.getTimeHandler()
+	sh.pruneExpired(now)
+
+	if ethMsg.BatchId != unknownBatchID && ethMsg.BatchId <= sh.lastExecutedBatchID {
+		// late signature for an already executed batch
+		return
+	}
+
+	entry, found := sh.messageHashes[string(ethMsg.MessageHash)]
+	if !found {
+		entry = &messageHashSignatures{
+			batchID:    ethMsg.BatchId,
+			signatures: make(map[string]*storedSignature),
+		}
+		sh.messageHashes[string(ethMsg.MessageHash)] = entry
+	}
+
+	signer := string(msg.PublicKeyBytes)
+	entry.signatures[signer] = &storedSignature{
+		signedMessage: msg,
+		ethMessage:    ethMsg,
+		receivedAt:    now,
+	}
+
+	sh.enforceSignerLimit(signer)
+}
+
+func (sh *signaturesHolder) pruneExpired(now time.Time) {
+	for messageHash, entry := range sh.messageHashes {
+		for signer, stored := range entry.signatures {
+			if now.Sub(stored.receivedAt) > sh.expiry {
+				delete(entry.signatures, signer)
+			}
+		}
+
+		if len(entry.signatures) == 0 {
+			delete(sh.messageHashes, messageHash)
+		}
+	}
+}
+
+// enforceSignerLimit removes the oldest signatures of the provided signer until the number of message hashes it
+// signed fits in the set limit
+func (sh *signaturesHolder) enforceSignerLimit(signer string) {
+	for {
+		numMessageHashes := 0
+		oldestMessageHash := ""
+		var oldest *storedSignature
+		for messageHash, entry := range sh.messageHashes {
+			stored, found := entry.signatures[signer]
+			if !found {
+				continue
+			}
+
+			numMessageHashes++
+			if oldest == nil || stored.receivedAt.Before(oldest.receivedAt) {
+				oldest = stored
+				oldestMessageHash = messageHash
+			}
+		}
+
+		if numMessageHashes <= sh.maxMessageHashesPerSigner {
+			return
+		}
+
+		sh.removeSignature(oldestMessageHash, signer)
+	}
+}
+
+func (sh *signaturesHolder) removeSignature(messageHash string, signer string) {
+	entry := sh.messageHashes[messageHash]
+	delete(entry.signatures, signer)
+	if len(entry.signatures) == 0 {
+		delete(sh.messageHashes, messageHash)
+	}
 }
 
 // AllStoredSignatures will return the stored signatures
@@ -39,9 +163,16 @@ func (sh *signaturesHolder) AllStoredSignatures() []*core.SignedMessage {
 	sh.mut.RLock()
 	defer sh.mut.RUnlock()
 
-	result := make([]*core.SignedMessage, 0, len(sh.signedMessages))
-	for _, msg := range sh.signedMessages {
-		result = append(result, msg)
+	now := sh.getTimeHandler()
+	result := make([]*core.SignedMessage, 0, len(sh.messageHashes))
+	for _, entry := range sh.messageHashes {
+		for _, stored := range entry.signatures {
+			if now.Sub(stored.receivedAt) > sh.expiry {
+				continue
+			}
+
+			result = append(result, stored.signedMessage)
+		}
 	}
 
 	return result
@@ -52,14 +183,22 @@ func (sh *signaturesHolder) Signatures(msgHash []byte) [][]byte {
 	sh.mut.RLock()
 	defer sh.mut.RUnlock()
 
+	entry, found := sh.messageHashes[string(msgHash)]
+	if !found {
+		return make([][]byte, 0)
+	}
+
+	now := sh.getTimeHandler()
 	uniqueEthSigs := make(map[string]struct{})
-	for _, ethMsg := range sh.ethMessages {
-		if bytes.Equal(ethMsg.MessageHash, msgHash) {
-			uniqueEthSigs[string(ethMsg.Signature)] = struct{}{}
+	for _, stored := range entry.signatures {
+		if now.Sub(stored.receivedAt) > sh.expiry {
+			continue
 		}
+
+		uniqueEthSigs[string(stored.ethMessage.Signature)] = struct{}{}
 	}
 
-	result := make([][]byte, 0, len(sh.signedMessages))
+	result := make([][]byte, 0, len(uniqueEthSigs))
 	for sig := range uniqueEthSigs {
 		result = append(result, []byte(sig))
 	}
@@ -67,13 +206,35 @@ func (sh *signaturesHolder) Signatures(msgHash []byte) [][]byte {
 	return result
 }
 
-// ClearStoredSignatures will clear any stored signatures
+// ClearStoredSignatures will clear the stored signatures, keeping only the ones for the batches that were not yet
+// executed (the in-flight batches). Signatures received from legacy relayers, that do not carry a batch ID, are
+// always cleared
 func (sh *signaturesHolder) ClearStoredSignatures() {
 	sh.mut.Lock()
 	defer sh.mut.Unlock()
 
-	sh.signedMessages = make(map[string]*core.SignedMessage)
-	sh.ethMessages = make([]*core.EthereumSignature, 0)
+	for messageHash, entry := range sh.messageHashes {
+		if entry.batchID == unknownBatchID || entry.batchID <= sh.lastExecutedBatchID {
+			delete(sh.messageHashes, messageHash)
+		}
+	}
+}
+
+// PruneExecutedBatch will remove all the signatures of the provided batch and of the previous ones, as they were
+// already executed on Ethereum
+func (sh *signaturesHolder) PruneExecutedBatch(batchID uint64) {
+	sh.mut.Lock()
+	defer sh.mut.Unlock()
+
+	if batchID > sh.lastExecutedBatchID {
+		sh.lastExecutedBatchID = batchID
+	}
+
+	for messageHash, entry := range sh.messageHashes {
+		if entry.batchID != unknownBatchID && entry.batchID <= sh.lastExecutedBatchID {
+			delete(sh.messageHashes, messageHash)
+		}
+	}
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
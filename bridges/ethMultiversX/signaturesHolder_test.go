@@ -2,15 +2,89 @@ package ethmultiversx
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/multiversx/mx-bridge-eth-go/clients"
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+func createMockArgsSignaturesHolder() ArgsSignaturesHolder {
+	return ArgsSignaturesHolder{
+		Expiry:                    time.Minute,
+		MaxMessageHashesPerSigner: 10,
+	}
+}
+
+func generateBatchSignature(pk string, messageHash string, batchID uint64) (*core.SignedMessage, *core.EthereumSignature) {
+	msg := &core.SignedMessage{
+		Payload:        []byte("payload " + messageHash),
+		Signature:      []byte("sig " + pk + messageHash),
+		PublicKeyBytes: []byte(pk),
+	}
+	ethMsg := &core.EthereumSignature{
+		Signature:   []byte("eth sig " + pk + messageHash),
+		MessageHash: []byte(messageHash),
+		BatchId:     batchID,
+	}
+
+	return msg, ethMsg
+}
+
+func getAllEthMessages(sh *signaturesHolder) []*core.EthereumSignature {
+	sh.mut.RLock()
+	defer sh.mut.RUnlock()
+
+	result := make([]*core.EthereumSignature, 0)
+	for _, entry := range sh.messageHashes {
+		for _, stored := range entry.signatures {
+			result = append(result, stored.ethMessage)
+		}
+	}
+
+	return result
+}
+
+func TestNewSignatureHolder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid expiry should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.Expiry = time.Millisecond
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.Contains(t, err.Error(), "args.Expiry")
+	})
+	t.Run("invalid max message hashes per signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesHolder()
+		args.MaxMessageHashesPerSigner = 0
+
+		sh, err := NewSignatureHolder(args)
+		assert.True(t, check.IfNil(sh))
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.Contains(t, err.Error(), "args.MaxMessageHashesPerSigner")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sh, err := NewSignatureHolder(createMockArgsSignaturesHolder())
+		assert.False(t, check.IfNil(sh))
+		assert.Nil(t, err)
+	})
+}
+
 func generateSignedMessage(index uint64) *core.SignedMessage {
 	return &core.SignedMessage{
 		Payload:        []byte(fmt.Sprintf("payload %d", index)),
@@ -36,14 +110,14 @@ func TestSignatureHolder_ProcessNewMessage(t *testing.T) {
 		msg := generateSignedMessage(0)
 		ethMsg := generateEthMessage(0)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(nil, ethMsg)
-		assert.Equal(t, 0, len(sh.signedMessages))
-		assert.Equal(t, 0, len(sh.ethMessages))
+		assert.Equal(t, 0, len(sh.AllStoredSignatures()))
+		assert.Equal(t, 0, len(getAllEthMessages(sh)))
 
 		sh.ProcessNewMessage(msg, nil)
-		assert.Equal(t, 0, len(sh.signedMessages))
-		assert.Equal(t, 0, len(sh.ethMessages))
+		assert.Equal(t, 0, len(sh.AllStoredSignatures()))
+		assert.Equal(t, 0, len(getAllEthMessages(sh)))
 	})
 	t.Run("first message should add", func(t *testing.T) {
 		t.Parallel()
@@ -51,10 +125,10 @@ func TestSignatureHolder_ProcessNewMessage(t *testing.T) {
 		msg := generateSignedMessage(0)
 		ethMsg := generateEthMessage(0)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		assert.Equal(t, []*core.SignedMessage{msg}, sh.AllStoredSignatures())
-		assert.Equal(t, []*core.EthereumSignature{ethMsg}, sh.ethMessages)
+		assert.Equal(t, []*core.EthereumSignature{ethMsg}, getAllEthMessages(sh))
 	})
 	t.Run("two messages should add", func(t *testing.T) {
 		t.Parallel()
@@ -65,10 +139,10 @@ func TestSignatureHolder_ProcessNewMessage(t *testing.T) {
 		msg1 := generateSignedMessage(1)
 		ethMsg1 := generateEthMessage(1)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
-		compareEthSignatureMessageLists(t, []*core.EthereumSignature{ethMsg, ethMsg1}, sh.ethMessages)
+		compareEthSignatureMessageLists(t, []*core.EthereumSignature{ethMsg, ethMsg1}, getAllEthMessages(sh))
 		compareSignedMessageLists(t, []*core.SignedMessage{msg, msg1}, sh.AllStoredSignatures())
 	})
 }
@@ -85,7 +159,7 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 		msg1 := generateSignedMessage(1)
 		ethMsg1 := generateEthMessage(1)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
 
@@ -108,7 +182,7 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 		ethMsg2 := generateEthMessage(2)
 		ethMsg2.Signature = ethMsg1.Signature
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
 		sh.ProcessNewMessage(msg2, ethMsg2)
@@ -128,7 +202,7 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 		msg2 := generateSignedMessage(2)
 		ethMsg2 := generateEthMessage(2)
 
-		sh := NewSignatureHolder()
+		sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
 		sh.ProcessNewMessage(msg, ethMsg)
 		sh.ProcessNewMessage(msg1, ethMsg1)
 		sh.ProcessNewMessage(msg2, ethMsg2)
@@ -137,6 +211,110 @@ func TestSignatureHolder_Signatures(t *testing.T) {
 	})
 }
 
+func TestSignatureHolder_Expiry(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
+	sh.getTimeHandler = func() time.Time {
+		return currentTime
+	}
+
+	msg1, ethMsg1 := generateBatchSignature("pk1", "hash", 1)
+	sh.ProcessNewMessage(msg1, ethMsg1)
+
+	currentTime = currentTime.Add(time.Second * 30)
+	msg2, ethMsg2 := generateBatchSignature("pk2", "hash", 1)
+	sh.ProcessNewMessage(msg2, ethMsg2)
+	compareBytesSlicesLists(t, [][]byte{ethMsg1.Signature, ethMsg2.Signature}, sh.Signatures([]byte("hash")))
+
+	currentTime = currentTime.Add(time.Second * 31)
+	compareBytesSlicesLists(t, [][]byte{ethMsg2.Signature}, sh.Signatures([]byte("hash")))
+	assert.Equal(t, []*core.SignedMessage{msg2}, sh.AllStoredSignatures())
+
+	// the expired signatures are removed from memory when processing new messages
+	msg3, ethMsg3 := generateBatchSignature("pk3", "hash", 1)
+	sh.ProcessNewMessage(msg3, ethMsg3)
+	assert.Equal(t, 2, len(getAllEthMessages(sh)))
+}
+
+func TestSignatureHolder_MaxMessageHashesPerSigner(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Now()
+	args := createMockArgsSignaturesHolder()
+	args.MaxMessageHashesPerSigner = 2
+	sh, _ := NewSignatureHolder(args)
+	sh.getTimeHandler = func() time.Time {
+		currentTime = currentTime.Add(time.Millisecond)
+		return currentTime
+	}
+
+	for i := 0; i < 5; i++ {
+		msg, ethMsg := generateBatchSignature("pk1", fmt.Sprintf("hash %d", i), uint64(i+1))
+		sh.ProcessNewMessage(msg, ethMsg)
+	}
+	msg, ethMsg := generateBatchSignature("pk2", "hash 0", 1)
+	sh.ProcessNewMessage(msg, ethMsg)
+
+	assert.Equal(t, 3, len(sh.AllStoredSignatures()))
+	assert.Empty(t, sh.Signatures([]byte("hash 1")))
+	assert.Empty(t, sh.Signatures([]byte("hash 2")))
+	assert.Equal(t, 1, len(sh.Signatures([]byte("hash 3"))))
+	assert.Equal(t, 1, len(sh.Signatures([]byte("hash 4"))))
+	// the other signer's signatures are not affected
+	assert.Equal(t, [][]byte{ethMsg.Signature}, sh.Signatures([]byte("hash 0")))
+}
+
+func TestSignatureHolder_PruneExecutedBatch(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
+
+	msgLegacy, ethMsgLegacy := generateBatchSignature("pk1", "legacy hash", 0)
+	msg1, ethMsg1 := generateBatchSignature("pk1", "hash 1", 1)
+	msg2, ethMsg2 := generateBatchSignature("pk1", "hash 2", 2)
+	msg3, ethMsg3 := generateBatchSignature("pk1", "hash 3", 3)
+	sh.ProcessNewMessage(msgLegacy, ethMsgLegacy)
+	sh.ProcessNewMessage(msg1, ethMsg1)
+	sh.ProcessNewMessage(msg2, ethMsg2)
+	sh.ProcessNewMessage(msg3, ethMsg3)
+
+	sh.PruneExecutedBatch(2)
+	compareSignedMessageLists(t, []*core.SignedMessage{msgLegacy, msg3}, sh.AllStoredSignatures())
+
+	// late signatures for the executed batches are ignored
+	lateMsg, lateEthMsg := generateBatchSignature("pk2", "hash 2", 2)
+	sh.ProcessNewMessage(lateMsg, lateEthMsg)
+	assert.Empty(t, sh.Signatures([]byte("hash 2")))
+
+	// pruning an older batch should not lower the executed batch
+	sh.PruneExecutedBatch(1)
+	sh.ProcessNewMessage(lateMsg, lateEthMsg)
+	assert.Empty(t, sh.Signatures([]byte("hash 2")))
+	compareSignedMessageLists(t, []*core.SignedMessage{msgLegacy, msg3}, sh.AllStoredSignatures())
+}
+
+func TestSignatureHolder_ClearStoredSignaturesShouldKeepInFlightBatches(t *testing.T) {
+	t.Parallel()
+
+	sh, _ := NewSignatureHolder(createMockArgsSignaturesHolder())
+
+	msgLegacy, ethMsgLegacy := generateBatchSignature("pk1", "legacy hash", 0)
+	msg1, ethMsg1 := generateBatchSignature("pk1", "hash 1", 1)
+	msg2, ethMsg2 := generateBatchSignature("pk1", "hash 2", 2)
+	sh.ProcessNewMessage(msgLegacy, ethMsgLegacy)
+	sh.ProcessNewMessage(msg1, ethMsg1)
+	sh.ProcessNewMessage(msg2, ethMsg2)
+
+	sh.ClearStoredSignatures()
+	compareSignedMessageLists(t, []*core.SignedMessage{msg1, msg2}, sh.AllStoredSignatures())
+
+	sh.PruneExecutedBatch(1)
+	sh.ClearStoredSignatures()
+	assert.Equal(t, []*core.SignedMessage{msg2}, sh.AllStoredSignatures())
+}
+
 func compareSignedMessageLists(t *testing.T, list1 []*core.SignedMessage, list2 []*core.SignedMessage) {
 	require.Equal(t, len(list1), len(list2))
 	for _, obj1 := range list1 {
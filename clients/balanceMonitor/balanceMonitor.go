@@ -0,0 +1,133 @@
+package balanceMonitor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsBalanceMonitor represents the DTO struct used in the NewBalanceMonitor constructor function
+type ArgsBalanceMonitor struct {
+	Log           logger.Logger
+	ChainName     string
+	FundsHandler  RelayerFundsHandler
+	StatusHandler core.StatusHandler
+	Config        config.BalanceMonitorConfig
+}
+
+type balanceMonitor struct {
+	log                       logger.Logger
+	chainName                 string
+	fundsHandler              RelayerFundsHandler
+	statusHandler             core.StatusHandler
+	estimatedDepositsPerBatch uint64
+	minBatchesThreshold       uint64
+	isBelowThreshold          bool
+}
+
+// NewBalanceMonitor creates a new instance of type balanceMonitor
+func NewBalanceMonitor(args ArgsBalanceMonitor) (*balanceMonitor, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balanceMonitor{
+		log:                       args.Log,
+		chainName:                 args.ChainName,
+		fundsHandler:              args.FundsHandler,
+		statusHandler:             args.StatusHandler,
+		estimatedDepositsPerBatch: args.Config.EstimatedDepositsPerBatch,
+		minBatchesThreshold:       args.Config.MinBatchesThreshold,
+	}, nil
+}
+
+func checkArgs(args ArgsBalanceMonitor) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if len(args.ChainName) == 0 {
+		return ErrEmptyChainName
+	}
+	if check.IfNil(args.FundsHandler) {
+		return ErrNilFundsHandler
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if args.Config.EstimatedDepositsPerBatch == 0 {
+		return fmt.Errorf("%w: should be greater than 0", ErrInvalidEstimatedDepositsPerBatch)
+	}
+
+	return nil
+}
+
+// Execute fetches the relayer's balance, estimates how many batches it can still pay for, publishes the metrics and
+// raises a warning if the number of remaining batches dropped below the configured threshold
+func (monitor *balanceMonitor) Execute(ctx context.Context) error {
+	balance, err := monitor.fundsHandler.GetRelayerBalance(ctx)
+	if err != nil {
+		return err
+	}
+	if balance == nil {
+		return ErrNilBalance
+	}
+
+	batchFee, err := monitor.fundsHandler.EstimateBatchFee(ctx, monitor.estimatedDepositsPerBatch)
+	if err != nil {
+		return err
+	}
+	if batchFee == nil {
+		return ErrNilBatchFee
+	}
+
+	monitor.statusHandler.SetStringMetric(core.MetricRelayerBalance, balance.String())
+	monitor.statusHandler.SetStringMetric(core.MetricRelayerEstimatedBatchFee, batchFee.String())
+
+	if batchFee.Sign() <= 0 {
+		monitor.log.Debug("balanceMonitor: estimated batch fee is 0, skipping the remaining batches computation",
+			"chain", monitor.chainName, "balance", balance.String())
+		return nil
+	}
+
+	remainingBatches := big.NewInt(0).Div(balance, batchFee)
+	numRemainingBatches := uint64(math.MaxInt)
+	if remainingBatches.IsUint64() && remainingBatches.Uint64() < numRemainingBatches {
+		numRemainingBatches = remainingBatches.Uint64()
+	}
+	monitor.statusHandler.SetIntMetric(core.MetricRelayerRemainingBatches, int(numRemainingBatches))
+
+	monitor.checkThreshold(balance, batchFee, numRemainingBatches)
+
+	return nil
+}
+
+func (monitor *balanceMonitor) checkThreshold(balance *big.Int, batchFee *big.Int, numRemainingBatches uint64) {
+	isBelowThreshold := numRemainingBatches < monitor.minBatchesThreshold
+	defer func() {
+		monitor.isBelowThreshold = isBelowThreshold
+	}()
+
+	if isBelowThreshold && !monitor.isBelowThreshold {
+		warning := fmt.Sprintf("low relayer funds on %s: balance %s can pay for %d more batch(es), threshold is %d",
+			monitor.chainName, balance.String(), numRemainingBatches, monitor.minBatchesThreshold)
+		monitor.log.Warn("balanceMonitor: " + warning)
+		monitor.statusHandler.SetStringMetric(core.MetricLastError, warning)
+		return
+	}
+	if !isBelowThreshold && monitor.isBelowThreshold {
+		monitor.log.Info("balanceMonitor: relayer funds recovered", "chain", monitor.chainName,
+			"balance", balance.String(), "batch fee", batchFee.String(), "remaining batches", numRemainingBatches)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (monitor *balanceMonitor) IsInterfaceNil() bool {
+	return monitor == nil
+}
@@ -0,0 +1,252 @@
+package balanceMonitor
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsBalanceMonitor() ArgsBalanceMonitor {
+	return ArgsBalanceMonitor{
+		Log:           &testsCommon.LoggerStub{},
+		ChainName:     "Ethereum",
+		FundsHandler:  &testsCommon.RelayerFundsHandlerStub{},
+		StatusHandler: testsCommon.NewStatusHandlerMock("test"),
+		Config: config.BalanceMonitorConfig{
+			Enabled:                   true,
+			PollingIntervalInSeconds:  60,
+			EstimatedDepositsPerBatch: 10,
+			MinBatchesThreshold:       5,
+		},
+	}
+}
+
+func createFundsHandler(balance *big.Int, feePerDeposit int64) *testsCommon.RelayerFundsHandlerStub {
+	return &testsCommon.RelayerFundsHandlerStub{
+		GetRelayerBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(0).Set(balance), nil
+		},
+		EstimateBatchFeeCalled: func(ctx context.Context, numDeposits uint64) (*big.Int, error) {
+			return big.NewInt(feePerDeposit * int64(numDeposits)), nil
+		},
+	}
+}
+
+func TestNewBalanceMonitor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.Log = nil
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("empty chain name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.ChainName = ""
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrEmptyChainName, err)
+	})
+	t.Run("nil funds handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.FundsHandler = nil
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrNilFundsHandler, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.StatusHandler = nil
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("invalid estimated deposits per batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.Config.EstimatedDepositsPerBatch = 0
+
+		monitor, err := NewBalanceMonitor(args)
+		assert.True(t, check.IfNil(monitor))
+		assert.ErrorIs(t, err, ErrInvalidEstimatedDepositsPerBatch)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		monitor, err := NewBalanceMonitor(createMockArgsBalanceMonitor())
+		assert.False(t, check.IfNil(monitor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBalanceMonitor_Execute(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	t.Run("get relayer balance errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.FundsHandler = &testsCommon.RelayerFundsHandlerStub{
+			GetRelayerBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("nil balance should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.FundsHandler = &testsCommon.RelayerFundsHandlerStub{
+			GetRelayerBalanceCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, nil
+			},
+		}
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Equal(t, ErrNilBalance, err)
+	})
+	t.Run("estimate batch fee errors should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.FundsHandler = &testsCommon.RelayerFundsHandlerStub{
+			EstimateBatchFeeCalled: func(ctx context.Context, numDeposits uint64) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("nil batch fee should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.FundsHandler = &testsCommon.RelayerFundsHandlerStub{
+			EstimateBatchFeeCalled: func(ctx context.Context, numDeposits uint64) (*big.Int, error) {
+				return nil, nil
+			},
+		}
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Equal(t, ErrNilBatchFee, err)
+	})
+	t.Run("zero batch fee should only publish the balance and the fee", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.FundsHandler = createFundsHandler(big.NewInt(100), 0)
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, "100", statusHandler.GetStringMetric(core.MetricRelayerBalance))
+		assert.Equal(t, "0", statusHandler.GetStringMetric(core.MetricRelayerEstimatedBatchFee))
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricRelayerRemainingBatches))
+		assert.Empty(t, statusHandler.GetStringMetric(core.MetricLastError))
+	})
+	t.Run("enough funds should publish the metrics without warning", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBalanceMonitor()
+		args.FundsHandler = createFundsHandler(big.NewInt(10000), 100)
+		var numDepositsRequested uint64
+		args.FundsHandler.(*testsCommon.RelayerFundsHandlerStub).EstimateBatchFeeCalled = func(ctx context.Context, numDeposits uint64) (*big.Int, error) {
+			numDepositsRequested = numDeposits
+			return big.NewInt(int64(100 * numDeposits)), nil
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, args.Config.EstimatedDepositsPerBatch, numDepositsRequested)
+		assert.Equal(t, "10000", statusHandler.GetStringMetric(core.MetricRelayerBalance))
+		assert.Equal(t, "1000", statusHandler.GetStringMetric(core.MetricRelayerEstimatedBatchFee))
+		assert.Equal(t, 10, statusHandler.GetIntMetric(core.MetricRelayerRemainingBatches))
+		assert.Empty(t, statusHandler.GetStringMetric(core.MetricLastError))
+	})
+	t.Run("crossing the threshold should raise the warning once", func(t *testing.T) {
+		t.Parallel()
+
+		balance := big.NewInt(10000)
+		args := createMockArgsBalanceMonitor()
+		args.FundsHandler = createFundsHandler(balance, 100)
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		numWarnings := 0
+		args.Log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, args ...interface{}) {
+				numWarnings++
+			},
+		}
+		monitor, _ := NewBalanceMonitor(args)
+
+		err := monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Empty(t, statusHandler.GetStringMetric(core.MetricLastError))
+		assert.Equal(t, 0, numWarnings)
+
+		balance.SetInt64(4500)
+		err = monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 4, statusHandler.GetIntMetric(core.MetricRelayerRemainingBatches))
+		expectedWarning := "low relayer funds on Ethereum: balance 4500 can pay for 4 more batch(es), threshold is 5"
+		assert.Equal(t, expectedWarning, statusHandler.GetStringMetric(core.MetricLastError))
+		assert.Equal(t, 1, numWarnings)
+
+		// still below the threshold, the warning is not raised again
+		statusHandler.SetStringMetric(core.MetricLastError, "")
+		balance.SetInt64(3000)
+		err = monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 3, statusHandler.GetIntMetric(core.MetricRelayerRemainingBatches))
+		assert.Empty(t, statusHandler.GetStringMetric(core.MetricLastError))
+		assert.Equal(t, 1, numWarnings)
+
+		// funds recovered, then crossed again
+		balance.SetInt64(20000)
+		err = monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 20, statusHandler.GetIntMetric(core.MetricRelayerRemainingBatches))
+
+		balance.SetInt64(100)
+		err = monitor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 0, statusHandler.GetIntMetric(core.MetricRelayerRemainingBatches))
+		assert.Equal(t, 2, numWarnings)
+	})
+}
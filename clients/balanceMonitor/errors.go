@@ -0,0 +1,24 @@
+package balanceMonitor
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger has been provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilFundsHandler signals that a nil relayer funds handler has been provided
+var ErrNilFundsHandler = errors.New("nil relayer funds handler")
+
+// ErrNilStatusHandler signals that a nil status handler has been provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrEmptyChainName signals that an empty chain name has been provided
+var ErrEmptyChainName = errors.New("empty chain name")
+
+// ErrInvalidEstimatedDepositsPerBatch signals that an invalid number of estimated deposits per batch has been provided
+var ErrInvalidEstimatedDepositsPerBatch = errors.New("invalid estimated deposits per batch")
+
+// ErrNilBalance signals that a nil balance has been returned
+var ErrNilBalance = errors.New("nil balance")
+
+// ErrNilBatchFee signals that a nil batch fee has been returned
+var ErrNilBatchFee = errors.New("nil batch fee")
@@ -0,0 +1,14 @@
+package balanceMonitor
+
+import (
+	"context"
+	"math/big"
+)
+
+// RelayerFundsHandler defines the behavior of a chain client able to report the relayer's balance and the
+// estimated fee of a batch
+type RelayerFundsHandler interface {
+	GetRelayerBalance(ctx context.Context) (*big.Int, error)
+	EstimateBatchFee(ctx context.Context, numDeposits uint64) (*big.Int, error)
+	IsInterfaceNil() bool
+}
@@ -174,10 +174,37 @@ func (validator *balanceValidator) computeEthAmount(
 		return nil, err
 	}
 
+	return ComputeEthAmount(ctx, validator.ethereumClient, token, isMintBurn, isNative, ethAmountInPendingBatches)
+}
+
+func (validator *balanceValidator) computeMvxAmount(
+	ctx context.Context,
+	token []byte,
+	isMintBurn bool,
+	isNative bool,
+) (*big.Int, error) {
+	mvxAmountInPendingBatches, err := validator.getTotalTransferAmountInPendingMvxBatches(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return ComputeMvxAmount(ctx, validator.multiversXClient, token, isMintBurn, isNative, mvxAmountInPendingBatches)
+}
+
+// ComputeEthAmount computes the amount of the token accounted by the Ethereum safe contract, excluding the amount
+// found in the pending, un-executed batches
+func ComputeEthAmount(
+	ctx context.Context,
+	balancesGetter EthereumBalancesGetter,
+	token common.Address,
+	isMintBurn bool,
+	isNative bool,
+	ethAmountInPendingBatches *big.Int,
+) (*big.Int, error) {
 	if !isMintBurn {
 		// we need to subtract all locked balances on the Ethereum side (all pending, un-executed batches) so the balances
 		// with the minted MultiversX tokens will match
-		total, errTotal := validator.ethereumClient.TotalBalances(ctx, token)
+		total, errTotal := balancesGetter.TotalBalances(ctx, token)
 		if errTotal != nil {
 			return nil, errTotal
 		}
@@ -185,11 +212,11 @@ func (validator *balanceValidator) computeEthAmount(
 		return total.Sub(total, ethAmountInPendingBatches), nil
 	}
 
-	burnBalances, err := validator.ethereumClient.BurnBalances(ctx, token)
+	burnBalances, err := balancesGetter.BurnBalances(ctx, token)
 	if err != nil {
 		return nil, err
 	}
-	mintBalances, err := validator.ethereumClient.MintBalances(ctx, token)
+	mintBalances, err := balancesGetter.MintBalances(ctx, token)
 	if err != nil {
 		return nil, err
 	}
@@ -210,21 +237,20 @@ func (validator *balanceValidator) computeEthAmount(
 	return ethAmount, nil
 }
 
-func (validator *balanceValidator) computeMvxAmount(
+// ComputeMvxAmount computes the amount of the token accounted by the MultiversX safe contract, excluding the amount
+// found in the pending, un-executed batches
+func ComputeMvxAmount(
 	ctx context.Context,
+	balancesGetter MultiversXBalancesGetter,
 	token []byte,
 	isMintBurn bool,
 	isNative bool,
+	mvxAmountInPendingBatches *big.Int,
 ) (*big.Int, error) {
-	mvxAmountInPendingBatches, err := validator.getTotalTransferAmountInPendingMvxBatches(ctx, token)
-	if err != nil {
-		return nil, err
-	}
-
 	if !isMintBurn {
 		// we need to subtract all locked balances on the MultiversX side (all pending, un-executed batches) so the balances
 		// with the minted Ethereum tokens will match
-		total, errTotal := validator.multiversXClient.TotalBalances(ctx, token)
+		total, errTotal := balancesGetter.TotalBalances(ctx, token)
 		if errTotal != nil {
 			return nil, errTotal
 		}
@@ -232,11 +258,11 @@ func (validator *balanceValidator) computeMvxAmount(
 		return total.Sub(total, mvxAmountInPendingBatches), nil
 	}
 
-	burnBalances, err := validator.multiversXClient.BurnBalances(ctx, token)
+	burnBalances, err := balancesGetter.BurnBalances(ctx, token)
 	if err != nil {
 		return nil, err
 	}
-	mintBalances, err := validator.multiversXClient.MintBalances(ctx, token)
+	mintBalances, err := balancesGetter.MintBalances(ctx, token)
 	if err != nil {
 		return nil, err
 	}
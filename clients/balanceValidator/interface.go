@@ -35,3 +35,17 @@ type EthereumClient interface {
 	WasExecuted(ctx context.Context, mvxBatchID uint64) (bool, error)
 	IsInterfaceNil() bool
 }
+
+// EthereumBalancesGetter defines the operations of the component able to fetch the token balances from the Ethereum safe contract
+type EthereumBalancesGetter interface {
+	TotalBalances(ctx context.Context, token common.Address) (*big.Int, error)
+	MintBalances(ctx context.Context, token common.Address) (*big.Int, error)
+	BurnBalances(ctx context.Context, token common.Address) (*big.Int, error)
+}
+
+// MultiversXBalancesGetter defines the operations of the component able to fetch the token balances from the MultiversX safe contract
+type MultiversXBalancesGetter interface {
+	TotalBalances(ctx context.Context, token []byte) (*big.Int, error)
+	MintBalances(ctx context.Context, token []byte) (*big.Int, error)
+	BurnBalances(ctx context.Context, token []byte) (*big.Int, error)
+}
@@ -6,15 +6,17 @@ import (
 )
 
 const (
-	evmCompatibleChainToMultiversXNameTemplate  = "%sToMultiversX"
-	multiversXToEvmCompatibleChainNameTemplate  = "MultiversXTo%s"
-	baseLogIdTemplate                           = "%sMultiversX-Base"
-	multiversXClientLogIdTemplate               = "%sMultiversX-MultiversXClient"
-	multiversXDataGetterLogIdTemplate           = "%sMultiversX-MultiversXDataGetter"
-	evmCompatibleChainClientLogIdTemplate       = "%sMultiversX-%sClient"
-	multiversXRoleProviderLogIdTemplate         = "%sMultiversX-MultiversXRoleProvider"
-	evmCompatibleChainRoleProviderLogIdTemplate = "%sMultiversX-%sRoleProvider"
-	broadcasterLogIdTemplate                    = "%sMultiversX-Broadcaster"
+	evmCompatibleChainToMultiversXNameTemplate    = "%sToMultiversX"
+	multiversXToEvmCompatibleChainNameTemplate    = "MultiversXTo%s"
+	baseLogIdTemplate                             = "%sMultiversX-Base"
+	multiversXClientLogIdTemplate                 = "%sMultiversX-MultiversXClient"
+	multiversXDataGetterLogIdTemplate             = "%sMultiversX-MultiversXDataGetter"
+	evmCompatibleChainClientLogIdTemplate         = "%sMultiversX-%sClient"
+	multiversXRoleProviderLogIdTemplate           = "%sMultiversX-MultiversXRoleProvider"
+	evmCompatibleChainRoleProviderLogIdTemplate   = "%sMultiversX-%sRoleProvider"
+	broadcasterLogIdTemplate                      = "%sMultiversX-Broadcaster"
+	multiversXBalanceMonitorLogIdTemplate         = "%sMultiversX-MultiversXBalanceMonitor"
+	evmCompatibleChainBalanceMonitorLogIdTemplate = "%sMultiversX-%sBalanceMonitor"
 )
 
 // Chain defines all the chain supported
@@ -34,6 +36,13 @@ const (
 	Polygon Chain = "Polygon"
 )
 
+// knownChainIDs holds the chain IDs of the public networks (mainnet & testnets) of each EVM compatible chain
+var knownChainIDs = map[Chain][]uint64{
+	Ethereum: {1, 11155111, 17000}, // mainnet, sepolia, holesky
+	Bsc:      {56, 97},             // mainnet, testnet
+	Polygon:  {137, 80002},         // mainnet, amoy
+}
+
 // ToLower returns the lowercase string of chain
 func (c Chain) ToLower() string {
 	return strings.ToLower(string(c))
@@ -83,3 +92,18 @@ func (c Chain) EvmCompatibleChainRoleProviderLogId() string {
 func (c Chain) BroadcasterLogId() string {
 	return fmt.Sprintf(broadcasterLogIdTemplate, c)
 }
+
+// MultiversXBalanceMonitorLogId returns the string using chain value and multiversXBalanceMonitorLogIdTemplate
+func (c Chain) MultiversXBalanceMonitorLogId() string {
+	return fmt.Sprintf(multiversXBalanceMonitorLogIdTemplate, c)
+}
+
+// EvmCompatibleChainBalanceMonitorLogId returns the string using chain value and evmCompatibleChainBalanceMonitorLogIdTemplate
+func (c Chain) EvmCompatibleChainBalanceMonitorLogId() string {
+	return fmt.Sprintf(evmCompatibleChainBalanceMonitorLogIdTemplate, c, c)
+}
+
+// KnownChainIDs returns the chain IDs of the public networks of the chain
+func (c Chain) KnownChainIDs() []uint64 {
+	return knownChainIDs[c]
+}
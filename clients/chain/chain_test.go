@@ -51,8 +51,25 @@ func Test_broadcasterLogId(t *testing.T) {
 	assert.Equal(t, "BscMultiversX-Broadcaster", Bsc.BroadcasterLogId())
 }
 
+func Test_multiversXBalanceMonitorLogId(t *testing.T) {
+	assert.Equal(t, "EthereumMultiversX-MultiversXBalanceMonitor", Ethereum.MultiversXBalanceMonitorLogId())
+	assert.Equal(t, "BscMultiversX-MultiversXBalanceMonitor", Bsc.MultiversXBalanceMonitorLogId())
+}
+
+func Test_ethBalanceMonitorLogId(t *testing.T) {
+	assert.Equal(t, "EthereumMultiversX-EthereumBalanceMonitor", Ethereum.EvmCompatibleChainBalanceMonitorLogId())
+	assert.Equal(t, "BscMultiversX-BscBalanceMonitor", Bsc.EvmCompatibleChainBalanceMonitorLogId())
+}
+
 func TestToLower(t *testing.T) {
 	assert.Equal(t, "msx", MultiversX.ToLower())
 	assert.Equal(t, "ethereum", Ethereum.ToLower())
 	assert.Equal(t, "bsc", Bsc.ToLower())
 }
+
+func TestKnownChainIDs(t *testing.T) {
+	assert.Equal(t, []uint64{1, 11155111, 17000}, Ethereum.KnownChainIDs())
+	assert.Equal(t, []uint64{56, 97}, Bsc.KnownChainIDs())
+	assert.Equal(t, []uint64{137, 80002}, Polygon.KnownChainIDs())
+	assert.Empty(t, MultiversX.KnownChainIDs())
+}
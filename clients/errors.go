@@ -38,4 +38,10 @@ var (
 
 	// ErrNilCryptoHandler signals that a nil crypto handler was provided
 	ErrNilCryptoHandler = errors.New("nil crypto handler")
+
+	// ErrTransactionReverted signals that the simulation of a transaction reverted
+	ErrTransactionReverted = errors.New("transaction reverted")
+
+	// ErrTransactionNotFinal signals that the transaction was not yet finalized so its cost is not available
+	ErrTransactionNotFinal = errors.New("transaction not final")
 )
@@ -2,6 +2,7 @@ package ethereum
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"sync"
@@ -64,6 +65,10 @@ type client struct {
 	lastBlockNumber          uint64
 	retriesAvailabilityCheck uint64
 	mut                      sync.RWMutex
+
+	mutSignaturesProgress sync.Mutex
+	lastProgress          *bridgeCore.SignaturesProgress
+	lastProgressNumSigs   int
 }
 
 // NewEthereumClient will create a new Ethereum client
@@ -244,15 +249,15 @@ func (c *client) WasExecuted(ctx context.Context, mvxBatchID uint64) (bool, erro
 	return c.clientWrapper.WasBatchExecuted(ctx, big.NewInt(0).SetUint64(mvxBatchID))
 }
 
-// BroadcastSignatureForMessageHash will send the signature for the provided message hash
-func (c *client) BroadcastSignatureForMessageHash(msgHash common.Hash) {
+// BroadcastSignatureForMessageHash will send the signature for the provided message hash of the provided batch
+func (c *client) BroadcastSignatureForMessageHash(msgHash common.Hash, batchID uint64) {
 	signature, err := c.cryptoHandler.Sign(msgHash)
 	if err != nil {
 		c.log.Error("error generating signature", "msh hash", msgHash, "error", err)
 		return
 	}
 
-	c.broadcaster.BroadcastSignature(signature, msgHash.Bytes())
+	c.broadcaster.BroadcastSignature(signature, msgHash.Bytes(), batchID)
 }
 
 // GenerateMessageHash will generate the message hash based on the provided batch
@@ -375,6 +380,13 @@ func (c *client) ExecuteTransfer(
 	}
 
 	batchID := big.NewInt(0).SetUint64(batchId)
+	err = c.clientWrapper.SimulateExecuteTransfer(ctx, c.cryptoHandler.GetAddress(), argLists.EthTokens, argLists.Recipients, argLists.Amounts, argLists.Nonces, batchID, signatures)
+	if err != nil {
+		c.log.Warn("the execute transfer simulation failed, the transaction will not be sent",
+			"batchID", batchID, "error", err)
+		return "", fmt.Errorf("%w in client.ExecuteTransfer", err)
+	}
+
 	tx, err := c.clientWrapper.ExecuteTransfer(auth, argLists.EthTokens, argLists.Recipients, argLists.Amounts, argLists.Nonces, batchID, signatures)
 	if err != nil {
 		return "", err
@@ -506,6 +518,56 @@ func (c *client) checkRelayerFundsForFee(ctx context.Context, transferFee *big.I
 	return nil
 }
 
+// GetRelayerBalance returns the native balance of the relayer
+func (c *client) GetRelayerBalance(ctx context.Context) (*big.Int, error) {
+	return c.clientWrapper.BalanceAt(ctx, c.cryptoHandler.GetAddress(), nil)
+}
+
+// EstimateBatchFee returns the fee paid by the relayer for executing a batch with the provided number of deposits
+// at the current gas price
+func (c *client) EstimateBatchFee(_ context.Context, numDeposits uint64) (*big.Int, error) {
+	gasPrice, err := c.gasHandler.GetCurrentGasPrice()
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := c.transferGasLimitBase + numDeposits*c.transferGasLimitForEach
+
+	return big.NewInt(0).Mul(big.NewInt(0).SetUint64(gasLimit), gasPrice), nil
+}
+
+// GetTransactionCost returns the gas used and the fee paid by the provided transaction, as found in its receipt
+func (c *client) GetTransactionCost(ctx context.Context, txHash string) (uint64, *big.Int, error) {
+	receipt, err := c.clientWrapper.TransactionReceipt(ctx, common.HexToHash(txHash))
+	if errors.Is(err, ethereum.NotFound) || (err == nil && receipt == nil) {
+		return 0, nil, fmt.Errorf("%w, hash %s", clients.ErrTransactionNotFinal, txHash)
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	if receipt.EffectiveGasPrice == nil {
+		return 0, nil, fmt.Errorf("%w: missing effective gas price in the receipt of %s", clients.ErrInvalidValue, txHash)
+	}
+
+	fee := big.NewInt(0).SetUint64(receipt.GasUsed)
+	fee.Mul(fee, receipt.EffectiveGasPrice)
+
+	return receipt.GasUsed, fee, nil
+}
+
+// GetBlockTimestamp returns the timestamp, in seconds, of the block with the provided number
+func (c *client) GetBlockTimestamp(ctx context.Context, blockNumber uint64) (uint64, error) {
+	header, err := c.clientWrapper.HeaderByNumber(ctx, big.NewInt(0).SetUint64(blockNumber))
+	if err != nil {
+		return 0, fmt.Errorf("%w in GetBlockTimestamp, HeaderByNumber call, block %d", err, blockNumber)
+	}
+	if header == nil {
+		return 0, fmt.Errorf("%w: nil header for block %d", clients.ErrInvalidValue, blockNumber)
+	}
+
+	return header.Time, nil
+}
+
 func (c *client) getNonce(ctx context.Context, fromAddress common.Address) (int64, error) {
 	blockNonce, err := c.clientWrapper.BlockNumber(ctx)
 	if err != nil {
@@ -549,6 +611,81 @@ func (c *client) IsQuorumReached(ctx context.Context, msgHash common.Hash) (bool
 	return len(signatures) >= int(quorum.Int64()), nil
 }
 
+// GetSignaturesProgress returns the signatures collection progress for the provided message hash. The signers are
+// recovered from the signatures gathered through the P2P network and are compared against the whitelisted relayers.
+// The progress is only recomputed when the message hash or the number of gathered signatures change
+func (c *client) GetSignaturesProgress(ctx context.Context, msgHash common.Hash) (*bridgeCore.SignaturesProgress, error) {
+	signatures := c.signatureHolder.Signatures(msgHash.Bytes())
+
+	c.mutSignaturesProgress.Lock()
+	defer c.mutSignaturesProgress.Unlock()
+
+	isCached := c.lastProgress != nil && c.lastProgress.MessageHash == msgHash.String() && c.lastProgressNumSigs == len(signatures)
+	if isCached {
+		progressCopy := *c.lastProgress
+		return &progressCopy, nil
+	}
+
+	quorum, err := c.clientWrapper.Quorum(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w in GetSignaturesProgress, Quorum call", err)
+	}
+
+	relayers, err := c.clientWrapper.GetRelayers(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w in GetSignaturesProgress, GetRelayers call", err)
+	}
+
+	signers := make(map[common.Address]struct{})
+	for _, signature := range signatures {
+		address, errRecover := RecoverSigner(msgHash, signature)
+		if errRecover != nil {
+			c.log.Debug("can not recover the signer", "msg hash", msgHash, "error", errRecover)
+			continue
+		}
+
+		signers[address] = struct{}{}
+	}
+
+	progress := &bridgeCore.SignaturesProgress{
+		MessageHash:    msgHash.String(),
+		Quorum:         int(quorum.Int64()),
+		Signers:        make([]string, 0, len(signers)),
+		MissingSigners: make([]string, 0, len(relayers)),
+	}
+	for _, relayer := range relayers {
+		_, signed := signers[relayer]
+		if signed {
+			progress.Signers = append(progress.Signers, relayer.String())
+			continue
+		}
+
+		progress.MissingSigners = append(progress.MissingSigners, relayer.String())
+	}
+	progress.NumSignatures = len(progress.Signers)
+
+	c.lastProgress = progress
+	c.lastProgressNumSigs = len(signatures)
+
+	progressCopy := *progress
+	return &progressCopy, nil
+}
+
+// RecoverSigner returns the Ethereum address of the account that signed the provided message hash
+func RecoverSigner(msgHash common.Hash, signature []byte) (common.Address, error) {
+	pkBytes, err := crypto.Ecrecover(msgHash.Bytes(), signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	pk, err := crypto.UnmarshalPubkey(pkBytes)
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	return crypto.PubkeyToAddress(*pk), nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (c *client) IsInterfaceNil() bool {
 	return c == nil
@@ -13,6 +13,7 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/multiversx/mx-bridge-eth-go/clients"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
 	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
@@ -479,7 +480,7 @@ func TestClient_BroadcastSignatureForMessageHash(t *testing.T) {
 		hash := common.HexToHash("hash")
 		args := createMockEthereumClientArgs()
 		args.Broadcaster = &testsCommon.BroadcasterStub{
-			BroadcastSignatureCalled: func(signature []byte, messageHash []byte) {
+			BroadcastSignatureCalled: func(signature []byte, messageHash []byte, batchID uint64) {
 				assert.Fail(t, "should have not called bradcast")
 			},
 		}
@@ -491,7 +492,7 @@ func TestClient_BroadcastSignatureForMessageHash(t *testing.T) {
 		}
 
 		c, _ := NewEthereumClient(args)
-		c.BroadcastSignatureForMessageHash(hash)
+		c.BroadcastSignatureForMessageHash(hash, 1)
 	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
@@ -502,8 +503,9 @@ func TestClient_BroadcastSignatureForMessageHash(t *testing.T) {
 		hash := common.HexToHash("hash")
 		args := createMockEthereumClientArgs()
 		args.Broadcaster = &testsCommon.BroadcasterStub{
-			BroadcastSignatureCalled: func(signature []byte, messageHash []byte) {
+			BroadcastSignatureCalled: func(signature []byte, messageHash []byte, batchID uint64) {
 				assert.Equal(t, hash.Bytes(), messageHash)
+				assert.Equal(t, uint64(1), batchID)
 				assert.Equal(t, expectedSig, string(signature))
 				broadcastCalled = true
 			},
@@ -516,7 +518,7 @@ func TestClient_BroadcastSignatureForMessageHash(t *testing.T) {
 		}
 
 		c, _ := NewEthereumClient(args)
-		c.BroadcastSignatureForMessageHash(hash)
+		c.BroadcastSignatureForMessageHash(hash, 1)
 
 		assert.True(t, broadcastCalled)
 	})
@@ -697,6 +699,42 @@ func TestClient_ExecuteTransfer(t *testing.T) {
 		assert.Equal(t, "", hash)
 		assert.True(t, errors.Is(err, errInsufficientBalance))
 	})
+	t.Run("simulation reverts should not send the transaction", func(t *testing.T) {
+		c, _ := NewEthereumClient(args)
+		c.signatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				return signatures[:9]
+			},
+		}
+		c.erc20ContractsHandler = &bridgeTests.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				return big.NewInt(10000), nil
+			},
+		}
+		revertErr := &clients.RevertError{Reason: "Not enough signatures"}
+		c.clientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			SimulateExecuteTransferCalled: func(ctx context.Context, from common.Address, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) error {
+				assert.Equal(t, c.cryptoHandler.GetAddress(), from)
+				assert.Equal(t, expectedTokens, tokens)
+				assert.Equal(t, expectedRecipients, recipients)
+				assert.Equal(t, expectedAmounts, amounts)
+				assert.Equal(t, expectedNonces, nonces)
+				assert.Equal(t, big.NewInt(332), batchNonce)
+				assert.Equal(t, signatures[:9], sigs)
+
+				return revertErr
+			},
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) (*types.Transaction, error) {
+				assert.Fail(t, "should have not called ExecuteTransfer")
+				return nil, nil
+			},
+		}
+
+		hash, err := c.ExecuteTransfer(context.Background(), common.Hash{}, argLists, batch.ID, 9)
+		assert.Equal(t, "", hash)
+		assert.ErrorIs(t, err, clients.ErrTransactionReverted)
+		assert.ErrorIs(t, err, revertErr)
+	})
 	t.Run("execute transfer errors", func(t *testing.T) {
 		expectedErr := errors.New("expected error execute transfer")
 		c, _ := NewEthereumClient(args)
@@ -1166,6 +1204,134 @@ func TestClient_IsQuorumReached(t *testing.T) {
 	})
 }
 
+func TestClient_GetSignaturesProgress(t *testing.T) {
+	t.Parallel()
+
+	msgHash := common.HexToHash("0x0102")
+	t.Run("quorum errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		progress, err := c.GetSignaturesProgress(context.Background(), msgHash)
+		assert.Nil(t, progress)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("get relayers errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(2), nil
+			},
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		progress, err := c.GetSignaturesProgress(context.Background(), msgHash)
+		assert.Nil(t, progress)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sk1, _ := crypto.GenerateKey()
+		sk2, _ := crypto.GenerateKey()
+		sk3, _ := crypto.GenerateKey()
+		relayer1 := crypto.PubkeyToAddress(sk1.PublicKey)
+		relayer2 := crypto.PubkeyToAddress(sk2.PublicKey)
+		relayer3 := crypto.PubkeyToAddress(sk3.PublicKey)
+		sig1, _ := crypto.Sign(msgHash.Bytes(), sk1)
+		sig3, _ := crypto.Sign(msgHash.Bytes(), sk3)
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(2), nil
+			},
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return []common.Address{relayer1, relayer2, relayer3}, nil
+			},
+		}
+		args.SignatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				assert.Equal(t, msgHash.Bytes(), messageHash)
+				return [][]byte{sig1, sig3, []byte("invalid signature")}
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		progress, err := c.GetSignaturesProgress(context.Background(), msgHash)
+		assert.Nil(t, err)
+		expectedProgress := &bridgeCore.SignaturesProgress{
+			MessageHash:    msgHash.String(),
+			Quorum:         2,
+			NumSignatures:  2,
+			Signers:        []string{relayer1.String(), relayer3.String()},
+			MissingSigners: []string{relayer2.String()},
+		}
+		assert.Equal(t, expectedProgress, progress)
+	})
+	t.Run("should recompute only when the number of signatures changes", func(t *testing.T) {
+		t.Parallel()
+
+		sk1, _ := crypto.GenerateKey()
+		sk2, _ := crypto.GenerateKey()
+		relayer1 := crypto.PubkeyToAddress(sk1.PublicKey)
+		relayer2 := crypto.PubkeyToAddress(sk2.PublicKey)
+		sig1, _ := crypto.Sign(msgHash.Bytes(), sk1)
+		sig2, _ := crypto.Sign(msgHash.Bytes(), sk2)
+
+		numGetRelayersCalls := 0
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(2), nil
+			},
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				numGetRelayersCalls++
+				return []common.Address{relayer1, relayer2}, nil
+			},
+		}
+		signatures := [][]byte{sig1}
+		args.SignatureHolder = &testsCommon.SignaturesHolderStub{
+			SignaturesCalled: func(messageHash []byte) [][]byte {
+				return signatures
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		progress, _ := c.GetSignaturesProgress(context.Background(), msgHash)
+		assert.Equal(t, 1, progress.NumSignatures)
+		progress.BatchID = 37 // the caller changes should not alter the cached progress
+
+		progress, _ = c.GetSignaturesProgress(context.Background(), msgHash)
+		assert.Equal(t, 1, progress.NumSignatures)
+		assert.Zero(t, progress.BatchID)
+		assert.Equal(t, 1, numGetRelayersCalls)
+
+		signatures = append(signatures, sig2)
+		progress, _ = c.GetSignaturesProgress(context.Background(), msgHash)
+		assert.Equal(t, 2, progress.NumSignatures)
+		assert.Equal(t, 2, numGetRelayersCalls)
+
+		progress, _ = c.GetSignaturesProgress(context.Background(), common.HexToHash("0x0103"))
+		assert.Equal(t, 0, progress.NumSignatures)
+		assert.Equal(t, 3, numGetRelayersCalls)
+	})
+}
+
 func TestClient_CheckClientAvailability(t *testing.T) {
 	t.Parallel()
 
@@ -1256,6 +1422,142 @@ func TestClient_CheckClientAvailability(t *testing.T) {
 	})
 }
 
+func TestClient_GetRelayerBalance(t *testing.T) {
+	t.Parallel()
+
+	relayerAddress := testsCommon.CreateRandomEthereumAddress()
+	args := createMockEthereumClientArgs()
+	args.CryptoHandler = &bridgeTests.CryptoHandlerStub{
+		GetAddressCalled: func() common.Address {
+			return relayerAddress
+		},
+	}
+	args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+		BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+			assert.Equal(t, relayerAddress, account)
+			assert.Nil(t, blockNumber)
+
+			return big.NewInt(1000), nil
+		},
+	}
+	c, _ := NewEthereumClient(args)
+
+	balance, err := c.GetRelayerBalance(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), balance)
+}
+
+func TestClient_EstimateBatchFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("gas handler errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.GasHandler = &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceCalled: func() (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		fee, err := c.EstimateBatchFee(context.Background(), 10)
+		assert.Nil(t, fee)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.GasHandler = &testsCommon.GasHandlerStub{
+			GetCurrentGasPriceCalled: func() (*big.Int, error) {
+				return big.NewInt(3), nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		fee, err := c.EstimateBatchFee(context.Background(), 10)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt((50+10*20)*3), fee)
+	})
+}
+
+func TestClient_GetTransactionCost(t *testing.T) {
+	t.Parallel()
+
+	txHash := "0x6b4c1e4b8a4c8e8fa1d53dd1c36b8bbb2cf1f1cd4b11a0a0f3e0e35b2c9b6a6f"
+	t.Run("receipt not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			TransactionReceiptCalled: func(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+				return nil, ethereum.NotFound
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.ErrorIs(t, err, clients.ErrTransactionNotFinal)
+		assert.Zero(t, gasUsed)
+		assert.Nil(t, fee)
+	})
+	t.Run("receipt fetch errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			TransactionReceiptCalled: func(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.Equal(t, expectedErr, err)
+		assert.Zero(t, gasUsed)
+		assert.Nil(t, fee)
+	})
+	t.Run("missing effective gas price should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			TransactionReceiptCalled: func(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+				return &types.Receipt{GasUsed: 100}, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.ErrorIs(t, err, clients.ErrInvalidValue)
+		assert.Zero(t, gasUsed)
+		assert.Nil(t, fee)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			TransactionReceiptCalled: func(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+				assert.Equal(t, common.HexToHash(txHash), hash)
+				return &types.Receipt{
+					GasUsed:           100,
+					EffectiveGasPrice: big.NewInt(7),
+				}, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(100), gasUsed)
+		assert.Equal(t, big.NewInt(700), fee)
+	})
+}
+
 func TestClient_GetBatchSCMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -1320,3 +1622,55 @@ func checkStatusHandler(t *testing.T, statusHandler *testsCommon.StatusHandlerMo
 	assert.Equal(t, status.String(), statusHandler.GetStringMetric(bridgeCore.MetricMultiversXClientStatus))
 	assert.Equal(t, message, statusHandler.GetStringMetric(bridgeCore.MetricLastMultiversXClientError))
 }
+
+func TestClient_GetBlockTimestamp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("header fetch errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			HeaderByNumberCalled: func(ctx context.Context, number *big.Int) (*types.Header, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		timestamp, err := c.GetBlockTimestamp(context.Background(), 37)
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Zero(t, timestamp)
+	})
+	t.Run("nil header should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			HeaderByNumberCalled: func(ctx context.Context, number *big.Int) (*types.Header, error) {
+				return nil, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		timestamp, err := c.GetBlockTimestamp(context.Background(), 37)
+		assert.ErrorIs(t, err, clients.ErrInvalidValue)
+		assert.Zero(t, timestamp)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockEthereumClientArgs()
+		args.ClientWrapper = &bridgeTests.EthereumClientWrapperStub{
+			HeaderByNumberCalled: func(ctx context.Context, number *big.Int) (*types.Header, error) {
+				assert.Equal(t, big.NewInt(37), number)
+				return &types.Header{Time: 1715000000}, nil
+			},
+		}
+		c, _ := NewEthereumClient(args)
+
+		timestamp, err := c.GetBlockTimestamp(context.Background(), 37)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1715000000), timestamp)
+	})
+}
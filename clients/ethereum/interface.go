@@ -25,6 +25,9 @@ type ClientWrapper interface {
 	ExecuteTransfer(opts *bind.TransactOpts, tokens []common.Address,
 		recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int,
 		signatures [][]byte) (*types.Transaction, error)
+	SimulateExecuteTransfer(ctx context.Context, from common.Address, tokens []common.Address,
+		recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int,
+		signatures [][]byte) error
 	Quorum(ctx context.Context) (*big.Int, error)
 	GetStatusesAfterExecution(ctx context.Context, batchID *big.Int) ([]byte, bool, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -36,6 +39,8 @@ type ClientWrapper interface {
 	WhitelistedTokens(ctx context.Context, arg0 common.Address) (bool, error)
 	IsPaused(ctx context.Context) (bool, error)
 	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 }
 
 // Erc20ContractsHolder defines the Ethereum ERC20 contract operations
@@ -47,7 +52,7 @@ type Erc20ContractsHolder interface {
 
 // Broadcaster defines the operations for a component used for communication with other peers
 type Broadcaster interface {
-	BroadcastSignature(signature []byte, messageHash []byte)
+	BroadcastSignature(signature []byte, messageHash []byte, batchID uint64)
 	IsInterfaceNil() bool
 }
 
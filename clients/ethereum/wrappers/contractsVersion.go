@@ -0,0 +1,138 @@
+package wrappers
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	contractV2 "github.com/multiversx/mx-bridge-eth-go/executors/ethereum/bridgeV2Wrappers/contract"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const getBatchFunction = "getBatch"
+
+// ArgsVersionedEthereumChainWrapper is the DTO used to construct an ethereumChainWrapper instance that works with
+// the provided contracts version
+type ArgsVersionedEthereumChainWrapper struct {
+	StatusHandler           core.StatusHandler
+	ContractsVersion        core.ContractsVersion
+	MultiSigContractAddress common.Address
+	SafeContractAddress     common.Address
+	BlockchainClient        contractsBackend
+}
+
+// NewVersionedEthereumChainWrapper binds the multisig and the safe contracts of the provided version and creates
+// a new instance of type ethereumChainWrapper on top of them
+func NewVersionedEthereumChainWrapper(args ArgsVersionedEthereumChainWrapper) (*ethereumChainWrapper, error) {
+	if check.IfNilReflect(args.BlockchainClient) {
+		return nil, errNilBlockchainClient
+	}
+
+	multiSig, safe, err := createContracts(args)
+	if err != nil {
+		return nil, err
+	}
+
+	argsWrapper := ArgsEthereumChainWrapper{
+		StatusHandler:           args.StatusHandler,
+		MultiSigContract:        multiSig,
+		MultiSigContractAddress: args.MultiSigContractAddress,
+		SafeContract:            safe,
+		BlockchainClient:        args.BlockchainClient,
+	}
+
+	return NewEthereumChainWrapper(argsWrapper)
+}
+
+func createContracts(args ArgsVersionedEthereumChainWrapper) (multiSigContract, safeContract, error) {
+	switch args.ContractsVersion {
+	case core.ContractsVersionV3:
+		multiSig, err := contract.NewBridge(args.MultiSigContractAddress, args.BlockchainClient)
+		if err != nil {
+			return nil, nil, err
+		}
+		safe, err := contract.NewERC20Safe(args.SafeContractAddress, args.BlockchainClient)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return multiSig, safe, nil
+	case core.ContractsVersionV2:
+		return createContractsV2(args)
+	}
+
+	return nil, nil, fmt.Errorf("%w: %s", errUnknownContractsVersion, args.ContractsVersion)
+}
+
+func createContractsV2(args ArgsVersionedEthereumChainWrapper) (multiSigContract, safeContract, error) {
+	multiSigV2, err := contractV2.NewBridge(args.MultiSigContractAddress, args.BlockchainClient)
+	if err != nil {
+		return nil, nil, err
+	}
+	safeV2, err := contractV2.NewERC20Safe(args.SafeContractAddress, args.BlockchainClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	argsAdapter := ArgsMultiSigContractV2Adapter{
+		MultiSigContract: multiSigV2,
+		SafeContract:     safeV2,
+		BlockchainClient: args.BlockchainClient,
+	}
+	multiSig, err := NewMultiSigContractV2Adapter(argsAdapter)
+	if err != nil {
+		return nil, nil, err
+	}
+	safe, err := NewSafeContractV2Adapter(safeV2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return multiSig, safe, nil
+}
+
+// DetectContractsVersion calls the getBatch function of the multisig contract and returns the contracts version
+// whose ABI is able to decode the response. The current version also returns the finality flag so it is checked first
+func DetectContractsVersion(ctx context.Context, caller ethereum.ContractCaller, multiSigContractAddress common.Address) (core.ContractsVersion, error) {
+	if check.IfNilReflect(caller) {
+		return "", errNilBlockchainClient
+	}
+
+	abiV3, err := contract.BridgeMetaData.GetAbi()
+	if err != nil {
+		return "", err
+	}
+	abiV2, err := contractV2.BridgeMetaData.GetAbi()
+	if err != nil {
+		return "", err
+	}
+
+	input, err := abiV3.Pack(getBatchFunction, big.NewInt(0))
+	if err != nil {
+		return "", err
+	}
+
+	output, err := caller.CallContract(ctx, ethereum.CallMsg{
+		To:   &multiSigContractAddress,
+		Data: input,
+	}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = abiV3.Unpack(getBatchFunction, output)
+	if err == nil {
+		return core.ContractsVersionV3, nil
+	}
+
+	_, err = abiV2.Unpack(getBatchFunction, output)
+	if err == nil {
+		return core.ContractsVersionV2, nil
+	}
+
+	return "", fmt.Errorf("%w for the multisig contract %s: %s", errUnknownContractsVersion, multiSigContractAddress.String(), err.Error())
+}
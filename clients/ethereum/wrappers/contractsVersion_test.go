@@ -0,0 +1,157 @@
+package wrappers
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	contractV2 "github.com/multiversx/mx-bridge-eth-go/executors/ethereum/bridgeV2Wrappers/contract"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type contractsBackendStub struct {
+	*interactors.BlockchainClientStub
+	bind.ContractBackend
+}
+
+func (stub *contractsBackendStub) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	return stub.BlockchainClientStub.FilterLogs(ctx, q)
+}
+
+func (stub *contractsBackendStub) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return stub.BlockchainClientStub.CallContract(ctx, call, blockNumber)
+}
+
+func (stub *contractsBackendStub) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return stub.BlockchainClientStub.HeaderByNumber(ctx, number)
+}
+
+func createMockArgsVersionedEthereumChainWrapper() ArgsVersionedEthereumChainWrapper {
+	return ArgsVersionedEthereumChainWrapper{
+		StatusHandler:           testsCommon.NewStatusHandlerMock("mock"),
+		ContractsVersion:        core.ContractsVersionV3,
+		MultiSigContractAddress: common.HexToAddress("0x4444444444444444444444444444444444444444"),
+		SafeContractAddress:     common.HexToAddress("0x5555555555555555555555555555555555555555"),
+		BlockchainClient: &contractsBackendStub{
+			BlockchainClientStub: &interactors.BlockchainClientStub{},
+		},
+	}
+}
+
+func TestNewVersionedEthereumChainWrapper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil blockchain client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVersionedEthereumChainWrapper()
+		args.BlockchainClient = nil
+
+		wrapper, err := NewVersionedEthereumChainWrapper(args)
+		assert.True(t, check.IfNil(wrapper))
+		assert.Equal(t, errNilBlockchainClient, err)
+	})
+	t.Run("unknown version should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVersionedEthereumChainWrapper()
+		args.ContractsVersion = "v1"
+
+		wrapper, err := NewVersionedEthereumChainWrapper(args)
+		assert.True(t, check.IfNil(wrapper))
+		assert.ErrorIs(t, err, errUnknownContractsVersion)
+	})
+	t.Run("v3 should work", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper, err := NewVersionedEthereumChainWrapper(createMockArgsVersionedEthereumChainWrapper())
+		assert.False(t, check.IfNil(wrapper))
+		assert.Nil(t, err)
+		assert.IsType(t, &contract.Bridge{}, wrapper.multiSigContract)
+		assert.IsType(t, &contract.ERC20Safe{}, wrapper.safeContract)
+	})
+	t.Run("v2 should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVersionedEthereumChainWrapper()
+		args.ContractsVersion = core.ContractsVersionV2
+
+		wrapper, err := NewVersionedEthereumChainWrapper(args)
+		assert.False(t, check.IfNil(wrapper))
+		assert.Nil(t, err)
+		assert.IsType(t, &multiSigContractV2Adapter{}, wrapper.multiSigContract)
+		assert.IsType(t, &safeContractV2Adapter{}, wrapper.safeContract)
+	})
+}
+
+func TestDetectContractsVersion(t *testing.T) {
+	t.Parallel()
+
+	multiSigAddress := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	abiV3, _ := contract.BridgeMetaData.GetAbi()
+	abiV2, _ := contractV2.BridgeMetaData.GetAbi()
+	createCaller := func(output []byte, err error) *interactors.BlockchainClientStub {
+		return &interactors.BlockchainClientStub{
+			CallContractCalled: func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+				assert.Equal(t, multiSigAddress, *call.To)
+				assert.Equal(t, abiV3.Methods[getBatchFunction].ID, call.Data[:4])
+
+				return output, err
+			},
+		}
+	}
+
+	t.Run("nil caller should error", func(t *testing.T) {
+		t.Parallel()
+
+		version, err := DetectContractsVersion(context.Background(), nil, multiSigAddress)
+		assert.Empty(t, version)
+		assert.Equal(t, errNilBlockchainClient, err)
+	})
+	t.Run("call errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		version, err := DetectContractsVersion(context.Background(), createCaller(nil, expectedErr), multiSigAddress)
+		assert.Empty(t, version)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("empty response should error", func(t *testing.T) {
+		t.Parallel()
+
+		version, err := DetectContractsVersion(context.Background(), createCaller(nil, nil), multiSigAddress)
+		assert.Empty(t, version)
+		assert.ErrorIs(t, err, errUnknownContractsVersion)
+	})
+	t.Run("v3 response", func(t *testing.T) {
+		t.Parallel()
+
+		output, err := abiV3.Methods[getBatchFunction].Outputs.Pack(contract.Batch{Nonce: big.NewInt(0)}, false)
+		require.Nil(t, err)
+
+		version, err := DetectContractsVersion(context.Background(), createCaller(output, nil), multiSigAddress)
+		assert.Nil(t, err)
+		assert.Equal(t, core.ContractsVersionV3, version)
+	})
+	t.Run("v2 response", func(t *testing.T) {
+		t.Parallel()
+
+		output, err := abiV2.Methods[getBatchFunction].Outputs.Pack(contractV2.Batch{Nonce: big.NewInt(0)})
+		require.Nil(t, err)
+
+		version, err := DetectContractsVersion(context.Background(), createCaller(output, nil), multiSigAddress)
+		assert.Nil(t, err)
+		assert.Equal(t, core.ContractsVersionV2, version)
+	})
+}
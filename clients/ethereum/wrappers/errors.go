@@ -3,8 +3,10 @@ package wrappers
 import "errors"
 
 var (
-	errNilErc20Contract    = errors.New("nil ERC20 contract")
-	errNilBlockchainClient = errors.New("nil blockchain client")
-	errNilMultiSigContract = errors.New("nil multi sig contract")
-	errNilSafeContract     = errors.New("nil safe contract")
+	errNilErc20Contract             = errors.New("nil ERC20 contract")
+	errNilBlockchainClient          = errors.New("nil blockchain client")
+	errNilMultiSigContract          = errors.New("nil multi sig contract")
+	errEmptyMultiSigContractAddress = errors.New("empty multi sig contract address")
+	errNilSafeContract              = errors.New("nil safe contract")
+	errUnknownContractsVersion      = errors.New("unknown contracts version")
 )
@@ -14,19 +14,24 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 )
 
+const executeTransferFunction = "executeTransfer"
+
 // ArgsEthereumChainWrapper is the DTO used to construct a ethereumChainWrapper instance
 type ArgsEthereumChainWrapper struct {
 	StatusHandler    core.StatusHandler
 	MultiSigContract multiSigContract
-	SafeContract     safeContract
-	BlockchainClient blockchainClient
+	// MultiSigContractAddress is the address of the multisig contract, required for simulating its calls
+	MultiSigContractAddress common.Address
+	SafeContract            safeContract
+	BlockchainClient        blockchainClient
 }
 
 type ethereumChainWrapper struct {
 	core.StatusHandler
-	multiSigContract multiSigContract
-	safeContract     safeContract
-	blockchainClient blockchainClient
+	multiSigContract        multiSigContract
+	multiSigContractAddress common.Address
+	safeContract            safeContract
+	blockchainClient        blockchainClient
 }
 
 // NewEthereumChainWrapper creates a new instance of type ethereumChainWrapper
@@ -37,10 +42,11 @@ func NewEthereumChainWrapper(args ArgsEthereumChainWrapper) (*ethereumChainWrapp
 	}
 
 	return &ethereumChainWrapper{
-		StatusHandler:    args.StatusHandler,
-		multiSigContract: args.MultiSigContract,
-		safeContract:     args.SafeContract,
-		blockchainClient: args.BlockchainClient,
+		StatusHandler:           args.StatusHandler,
+		multiSigContract:        args.MultiSigContract,
+		multiSigContractAddress: args.MultiSigContractAddress,
+		safeContract:            args.SafeContract,
+		blockchainClient:        args.BlockchainClient,
 	}, nil
 }
 
@@ -51,6 +57,9 @@ func checkArgs(args ArgsEthereumChainWrapper) error {
 	if check.IfNilReflect(args.MultiSigContract) {
 		return errNilMultiSigContract
 	}
+	if args.MultiSigContractAddress == (common.Address{}) {
+		return errEmptyMultiSigContractAddress
+	}
 	if check.IfNilReflect(args.SafeContract) {
 		return errNilSafeContract
 	}
@@ -122,6 +131,29 @@ func (wrapper *ethereumChainWrapper) ExecuteTransfer(opts *bind.TransactOpts, to
 	return wrapper.multiSigContract.ExecuteTransfer(opts, tokens, recipients, amounts, nonces, batchNonce, signatures)
 }
 
+// SimulateExecuteTransfer runs an eth_call of the execute-transfer function against the pending state, using the
+// provided sender. A *clients.RevertError is returned if the execution reverts
+func (wrapper *ethereumChainWrapper) SimulateExecuteTransfer(ctx context.Context, from common.Address, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) error {
+	contractAbi, err := contract.BridgeMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+
+	input, err := contractAbi.Pack(executeTransferFunction, tokens, recipients, amounts, nonces, batchNonce, signatures)
+	if err != nil {
+		return err
+	}
+
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	_, err = wrapper.blockchainClient.PendingCallContract(ctx, ethereum.CallMsg{
+		From: from,
+		To:   &wrapper.multiSigContractAddress,
+		Data: input,
+	})
+
+	return clients.NewRevertError(err, contractAbi)
+}
+
 // Quorum returns the current set quorum value
 func (wrapper *ethereumChainWrapper) Quorum(ctx context.Context) (*big.Int, error) {
 	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
@@ -141,6 +173,18 @@ func (wrapper *ethereumChainWrapper) BalanceAt(ctx context.Context, account comm
 	return wrapper.blockchainClient.BalanceAt(ctx, account, blockNumber)
 }
 
+// TransactionReceipt returns the receipt of the provided transaction
+func (wrapper *ethereumChainWrapper) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.TransactionReceipt(ctx, txHash)
+}
+
+// HeaderByNumber returns the header of the block with the provided number
+func (wrapper *ethereumChainWrapper) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	return wrapper.blockchainClient.HeaderByNumber(ctx, number)
+}
+
 // TotalBalances returns the total balance of the given token
 func (wrapper *ethereumChainWrapper) TotalBalances(ctx context.Context, token common.Address) (*big.Int, error) {
 	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
@@ -25,13 +25,26 @@ func createMockArgsEthereumChainWrapper() (ArgsEthereumChainWrapper, *testsCommo
 	statusHandler := testsCommon.NewStatusHandlerMock("mock")
 
 	return ArgsEthereumChainWrapper{
-		MultiSigContract: &bridgeTests.MultiSigContractStub{},
-		SafeContract:     &bridgeTests.SafeContractStub{},
-		BlockchainClient: &interactors.BlockchainClientStub{},
-		StatusHandler:    statusHandler,
+		MultiSigContract:        &bridgeTests.MultiSigContractStub{},
+		SafeContract:            &bridgeTests.SafeContractStub{},
+		BlockchainClient:        &interactors.BlockchainClientStub{},
+		StatusHandler:           statusHandler,
+		MultiSigContractAddress: common.HexToAddress("0x4444444444444444444444444444444444444444"),
 	}, statusHandler
 }
 
+type testRevertError struct {
+	data string
+}
+
+func (err *testRevertError) Error() string {
+	return "execution reverted"
+}
+
+func (err *testRevertError) ErrorData() interface{} {
+	return err.data
+}
+
 func TestNewMultiSigContractWrapper(t *testing.T) {
 	t.Parallel()
 
@@ -75,6 +88,16 @@ func TestNewMultiSigContractWrapper(t *testing.T) {
 		assert.True(t, check.IfNil(wrapper))
 		assert.Equal(t, errNilMultiSigContract, err)
 	})
+	t.Run("empty multisig contract address", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsEthereumChainWrapper()
+		args.MultiSigContractAddress = common.Address{}
+
+		wrapper, err := NewEthereumChainWrapper(args)
+		assert.True(t, check.IfNil(wrapper))
+		assert.Equal(t, errEmptyMultiSigContractAddress, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -252,6 +275,78 @@ func TestEthClientWrapper_ExecuteTransfer(t *testing.T) {
 	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientTransactions))
 }
 
+func TestEthClientWrapper_SimulateExecuteTransfer(t *testing.T) {
+	t.Parallel()
+
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	tokens := []common.Address{common.HexToAddress("0x2222222222222222222222222222222222222222")}
+	recipients := []common.Address{common.HexToAddress("0x3333333333333333333333333333333333333333")}
+	amounts := []*big.Int{big.NewInt(37)}
+	nonces := []*big.Int{big.NewInt(1)}
+	batchNonce := big.NewInt(2)
+	signatures := [][]byte{[]byte("signature")}
+
+	t.Run("simulation succeeds should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		args, statusHandler := createMockArgsEthereumChainWrapper()
+		handlerCalled := false
+		args.BlockchainClient = &interactors.BlockchainClientStub{
+			PendingCallContractCalled: func(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+				handlerCalled = true
+				assert.Equal(t, from, call.From)
+				assert.Equal(t, args.MultiSigContractAddress, *call.To)
+
+				contractAbi, _ := contract.BridgeMetaData.GetAbi()
+				expectedData, _ := contractAbi.Pack("executeTransfer", tokens, recipients, amounts, nonces, batchNonce, signatures)
+				assert.Equal(t, expectedData, call.Data)
+
+				return nil, nil
+			},
+		}
+		wrapper, _ := NewEthereumChainWrapper(args)
+		err := wrapper.SimulateExecuteTransfer(context.Background(), from, tokens, recipients, amounts, nonces, batchNonce, signatures)
+		assert.Nil(t, err)
+		assert.True(t, handlerCalled)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+	})
+	t.Run("simulation reverts should return the decoded reason", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createMockArgsEthereumChainWrapper()
+		args.BlockchainClient = &interactors.BlockchainClientStub{
+			PendingCallContractCalled: func(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+				return nil, &testRevertError{
+					data: "0x08c379a0" +
+						"0000000000000000000000000000000000000000000000000000000000000020" +
+						"0000000000000000000000000000000000000000000000000000000000000015" +
+						"4261746368206e6f6e6365206e6f742076616c69640000000000000000000000",
+				}
+			},
+		}
+		wrapper, _ := NewEthereumChainWrapper(args)
+		err := wrapper.SimulateExecuteTransfer(context.Background(), from, tokens, recipients, amounts, nonces, batchNonce, signatures)
+		assert.ErrorIs(t, err, clients.ErrTransactionReverted)
+		revertErr, ok := err.(*clients.RevertError)
+		assert.True(t, ok)
+		assert.Equal(t, "Batch nonce not valid", revertErr.Reason)
+	})
+	t.Run("other errors should be returned unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args, _ := createMockArgsEthereumChainWrapper()
+		args.BlockchainClient = &interactors.BlockchainClientStub{
+			PendingCallContractCalled: func(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+				return nil, expectedErr
+			},
+		}
+		wrapper, _ := NewEthereumChainWrapper(args)
+		err := wrapper.SimulateExecuteTransfer(context.Background(), from, tokens, recipients, amounts, nonces, batchNonce, signatures)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
 func TestEthClientWrapper_Quorum(t *testing.T) {
 	t.Parallel()
 
@@ -351,3 +446,47 @@ func TestEthereumChainWrapper_FilterLogs(t *testing.T) {
 	})
 
 }
+
+func TestEthereumChainWrapper_TransactionReceipt(t *testing.T) {
+	t.Parallel()
+
+	expectedReceipt := &types.Receipt{
+		GasUsed: 37,
+	}
+	expectedTxHash := common.HexToHash("0x1234")
+	args, statusHandler := createMockArgsEthereumChainWrapper()
+	args.BlockchainClient = &interactors.BlockchainClientStub{
+		TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+			assert.Equal(t, expectedTxHash, txHash)
+			return expectedReceipt, nil
+		},
+	}
+	wrapper, _ := NewEthereumChainWrapper(args)
+
+	receipt, err := wrapper.TransactionReceipt(context.Background(), expectedTxHash)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedReceipt, receipt)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
+
+func TestEthereumChainWrapper_HeaderByNumber(t *testing.T) {
+	t.Parallel()
+
+	expectedHeader := &types.Header{
+		Time: 1715000000,
+	}
+	expectedNumber := big.NewInt(37)
+	args, statusHandler := createMockArgsEthereumChainWrapper()
+	args.BlockchainClient = &interactors.BlockchainClientStub{
+		HeaderByNumberCalled: func(ctx context.Context, number *big.Int) (*types.Header, error) {
+			assert.Equal(t, expectedNumber, number)
+			return expectedHeader, nil
+		},
+	}
+	wrapper, _ := NewEthereumChainWrapper(args)
+
+	header, err := wrapper.HeaderByNumber(context.Background(), expectedNumber)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHeader, header)
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumEthClientRequests))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	contractV2 "github.com/multiversx/mx-bridge-eth-go/executors/ethereum/bridgeV2Wrappers/contract"
 )
 
 type genericErc20Contract interface {
@@ -41,5 +42,36 @@ type blockchainClient interface {
 	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
 	ChainID(ctx context.Context) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error)
 	FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+type multiSigContractV2 interface {
+	GetBatch(opts *bind.CallOpts, batchNonce *big.Int) (contractV2.Batch, error)
+	GetBatchDeposits(opts *bind.CallOpts, batchNonce *big.Int) ([]contractV2.Deposit, error)
+	GetRelayers(opts *bind.CallOpts) ([]common.Address, error)
+	WasBatchExecuted(opts *bind.CallOpts, batchNonce *big.Int) (bool, error)
+	ExecuteTransfer(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, depositNonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error)
+	Quorum(opts *bind.CallOpts) (*big.Int, error)
+	GetStatusesAfterExecution(opts *bind.CallOpts, batchID *big.Int) ([]byte, error)
+	Paused(opts *bind.CallOpts) (bool, error)
+	BatchSettleBlockCount(opts *bind.CallOpts) (*big.Int, error)
+	CrossTransferStatuses(opts *bind.CallOpts, batchID *big.Int) (*big.Int, error)
+}
+
+type safeContractV2 interface {
+	TokenBalances(opts *bind.CallOpts, token common.Address) (*big.Int, error)
+	WhitelistedTokens(opts *bind.CallOpts, token common.Address) (bool, error)
+	BatchSettleLimit(opts *bind.CallOpts) (uint8, error)
+}
+
+type blockNumberGetter interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+type contractsBackend interface {
+	blockchainClient
+	bind.ContractBackend
 }
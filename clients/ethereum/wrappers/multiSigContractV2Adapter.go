@@ -0,0 +1,160 @@
+package wrappers
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	contractV2 "github.com/multiversx/mx-bridge-eth-go/executors/ethereum/bridgeV2Wrappers/contract"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ArgsMultiSigContractV2Adapter is the DTO used to construct a multiSigContractV2Adapter instance
+type ArgsMultiSigContractV2Adapter struct {
+	MultiSigContract multiSigContractV2
+	SafeContract     safeContractV2
+	BlockchainClient blockNumberGetter
+}
+
+// multiSigContractV2Adapter exposes the v2 Bridge contract through the operations of the current contract version.
+// The v2 contract does not report the finality of the batches and of the statuses, so it is computed here in the same
+// way the current contract does it
+type multiSigContractV2Adapter struct {
+	multiSigContract multiSigContractV2
+	safeContract     safeContractV2
+	blockchainClient blockNumberGetter
+}
+
+// NewMultiSigContractV2Adapter creates a new instance of type multiSigContractV2Adapter
+func NewMultiSigContractV2Adapter(args ArgsMultiSigContractV2Adapter) (*multiSigContractV2Adapter, error) {
+	if check.IfNilReflect(args.MultiSigContract) {
+		return nil, errNilMultiSigContract
+	}
+	if check.IfNilReflect(args.SafeContract) {
+		return nil, errNilSafeContract
+	}
+	if check.IfNilReflect(args.BlockchainClient) {
+		return nil, errNilBlockchainClient
+	}
+
+	return &multiSigContractV2Adapter{
+		multiSigContract: args.MultiSigContract,
+		safeContract:     args.SafeContract,
+		blockchainClient: args.BlockchainClient,
+	}, nil
+}
+
+// GetBatch returns the batch of transactions by providing the batch nonce
+func (adapter *multiSigContractV2Adapter) GetBatch(opts *bind.CallOpts, batchNonce *big.Int) (contract.Batch, bool, error) {
+	batch, err := adapter.multiSigContract.GetBatch(opts, batchNonce)
+	if err != nil {
+		return contract.Batch{}, false, err
+	}
+
+	isFinal, err := adapter.isBatchFinal(opts, batch)
+	if err != nil {
+		return contract.Batch{}, false, err
+	}
+
+	return contract.Batch(batch), isFinal, nil
+}
+
+// GetBatchDeposits returns the transactions of a batch by providing the batch nonce
+func (adapter *multiSigContractV2Adapter) GetBatchDeposits(opts *bind.CallOpts, batchNonce *big.Int) ([]contract.Deposit, bool, error) {
+	depositsV2, err := adapter.multiSigContract.GetBatchDeposits(opts, batchNonce)
+	if err != nil {
+		return nil, false, err
+	}
+
+	batch, err := adapter.multiSigContract.GetBatch(opts, batchNonce)
+	if err != nil {
+		return nil, false, err
+	}
+
+	isFinal, err := adapter.isBatchFinal(opts, batch)
+	if err != nil {
+		return nil, false, err
+	}
+
+	deposits := make([]contract.Deposit, 0, len(depositsV2))
+	for _, deposit := range depositsV2 {
+		deposits = append(deposits, contract.Deposit(deposit))
+	}
+
+	return deposits, isFinal, nil
+}
+
+func (adapter *multiSigContractV2Adapter) isBatchFinal(opts *bind.CallOpts, batch contractV2.Batch) (bool, error) {
+	settleLimit, err := adapter.safeContract.BatchSettleLimit(opts)
+	if err != nil {
+		return false, err
+	}
+
+	currentBlockNumber, err := adapter.blockchainClient.BlockNumber(opts.Context)
+	if err != nil {
+		return false, err
+	}
+
+	return batch.LastUpdatedBlockNumber+uint64(settleLimit) <= currentBlockNumber, nil
+}
+
+// GetRelayers returns all whitelisted ethereum addresses
+func (adapter *multiSigContractV2Adapter) GetRelayers(opts *bind.CallOpts) ([]common.Address, error) {
+	return adapter.multiSigContract.GetRelayers(opts)
+}
+
+// WasBatchExecuted returns true if the batch was executed
+func (adapter *multiSigContractV2Adapter) WasBatchExecuted(opts *bind.CallOpts, batchNonce *big.Int) (bool, error) {
+	return adapter.multiSigContract.WasBatchExecuted(opts, batchNonce)
+}
+
+// ExecuteTransfer will send an execute-transfer transaction on the ethereum chain
+func (adapter *multiSigContractV2Adapter) ExecuteTransfer(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, depositNonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
+	return adapter.multiSigContract.ExecuteTransfer(opts, tokens, recipients, amounts, depositNonces, batchNonce, signatures)
+}
+
+// Quorum returns the current set quorum value
+func (adapter *multiSigContractV2Adapter) Quorum(opts *bind.CallOpts) (*big.Int, error) {
+	return adapter.multiSigContract.Quorum(opts)
+}
+
+// GetStatusesAfterExecution returns the statuses of the last executed transfer. The statuses are final after
+// the batch settle block count passed since they were set
+func (adapter *multiSigContractV2Adapter) GetStatusesAfterExecution(opts *bind.CallOpts, batchID *big.Int) ([]byte, bool, error) {
+	statuses, err := adapter.multiSigContract.GetStatusesAfterExecution(opts, batchID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	createdBlockNumber, err := adapter.multiSigContract.CrossTransferStatuses(opts, batchID)
+	if err != nil {
+		return nil, false, err
+	}
+
+	settleBlockCount, err := adapter.multiSigContract.BatchSettleBlockCount(opts)
+	if err != nil {
+		return nil, false, err
+	}
+
+	currentBlockNumber, err := adapter.blockchainClient.BlockNumber(opts.Context)
+	if err != nil {
+		return nil, false, err
+	}
+
+	finalBlockNumber := big.NewInt(0).Add(createdBlockNumber, settleBlockCount)
+	isFinal := finalBlockNumber.Cmp(big.NewInt(0).SetUint64(currentBlockNumber)) <= 0
+
+	return statuses, isFinal, nil
+}
+
+// Paused returns true if the multisig contract is paused
+func (adapter *multiSigContractV2Adapter) Paused(opts *bind.CallOpts) (bool, error) {
+	return adapter.multiSigContract.Paused(opts)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adapter *multiSigContractV2Adapter) IsInterfaceNil() bool {
+	return adapter == nil
+}
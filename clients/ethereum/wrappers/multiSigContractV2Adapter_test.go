@@ -0,0 +1,269 @@
+package wrappers
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	contractV2 "github.com/multiversx/mx-bridge-eth-go/executors/ethereum/bridgeV2Wrappers/contract"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsMultiSigContractV2Adapter() ArgsMultiSigContractV2Adapter {
+	return ArgsMultiSigContractV2Adapter{
+		MultiSigContract: &bridgeTests.MultiSigContractV2Stub{},
+		SafeContract:     &bridgeTests.SafeContractV2Stub{},
+		BlockchainClient: &interactors.BlockchainClientStub{},
+	}
+}
+
+func createFinalityArgsMultiSigContractV2Adapter(currentBlockNumber uint64) ArgsMultiSigContractV2Adapter {
+	args := createMockArgsMultiSigContractV2Adapter()
+	args.MultiSigContract = &bridgeTests.MultiSigContractV2Stub{
+		GetBatchCalled: func(opts *bind.CallOpts, batchNonce *big.Int) (contractV2.Batch, error) {
+			return contractV2.Batch{
+				Nonce:                  batchNonce,
+				BlockNumber:            90,
+				LastUpdatedBlockNumber: 100,
+				DepositsCount:          1,
+			}, nil
+		},
+		GetBatchDepositsCalled: func(opts *bind.CallOpts, batchNonce *big.Int) ([]contractV2.Deposit, error) {
+			return []contractV2.Deposit{
+				{
+					Nonce:        big.NewInt(7),
+					TokenAddress: common.HexToAddress("0x1"),
+					Amount:       big.NewInt(1000),
+					Depositor:    common.HexToAddress("0x2"),
+					Recipient:    [32]byte{3},
+					Status:       1,
+				},
+			}, nil
+		},
+		GetStatusesAfterExecutionCalled: func(opts *bind.CallOpts, batchID *big.Int) ([]byte, error) {
+			return []byte{3, 4}, nil
+		},
+		CrossTransferStatusesCalled: func(opts *bind.CallOpts, batchID *big.Int) (*big.Int, error) {
+			return big.NewInt(100), nil
+		},
+		BatchSettleBlockCountCalled: func(opts *bind.CallOpts) (*big.Int, error) {
+			return big.NewInt(40), nil
+		},
+	}
+	args.SafeContract = &bridgeTests.SafeContractV2Stub{
+		BatchSettleLimitCalled: func(opts *bind.CallOpts) (uint8, error) {
+			return 40, nil
+		},
+	}
+	args.BlockchainClient = &interactors.BlockchainClientStub{
+		BlockNumberCalled: func(ctx context.Context) (uint64, error) {
+			return currentBlockNumber, nil
+		},
+	}
+
+	return args
+}
+
+func TestNewMultiSigContractV2Adapter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil multisig contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiSigContractV2Adapter()
+		args.MultiSigContract = nil
+
+		adapter, err := NewMultiSigContractV2Adapter(args)
+		assert.True(t, check.IfNil(adapter))
+		assert.Equal(t, errNilMultiSigContract, err)
+	})
+	t.Run("nil safe contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiSigContractV2Adapter()
+		args.SafeContract = nil
+
+		adapter, err := NewMultiSigContractV2Adapter(args)
+		assert.True(t, check.IfNil(adapter))
+		assert.Equal(t, errNilSafeContract, err)
+	})
+	t.Run("nil blockchain client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMultiSigContractV2Adapter()
+		args.BlockchainClient = nil
+
+		adapter, err := NewMultiSigContractV2Adapter(args)
+		assert.True(t, check.IfNil(adapter))
+		assert.Equal(t, errNilBlockchainClient, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, err := NewMultiSigContractV2Adapter(createMockArgsMultiSigContractV2Adapter())
+		assert.False(t, check.IfNil(adapter))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMultiSigContractV2Adapter_GetBatch(t *testing.T) {
+	t.Parallel()
+
+	opts := &bind.CallOpts{Context: context.Background()}
+	expectedBatch := contract.Batch{
+		Nonce:                  big.NewInt(22),
+		BlockNumber:            90,
+		LastUpdatedBlockNumber: 100,
+		DepositsCount:          1,
+	}
+
+	t.Run("get batch errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsMultiSigContractV2Adapter()
+		args.MultiSigContract = &bridgeTests.MultiSigContractV2Stub{
+			GetBatchCalled: func(opts *bind.CallOpts, batchNonce *big.Int) (contractV2.Batch, error) {
+				return contractV2.Batch{}, expectedErr
+			},
+		}
+		adapter, _ := NewMultiSigContractV2Adapter(args)
+
+		_, _, err := adapter.GetBatch(opts, big.NewInt(22))
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("settle limit not passed should return not final", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := NewMultiSigContractV2Adapter(createFinalityArgsMultiSigContractV2Adapter(139))
+
+		batch, isFinal, err := adapter.GetBatch(opts, big.NewInt(22))
+		assert.Nil(t, err)
+		assert.False(t, isFinal)
+		assert.Equal(t, expectedBatch, batch)
+	})
+	t.Run("settle limit passed should return final", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := NewMultiSigContractV2Adapter(createFinalityArgsMultiSigContractV2Adapter(140))
+
+		batch, isFinal, err := adapter.GetBatch(opts, big.NewInt(22))
+		assert.Nil(t, err)
+		assert.True(t, isFinal)
+		assert.Equal(t, expectedBatch, batch)
+	})
+}
+
+func TestMultiSigContractV2Adapter_GetBatchDeposits(t *testing.T) {
+	t.Parallel()
+
+	opts := &bind.CallOpts{Context: context.Background()}
+	expectedDeposits := []contract.Deposit{
+		{
+			Nonce:        big.NewInt(7),
+			TokenAddress: common.HexToAddress("0x1"),
+			Amount:       big.NewInt(1000),
+			Depositor:    common.HexToAddress("0x2"),
+			Recipient:    [32]byte{3},
+			Status:       1,
+		},
+	}
+
+	t.Run("not final", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := NewMultiSigContractV2Adapter(createFinalityArgsMultiSigContractV2Adapter(139))
+
+		deposits, isFinal, err := adapter.GetBatchDeposits(opts, big.NewInt(22))
+		assert.Nil(t, err)
+		assert.False(t, isFinal)
+		assert.Equal(t, expectedDeposits, deposits)
+	})
+	t.Run("final", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := NewMultiSigContractV2Adapter(createFinalityArgsMultiSigContractV2Adapter(200))
+
+		deposits, isFinal, err := adapter.GetBatchDeposits(opts, big.NewInt(22))
+		assert.Nil(t, err)
+		assert.True(t, isFinal)
+		assert.Equal(t, expectedDeposits, deposits)
+	})
+}
+
+func TestMultiSigContractV2Adapter_GetStatusesAfterExecution(t *testing.T) {
+	t.Parallel()
+
+	opts := &bind.CallOpts{Context: context.Background()}
+
+	t.Run("not final", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := NewMultiSigContractV2Adapter(createFinalityArgsMultiSigContractV2Adapter(139))
+
+		statuses, isFinal, err := adapter.GetStatusesAfterExecution(opts, big.NewInt(22))
+		assert.Nil(t, err)
+		assert.False(t, isFinal)
+		assert.Equal(t, []byte{3, 4}, statuses)
+	})
+	t.Run("final", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, _ := NewMultiSigContractV2Adapter(createFinalityArgsMultiSigContractV2Adapter(140))
+
+		statuses, isFinal, err := adapter.GetStatusesAfterExecution(opts, big.NewInt(22))
+		assert.Nil(t, err)
+		assert.True(t, isFinal)
+		assert.Equal(t, []byte{3, 4}, statuses)
+	})
+}
+
+func TestMultiSigContractV2Adapter_PassThroughMethods(t *testing.T) {
+	t.Parallel()
+
+	handlerCalled := make(map[string]bool)
+	args := createMockArgsMultiSigContractV2Adapter()
+	args.MultiSigContract = &bridgeTests.MultiSigContractV2Stub{
+		GetRelayersCalled: func(opts *bind.CallOpts) ([]common.Address, error) {
+			handlerCalled["GetRelayers"] = true
+			return nil, nil
+		},
+		WasBatchExecutedCalled: func(opts *bind.CallOpts, batchNonce *big.Int) (bool, error) {
+			handlerCalled["WasBatchExecuted"] = true
+			return true, nil
+		},
+		ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
+			handlerCalled["ExecuteTransfer"] = true
+			return nil, nil
+		},
+		QuorumCalled: func(opts *bind.CallOpts) (*big.Int, error) {
+			handlerCalled["Quorum"] = true
+			return big.NewInt(3), nil
+		},
+		PausedCalled: func(opts *bind.CallOpts) (bool, error) {
+			handlerCalled["Paused"] = true
+			return true, nil
+		},
+	}
+	adapter, _ := NewMultiSigContractV2Adapter(args)
+
+	opts := &bind.CallOpts{Context: context.Background()}
+	_, _ = adapter.GetRelayers(opts)
+	wasExecuted, _ := adapter.WasBatchExecuted(opts, big.NewInt(1))
+	_, _ = adapter.ExecuteTransfer(&bind.TransactOpts{}, nil, nil, nil, nil, big.NewInt(1), nil)
+	quorum, _ := adapter.Quorum(opts)
+	isPaused, _ := adapter.Paused(opts)
+
+	assert.True(t, wasExecuted)
+	assert.Equal(t, big.NewInt(3), quorum)
+	assert.True(t, isPaused)
+	assert.Equal(t, 5, len(handlerCalled))
+}
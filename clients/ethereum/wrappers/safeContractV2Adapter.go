@@ -0,0 +1,61 @@
+package wrappers
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// safeContractV2Adapter exposes the v2 ERC20Safe contract through the operations of the current contract version.
+// The v2 contract only locks the whitelisted tokens, so all of them are native and none is mint/burn
+type safeContractV2Adapter struct {
+	safeContract safeContractV2
+}
+
+// NewSafeContractV2Adapter creates a new instance of type safeContractV2Adapter
+func NewSafeContractV2Adapter(safeContract safeContractV2) (*safeContractV2Adapter, error) {
+	if check.IfNilReflect(safeContract) {
+		return nil, errNilSafeContract
+	}
+
+	return &safeContractV2Adapter{
+		safeContract: safeContract,
+	}, nil
+}
+
+// TotalBalances returns the total locked balance of the provided token
+func (adapter *safeContractV2Adapter) TotalBalances(opts *bind.CallOpts, token common.Address) (*big.Int, error) {
+	return adapter.safeContract.TokenBalances(opts, token)
+}
+
+// MintBalances returns 0 as the v2 contract does not mint tokens
+func (adapter *safeContractV2Adapter) MintBalances(_ *bind.CallOpts, _ common.Address) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// BurnBalances returns 0 as the v2 contract does not burn tokens
+func (adapter *safeContractV2Adapter) BurnBalances(_ *bind.CallOpts, _ common.Address) (*big.Int, error) {
+	return big.NewInt(0), nil
+}
+
+// MintBurnTokens returns false as the v2 contract does not support mint/burn tokens
+func (adapter *safeContractV2Adapter) MintBurnTokens(_ *bind.CallOpts, _ common.Address) (bool, error) {
+	return false, nil
+}
+
+// NativeTokens returns true as all the tokens handled by the v2 contract are native
+func (adapter *safeContractV2Adapter) NativeTokens(_ *bind.CallOpts, _ common.Address) (bool, error) {
+	return true, nil
+}
+
+// WhitelistedTokens returns true if the provided token is whitelisted
+func (adapter *safeContractV2Adapter) WhitelistedTokens(opts *bind.CallOpts, token common.Address) (bool, error) {
+	return adapter.safeContract.WhitelistedTokens(opts, token)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adapter *safeContractV2Adapter) IsInterfaceNil() bool {
+	return adapter == nil
+}
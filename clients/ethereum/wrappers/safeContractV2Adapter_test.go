@@ -0,0 +1,72 @@
+package wrappers
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSafeContractV2Adapter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil safe contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, err := NewSafeContractV2Adapter(nil)
+		assert.True(t, check.IfNil(adapter))
+		assert.Equal(t, errNilSafeContract, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, err := NewSafeContractV2Adapter(&bridgeTests.SafeContractV2Stub{})
+		assert.False(t, check.IfNil(adapter))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSafeContractV2Adapter_Methods(t *testing.T) {
+	t.Parallel()
+
+	token := common.HexToAddress("0x1")
+	adapter, _ := NewSafeContractV2Adapter(&bridgeTests.SafeContractV2Stub{
+		TokenBalancesCalled: func(opts *bind.CallOpts, providedToken common.Address) (*big.Int, error) {
+			assert.Equal(t, token, providedToken)
+			return big.NewInt(1000), nil
+		},
+		WhitelistedTokensCalled: func(opts *bind.CallOpts, providedToken common.Address) (bool, error) {
+			assert.Equal(t, token, providedToken)
+			return true, nil
+		},
+	})
+
+	opts := &bind.CallOpts{}
+	totalBalances, err := adapter.TotalBalances(opts, token)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(1000), totalBalances)
+
+	mintBalances, err := adapter.MintBalances(opts, token)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), mintBalances)
+
+	burnBalances, err := adapter.BurnBalances(opts, token)
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(0), burnBalances)
+
+	isMintBurn, err := adapter.MintBurnTokens(opts, token)
+	assert.Nil(t, err)
+	assert.False(t, isMintBurn)
+
+	isNative, err := adapter.NativeTokens(opts, token)
+	assert.Nil(t, err)
+	assert.True(t, isNative)
+
+	isWhitelisted, err := adapter.WhitelistedTokens(opts, token)
+	assert.Nil(t, err)
+	assert.True(t, isWhitelisted)
+}
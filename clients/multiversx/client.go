@@ -3,8 +3,10 @@ package multiversx
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"net/http"
 	"reflect"
 	"sync"
 	"time"
@@ -15,6 +17,7 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/core/converters"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -30,10 +33,41 @@ const (
 	signFuncName                    = "sign"
 	performActionFuncName           = "performAction"
 	minClientAvailabilityAllowDelta = 1
+	transactionInfoEndpoint         = "transaction/%s?withResults=true"
+	blockByNonceEndpoint            = "block/%d/by-nonce/%d"
 
 	multiversXDataGetterLogId = "MultiversXEth-MultiversXDataGetter"
 )
 
+// NumFieldsForTransaction represents the number of return data items for each deposit in a MultiversX batch:
+// block nonce, deposit nonce, from, to, token and amount
+const NumFieldsForTransaction = 6
+
+// transactionCostResponse holds the cost related fields of the transaction info endpoint response that are not
+// available in the sdk's data.TransactionOnNetwork structure
+type transactionCostResponse struct {
+	Data struct {
+		Transaction struct {
+			Status  transaction.TxStatus `json:"status"`
+			GasUsed uint64               `json:"gasUsed"`
+			Fee     string               `json:"fee"`
+		} `json:"transaction"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+// blockTimestampResponse holds the timestamp field of the block by nonce endpoint response
+type blockTimestampResponse struct {
+	Data struct {
+		Block struct {
+			Timestamp uint64 `json:"timestamp"`
+		} `json:"block"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
 // ClientArgs represents the argument for the NewClient constructor function
 type ClientArgs struct {
 	GasMapConfig                 config.MultiversXGasMapConfig
@@ -47,6 +81,7 @@ type ClientArgs struct {
 	RoleProvider                 roleProvider
 	StatusHandler                bridgeCore.StatusHandler
 	ClientAvailabilityAllowDelta uint64
+	ContractsVersion             bridgeCore.ContractsVersion
 }
 
 // client represents the MultiversX Client implementation
@@ -99,6 +134,7 @@ func NewClient(args ClientArgs) (*client, error) {
 		RelayerAddress:          relayerAddress,
 		Proxy:                   args.Proxy,
 		Log:                     bridgeCore.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXDataGetterLogId), multiversXDataGetterLogId),
+		ContractsVersion:        args.ContractsVersion,
 	}
 	getter, err := NewMXClientDataGetter(argsMXClientDataGetter)
 	if err != nil {
@@ -237,9 +273,35 @@ func emptyResponse(response [][]byte) bool {
 }
 
 func (c *client) createPendingBatchFromResponse(ctx context.Context, responseData [][]byte) (*bridgeCore.TransferBatch, error) {
-	numFieldsForTransaction := 6
+	batch, err := ParseBatchFromResponse(responseData, c.addressPublicKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedTokens := make(map[string][]byte)
+	for transferIndex, deposit := range batch.Deposits {
+		storedConvertedTokenBytes, exists := cachedTokens[deposit.DisplayableToken]
+		if !exists {
+			deposit.DestinationTokenBytes, err = c.tokensMapper.ConvertToken(ctx, deposit.SourceTokenBytes)
+			if err != nil {
+				return nil, fmt.Errorf("%w while converting token bytes, transfer index %d", err, transferIndex)
+			}
+			cachedTokens[deposit.DisplayableToken] = deposit.DestinationTokenBytes
+		} else {
+			deposit.DestinationTokenBytes = storedConvertedTokenBytes
+		}
+	}
+
+	c.log.Debug("created batch " + batch.String())
+
+	return batch, nil
+}
+
+// ParseBatchFromResponse creates the transfer batch from the getBatch or getCurrentTxBatch response of the multisig
+// contract. The destination tokens are not converted
+func ParseBatchFromResponse(responseData [][]byte, addressConverter bridgeCore.AddressConverter) (*bridgeCore.TransferBatch, error) {
 	dataLen := len(responseData)
-	haveCorrectNumberOfArgs := (dataLen-1)%numFieldsForTransaction == 0 && dataLen > 1
+	haveCorrectNumberOfArgs := (dataLen-1)%NumFieldsForTransaction == 0 && dataLen > 1
 	if !haveCorrectNumberOfArgs {
 		return nil, fmt.Errorf("%w, got %d argument(s)", errInvalidNumberOfArguments, dataLen)
 	}
@@ -253,10 +315,15 @@ func (c *client) createPendingBatchFromResponse(ctx context.Context, responseDat
 		ID: batchID,
 	}
 
-	cachedTokens := make(map[string][]byte)
+	// the batch was created in the block of its first deposit
+	batch.BlockNumber, err = parseUInt64FromByteSlice(responseData[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w while parsing the block nonce", err)
+	}
+
 	transferIndex := 0
-	for i := 1; i < dataLen; i += numFieldsForTransaction {
-		// blockNonce is the i-th element, let's ignore it for now
+	for i := 1; i < dataLen; i += NumFieldsForTransaction {
+		// blockNonce is the i-th element, only the first one is used as the batch block number
 		depositNonce, errParse := parseUInt64FromByteSlice(responseData[i+1])
 		if errParse != nil {
 			return nil, fmt.Errorf("%w while parsing the deposit nonce, transfer index %d", errParse, transferIndex)
@@ -266,33 +333,20 @@ func (c *client) createPendingBatchFromResponse(ctx context.Context, responseDat
 		deposit := &bridgeCore.DepositTransfer{
 			Nonce:            depositNonce,
 			FromBytes:        responseData[i+2],
-			DisplayableFrom:  c.addressPublicKeyConverter.ToBech32StringSilent(responseData[i+2]),
+			DisplayableFrom:  addressConverter.ToBech32StringSilent(responseData[i+2]),
 			ToBytes:          responseData[i+3],
-			DisplayableTo:    c.addressPublicKeyConverter.ToHexStringWithPrefix(responseData[i+3]),
+			DisplayableTo:    addressConverter.ToHexStringWithPrefix(responseData[i+3]),
 			SourceTokenBytes: responseData[i+4],
 			DisplayableToken: string(responseData[i+4]),
 			Amount:           amount,
 		}
 
-		storedConvertedTokenBytes, exists := cachedTokens[deposit.DisplayableToken]
-		if !exists {
-			deposit.DestinationTokenBytes, err = c.tokensMapper.ConvertToken(ctx, deposit.SourceTokenBytes)
-			if err != nil {
-				return nil, fmt.Errorf("%w while converting token bytes, transfer index %d", err, transferIndex)
-			}
-			cachedTokens[deposit.DisplayableToken] = deposit.DestinationTokenBytes
-		} else {
-			deposit.DestinationTokenBytes = storedConvertedTokenBytes
-		}
-
 		batch.Deposits = append(batch.Deposits, deposit)
 		transferIndex++
 	}
 
 	batch.Statuses = make([]byte, len(batch.Deposits))
 
-	c.log.Debug("created batch " + batch.String())
-
 	return batch, nil
 }
 
@@ -343,8 +397,10 @@ func (c *client) ProposeTransfer(ctx context.Context, batch *bridgeCore.Transfer
 			ArgBytes(dt.ToBytes).
 			ArgBytes(dt.DestinationTokenBytes).
 			ArgBigInt(dt.Amount).
-			ArgInt64(int64(dt.Nonce)).
-			ArgBytes(dt.Data)
+			ArgInt64(int64(dt.Nonce))
+		if c.isDepositDataSupported() {
+			txBuilder.ArgBytes(dt.Data)
+		}
 	}
 
 	gasLimit := c.gasMapConfig.ProposeTransferBase + uint64(len(batch.Deposits))*c.gasMapConfig.ProposeTransferForEach
@@ -432,27 +488,27 @@ func (c *client) checkIsPaused(ctx context.Context) error {
 
 // IsMintBurnToken returns true if the provided token is whitelisted for mint/burn operations
 func (c *client) IsMintBurnToken(ctx context.Context, token []byte) (bool, error) {
-	return c.isMintBurnToken(ctx, token)
+	return c.mxClientDataGetter.IsMintBurnToken(ctx, token)
 }
 
 // IsNativeToken returns true if the provided token is native
 func (c *client) IsNativeToken(ctx context.Context, token []byte) (bool, error) {
-	return c.isNativeToken(ctx, token)
+	return c.mxClientDataGetter.IsNativeToken(ctx, token)
 }
 
 // TotalBalances returns the total stored tokens
 func (c *client) TotalBalances(ctx context.Context, token []byte) (*big.Int, error) {
-	return c.getTotalBalances(ctx, token)
+	return c.GetTotalBalances(ctx, token)
 }
 
 // MintBalances returns the minted tokens
 func (c *client) MintBalances(ctx context.Context, token []byte) (*big.Int, error) {
-	return c.getMintBalances(ctx, token)
+	return c.GetMintBalances(ctx, token)
 }
 
 // BurnBalances returns the burned tokens
 func (c *client) BurnBalances(ctx context.Context, token []byte) (*big.Int, error) {
-	return c.getBurnBalances(ctx, token)
+	return c.GetBurnBalances(ctx, token)
 }
 
 // CheckRequiredBalance will check the required balance for the provided token
@@ -493,6 +549,103 @@ func (c *client) CheckRequiredBalance(ctx context.Context, token []byte, value *
 	return nil
 }
 
+// GetRelayerBalance returns the EGLD balance of the relayer
+func (c *client) GetRelayerBalance(ctx context.Context) (*big.Int, error) {
+	account, err := c.proxy.GetAccount(ctx, c.relayerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w for the relayer account: %s", errInvalidBalance, account.Balance)
+	}
+
+	return balance, nil
+}
+
+// GetTransactionCost returns the gas used and the fee paid by the provided transaction, as computed by the network
+func (c *client) GetTransactionCost(ctx context.Context, txHash string) (uint64, *big.Int, error) {
+	buff, code, err := c.proxy.GetHTTP(ctx, fmt.Sprintf(transactionInfoEndpoint, txHash))
+	if err != nil {
+		return 0, nil, err
+	}
+	if code != http.StatusOK {
+		return 0, nil, fmt.Errorf("%w: HTTP status %d while fetching transaction %s", clients.ErrTransactionNotFinal, code, txHash)
+	}
+
+	response := &transactionCostResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(response.Error) > 0 {
+		return 0, nil, fmt.Errorf("%s while fetching transaction %s", response.Error, txHash)
+	}
+
+	tx := response.Data.Transaction
+	if tx.Status == transaction.TxStatusPending || len(tx.Fee) == 0 {
+		return 0, nil, fmt.Errorf("%w, hash %s, status %s", clients.ErrTransactionNotFinal, txHash, tx.Status)
+	}
+
+	fee, ok := big.NewInt(0).SetString(tx.Fee, 10)
+	if !ok {
+		return 0, nil, fmt.Errorf("%w for the fee of transaction %s: %s", clients.ErrInvalidValue, txHash, tx.Fee)
+	}
+
+	return tx.GasUsed, fee, nil
+}
+
+// GetBlockTimestamp returns the timestamp, in seconds, of the block with the provided nonce, from the shard of the
+// safe contract
+func (c *client) GetBlockTimestamp(ctx context.Context, blockNonce uint64) (uint64, error) {
+	safeAddress, err := c.safeContractAddress.AddressAsBech32String()
+	if err != nil {
+		return 0, err
+	}
+
+	shardID, err := c.proxy.GetShardOfAddress(ctx, safeAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	buff, code, err := c.proxy.GetHTTP(ctx, fmt.Sprintf(blockByNonceEndpoint, shardID, blockNonce))
+	if err != nil {
+		return 0, err
+	}
+	if code != http.StatusOK {
+		return 0, fmt.Errorf("%w: HTTP status %d while fetching block %d from shard %d", clients.ErrInvalidValue, code, blockNonce, shardID)
+	}
+
+	response := &blockTimestampResponse{}
+	err = json.Unmarshal(buff, response)
+	if err != nil {
+		return 0, err
+	}
+	if len(response.Error) > 0 {
+		return 0, fmt.Errorf("%s while fetching block %d from shard %d", response.Error, blockNonce, shardID)
+	}
+
+	return response.Data.Block.Timestamp, nil
+}
+
+// EstimateBatchFee returns the fee paid by the relayer, at the minimum gas price, when it proposes, signs and performs
+// the transfer of a batch with the provided number of deposits
+func (c *client) EstimateBatchFee(ctx context.Context, numDeposits uint64) (*big.Int, error) {
+	networkConfig, err := c.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasLimit := c.gasMapConfig.ProposeTransferBase + numDeposits*c.gasMapConfig.ProposeTransferForEach +
+		c.gasMapConfig.Sign +
+		c.gasMapConfig.PerformActionBase + numDeposits*c.gasMapConfig.PerformActionForEach
+
+	fee := big.NewInt(0).SetUint64(gasLimit)
+
+	return fee.Mul(fee, big.NewInt(0).SetUint64(networkConfig.MinGasPrice)), nil
+}
+
 // CheckClientAvailability will check the client availability and will set the metric accordingly
 func (c *client) CheckClientAvailability(ctx context.Context) error {
 	c.mut.Lock()
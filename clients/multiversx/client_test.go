@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -296,6 +298,21 @@ func TestClient_GetPendingBatch(t *testing.T) {
 		assert.True(t, errors.Is(err, errNotUint64Bytes))
 		assert.True(t, strings.Contains(err.Error(), "while parsing batch ID"))
 	})
+	t.Run("invalid block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		buff := createMockPendingBatchBytes(2)
+		buff[1] = bytes.Repeat([]byte{1}, 32)
+		args.Proxy = createMockProxy(buff)
+
+		c, _ := NewClient(args)
+		batch, err := c.GetPendingBatch(context.Background())
+
+		assert.Nil(t, batch)
+		assert.True(t, errors.Is(err, errNotUint64Bytes))
+		assert.True(t, strings.Contains(err.Error(), "while parsing the block nonce"))
+	})
 	t.Run("invalid deposit nonce", func(t *testing.T) {
 		t.Parallel()
 
@@ -340,12 +357,15 @@ func TestClient_GetPendingBatch(t *testing.T) {
 				return append([]byte("converted_"), sourceBytes...), nil
 			},
 		}
-		args.Proxy = createMockProxy(createMockPendingBatchBytes(2))
+		buff := createMockPendingBatchBytes(2)
+		buff[1] = big.NewInt(1234).Bytes()
+		args.Proxy = createMockProxy(buff)
 
 		tokenBytes1 := bytes.Repeat([]byte{3}, 32)
 		tokenBytes2 := bytes.Repeat([]byte{6}, 32)
 		expectedBatch := &bridgeCore.TransferBatch{
-			ID: 44562,
+			ID:          44562,
+			BlockNumber: 1234,
 			Deposits: []*bridgeCore.DepositTransfer{
 				{
 					Nonce:                 5000,
@@ -763,6 +783,46 @@ func TestClient_ProposeTransfer(t *testing.T) {
 			},
 		}
 
+		hash, err := c.ProposeTransfer(context.Background(), batch)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedHash, hash)
+		assert.True(t, sendWasCalled)
+	})
+	t.Run("should propose transfer without the data field for v2 contracts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createMockProxy(make([][]byte, 0))
+		args.ContractsVersion = bridgeCore.ContractsVersionV2
+		expectedHash := "expected hash"
+		c, _ := NewClient(args)
+		sendWasCalled := false
+		batch := createMockBatch()
+
+		c.txHandler = &bridgeTests.TxHandlerStub{
+			SendTransactionReturnHashCalled: func(ctx context.Context, builder builders.TxDataBuilder, gasLimit uint64) (string, error) {
+				sendWasCalled = true
+
+				dataField, err := builder.ToDataString()
+				assert.Nil(t, err)
+
+				dataStrings := []string{
+					proposeTransferFuncName,
+					hex.EncodeToString(big.NewInt(int64(batch.ID)).Bytes()),
+				}
+				for _, dt := range batch.Deposits {
+					depositString := depositToString(dt)
+					depositString = depositString[:strings.LastIndex(depositString, "@")]
+					dataStrings = append(dataStrings, depositString)
+				}
+
+				expectedDataField := strings.Join(dataStrings, "@")
+				assert.Equal(t, expectedDataField, dataField)
+
+				return expectedHash, nil
+			},
+		}
+
 		hash, err := c.ProposeTransfer(context.Background(), batch)
 		assert.Nil(t, err)
 		assert.Equal(t, expectedHash, hash)
@@ -1006,6 +1066,185 @@ func TestClient_Close(t *testing.T) {
 	assert.True(t, closeCalled)
 }
 
+func TestClient_GetRelayerBalance(t *testing.T) {
+	t.Parallel()
+
+	t.Run("proxy errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockClientArgs()
+		args.Proxy = &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewClient(args)
+
+		balance, err := c.GetRelayerBalance(context.Background())
+		assert.Nil(t, balance)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Balance: "not a number"}, nil
+			},
+		}
+		c, _ := NewClient(args)
+
+		balance, err := c.GetRelayerBalance(context.Background())
+		assert.Nil(t, balance)
+		assert.True(t, errors.Is(err, errInvalidBalance))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				expectedAddressBytes, _ := args.RelayerPrivateKey.GeneratePublic().ToByteArray()
+				assert.Equal(t, expectedAddressBytes, address.AddressBytes())
+
+				return &data.Account{Balance: "1000"}, nil
+			},
+		}
+		c, _ := NewClient(args)
+
+		balance, err := c.GetRelayerBalance(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(1000), balance)
+	})
+}
+
+func TestClient_EstimateBatchFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("proxy errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockClientArgs()
+		args.Proxy = &interactors.ProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return nil, expectedErr
+			},
+		}
+		c, _ := NewClient(args)
+
+		fee, err := c.EstimateBatchFee(context.Background(), 10)
+		assert.Nil(t, fee)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = &interactors.ProxyStub{
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{MinGasPrice: 1000}, nil
+			},
+		}
+		c, _ := NewClient(args)
+
+		fee, err := c.EstimateBatchFee(context.Background(), 10)
+		assert.Nil(t, err)
+		expectedGasLimit := int64(20 + 10*30 + 10 + 60 + 10*70)
+		assert.Equal(t, big.NewInt(expectedGasLimit*1000), fee)
+	})
+}
+
+func TestClient_GetTransactionCost(t *testing.T) {
+	t.Parallel()
+
+	txHash := "c1e1a6f4b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d"
+	createProxy := func(response string, code int, err error) *interactors.ProxyStub {
+		return &interactors.ProxyStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				assert.Equal(t, "transaction/"+txHash+"?withResults=true", endpoint)
+				return []byte(response), code, err
+			},
+		}
+	}
+
+	t.Run("proxy errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockClientArgs()
+		args.Proxy = createProxy("", 0, expectedErr)
+		c, _ := NewClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.Equal(t, expectedErr, err)
+		assert.Zero(t, gasUsed)
+		assert.Nil(t, fee)
+	})
+	t.Run("transaction not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createProxy(`{"error":"transaction not found"}`, http.StatusNotFound, nil)
+		c, _ := NewClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.ErrorIs(t, err, clients.ErrTransactionNotFinal)
+		assert.Zero(t, gasUsed)
+		assert.Nil(t, fee)
+	})
+	t.Run("response error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createProxy(`{"error":"internal issue"}`, http.StatusOK, nil)
+		c, _ := NewClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.ErrorContains(t, err, "internal issue")
+		assert.Zero(t, gasUsed)
+		assert.Nil(t, fee)
+	})
+	t.Run("pending transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createProxy(`{"data":{"transaction":{"status":"pending","gasUsed":10,"fee":"100"}}}`, http.StatusOK, nil)
+		c, _ := NewClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.ErrorIs(t, err, clients.ErrTransactionNotFinal)
+		assert.Zero(t, gasUsed)
+		assert.Nil(t, fee)
+	})
+	t.Run("invalid fee should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createProxy(`{"data":{"transaction":{"status":"success","gasUsed":10,"fee":"abc"}}}`, http.StatusOK, nil)
+		c, _ := NewClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.ErrorIs(t, err, clients.ErrInvalidValue)
+		assert.Zero(t, gasUsed)
+		assert.Nil(t, fee)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createProxy(`{"data":{"transaction":{"status":"success","gasUsed":1500000,"fee":"157500000000000"}},"code":"successful"}`, http.StatusOK, nil)
+		c, _ := NewClient(args)
+
+		gasUsed, fee, err := c.GetTransactionCost(context.Background(), txHash)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1500000), gasUsed)
+		assert.Equal(t, "157500000000000", fee.String())
+	})
+}
+
 func TestClient_CheckClientAvailability(t *testing.T) {
 	t.Parallel()
 
@@ -1116,3 +1355,82 @@ func checkStatusHandler(t *testing.T, statusHandler *testsCommon.StatusHandlerMo
 	assert.Equal(t, status.String(), statusHandler.GetStringMetric(bridgeCore.MetricMultiversXClientStatus))
 	assert.Equal(t, message, statusHandler.GetStringMetric(bridgeCore.MetricLastMultiversXClientError))
 }
+
+func TestClient_GetBlockTimestamp(t *testing.T) {
+	t.Parallel()
+
+	createProxy := func(response string, code int, err error) *interactors.ProxyStub {
+		return &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				return 1, nil
+			},
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				assert.Equal(t, "block/1/by-nonce/37", endpoint)
+				return []byte(response), code, err
+			},
+		}
+	}
+
+	t.Run("get shard of address errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockClientArgs()
+		args.Proxy = &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				return 0, expectedErr
+			},
+		}
+		c, _ := NewClient(args)
+
+		timestamp, err := c.GetBlockTimestamp(context.Background(), 37)
+		assert.Equal(t, expectedErr, err)
+		assert.Zero(t, timestamp)
+	})
+	t.Run("proxy errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockClientArgs()
+		args.Proxy = createProxy("", 0, expectedErr)
+		c, _ := NewClient(args)
+
+		timestamp, err := c.GetBlockTimestamp(context.Background(), 37)
+		assert.Equal(t, expectedErr, err)
+		assert.Zero(t, timestamp)
+	})
+	t.Run("block not found should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createProxy(`{"error":"block not found"}`, http.StatusNotFound, nil)
+		c, _ := NewClient(args)
+
+		timestamp, err := c.GetBlockTimestamp(context.Background(), 37)
+		assert.ErrorIs(t, err, clients.ErrInvalidValue)
+		assert.Zero(t, timestamp)
+	})
+	t.Run("response error should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createProxy(`{"error":"internal issue"}`, http.StatusOK, nil)
+		c, _ := NewClient(args)
+
+		timestamp, err := c.GetBlockTimestamp(context.Background(), 37)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "internal issue")
+		assert.Zero(t, timestamp)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockClientArgs()
+		args.Proxy = createProxy(`{"data":{"block":{"nonce":37,"timestamp":1715000000}},"code":"successful"}`, http.StatusOK, nil)
+		c, _ := NewClient(args)
+
+		timestamp, err := c.GetBlockTimestamp(context.Background(), 37)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1715000000), timestamp)
+	})
+}
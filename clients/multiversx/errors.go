@@ -18,4 +18,5 @@ var (
 	errNilNodeStatusResponse    = errors.New("nil node status response")
 	errInvalidBalance           = errors.New("invalid balance")
 	errInsufficientESDTBalance  = errors.New("insufficient ESDT balance")
+	errUnknownContractsVersion  = errors.New("unknown contracts version")
 )
@@ -43,6 +43,8 @@ const (
 	getBurnBalances                                           = "getBurnBalances"
 	getAllKnownTokens                                         = "getAllKnownTokens"
 	getLastBatchId                                            = "getLastBatchId"
+	getQuorumFuncName                                         = "getQuorum"
+	getActionSignerCountFuncName                              = "getActionSignerCount"
 )
 
 // ArgsMXClientDataGetter is the arguments DTO used in the NewMXClientDataGetter constructor
@@ -52,6 +54,7 @@ type ArgsMXClientDataGetter struct {
 	RelayerAddress          core.AddressHandler
 	Proxy                   Proxy
 	Log                     logger.Logger
+	ContractsVersion        bridgeCore.ContractsVersion
 }
 
 type mxClientDataGetter struct {
@@ -61,9 +64,14 @@ type mxClientDataGetter struct {
 	relayerAddress                core.AddressHandler
 	proxy                         Proxy
 	log                           logger.Logger
+	contractsVersion              bridgeCore.ContractsVersion
 	mutNodeStatus                 sync.Mutex
 	wasShardIDFetched             bool
 	shardID                       uint32
+
+	mutSignaturesProgress  sync.Mutex
+	lastProgress           *bridgeCore.SignaturesProgress
+	lastProgressNumSigners uint64
 }
 
 // NewMXClientDataGetter creates a new instance of the dataGetter type
@@ -83,6 +91,10 @@ func NewMXClientDataGetter(args ArgsMXClientDataGetter) (*mxClientDataGetter, er
 	if check.IfNil(args.SafeContractAddress) {
 		return nil, fmt.Errorf("%w for the SafeContractAddress argument", errNilAddressHandler)
 	}
+	contractsVersion, err := checkContractsVersion(args.ContractsVersion)
+	if err != nil {
+		return nil, err
+	}
 	bech32Address, err := args.MultisigContractAddress.AddressAsBech32String()
 	if err != nil {
 		return nil, fmt.Errorf("%w for %x", err, args.MultisigContractAddress.AddressBytes())
@@ -95,9 +107,27 @@ func NewMXClientDataGetter(args ArgsMXClientDataGetter) (*mxClientDataGetter, er
 		relayerAddress:                args.RelayerAddress,
 		proxy:                         args.Proxy,
 		log:                           args.Log,
+		contractsVersion:              contractsVersion,
 	}, nil
 }
 
+func checkContractsVersion(version bridgeCore.ContractsVersion) (bridgeCore.ContractsVersion, error) {
+	switch version {
+	case "":
+		return bridgeCore.ContractsVersionV3, nil
+	case bridgeCore.ContractsVersionV2, bridgeCore.ContractsVersionV3:
+		return version, nil
+	}
+
+	return "", fmt.Errorf("%w: %s", errUnknownContractsVersion, version)
+}
+
+// isDepositDataSupported returns true if the multisig contract expects the data field of each deposit
+// when proposing a transfer. The v2 contracts do not support transfers with SC calls
+func (dataGetter *mxClientDataGetter) isDepositDataSupported() bool {
+	return dataGetter.contractsVersion != bridgeCore.ContractsVersionV2
+}
+
 // ExecuteQueryReturningBytes will try to execute the provided query and return the result as slice of byte slices
 func (dataGetter *mxClientDataGetter) ExecuteQueryReturningBytes(ctx context.Context, request *data.VmValueRequest) ([][]byte, error) {
 	if request == nil {
@@ -451,12 +481,93 @@ func (dataGetter *mxClientDataGetter) GetLastExecutedEthTxID(ctx context.Context
 
 // WasSigned returns true if the action was already signed by the current relayer
 func (dataGetter *mxClientDataGetter) WasSigned(ctx context.Context, actionID uint64) (bool, error) {
+	return dataGetter.WasSignedBy(ctx, actionID, dataGetter.relayerAddress)
+}
+
+// WasSignedBy returns true if the action was already signed by the provided relayer
+func (dataGetter *mxClientDataGetter) WasSignedBy(ctx context.Context, actionID uint64, relayerAddress core.AddressHandler) (bool, error) {
 	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
-	builder.Function(signedFuncName).ArgAddress(dataGetter.relayerAddress).ArgInt64(int64(actionID))
+	builder.Function(signedFuncName).ArgAddress(relayerAddress).ArgInt64(int64(actionID))
 
 	return dataGetter.executeQueryBoolFromBuilder(ctx, builder)
 }
 
+// GetQuorum returns the quorum set in the multisig contract
+func (dataGetter *mxClientDataGetter) GetQuorum(ctx context.Context) (uint64, error) {
+	builder := dataGetter.createMultisigDefaultVmQueryBuilder().Function(getQuorumFuncName)
+
+	return dataGetter.executeQueryUint64FromBuilder(ctx, builder)
+}
+
+// GetActionSignerCount returns the number of signatures gathered by the provided action ID
+func (dataGetter *mxClientDataGetter) GetActionSignerCount(ctx context.Context, actionID uint64) (uint64, error) {
+	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
+	builder.Function(getActionSignerCountFuncName).ArgInt64(int64(actionID))
+
+	return dataGetter.executeQueryUint64FromBuilder(ctx, builder)
+}
+
+// GetSignaturesProgress returns the signatures collection progress for the provided action ID, by querying
+// each staked relayer. The relayers are only queried when the action ID or its signer count change
+func (dataGetter *mxClientDataGetter) GetSignaturesProgress(ctx context.Context, actionID uint64) (*bridgeCore.SignaturesProgress, error) {
+	signerCount, err := dataGetter.GetActionSignerCount(ctx, actionID)
+	if err != nil {
+		return nil, err
+	}
+
+	dataGetter.mutSignaturesProgress.Lock()
+	defer dataGetter.mutSignaturesProgress.Unlock()
+
+	isCached := dataGetter.lastProgress != nil && dataGetter.lastProgress.ActionID == actionID &&
+		dataGetter.lastProgressNumSigners == signerCount
+	if isCached {
+		progressCopy := *dataGetter.lastProgress
+		return &progressCopy, nil
+	}
+
+	quorum, err := dataGetter.GetQuorum(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	relayers, err := dataGetter.GetAllStakedRelayers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &bridgeCore.SignaturesProgress{
+		ActionID:       actionID,
+		Quorum:         int(quorum),
+		Signers:        make([]string, 0, len(relayers)),
+		MissingSigners: make([]string, 0, len(relayers)),
+	}
+	for _, relayer := range relayers {
+		relayerAddress := data.NewAddressFromBytes(relayer)
+		bech32Address, errConvert := relayerAddress.AddressAsBech32String()
+		if errConvert != nil {
+			return nil, errConvert
+		}
+
+		wasSigned, errQuery := dataGetter.WasSignedBy(ctx, actionID, relayerAddress)
+		if errQuery != nil {
+			return nil, errQuery
+		}
+		if !wasSigned {
+			progress.MissingSigners = append(progress.MissingSigners, bech32Address)
+			continue
+		}
+
+		progress.Signers = append(progress.Signers, bech32Address)
+	}
+	progress.NumSignatures = len(progress.Signers)
+
+	dataGetter.lastProgress = progress
+	dataGetter.lastProgressNumSigners = signerCount
+
+	progressCopy := *progress
+	return &progressCopy, nil
+}
+
 // GetAllStakedRelayers returns all staked relayers defined in MultiversX SC
 func (dataGetter *mxClientDataGetter) GetAllStakedRelayers(ctx context.Context) ([][]byte, error) {
 	builder := dataGetter.createMultisigDefaultVmQueryBuilder()
@@ -473,36 +584,48 @@ func (dataGetter *mxClientDataGetter) IsPaused(ctx context.Context) (bool, error
 	return dataGetter.executeQueryBoolFromBuilder(ctx, builder)
 }
 
-func (dataGetter *mxClientDataGetter) isMintBurnToken(ctx context.Context, token []byte) (bool, error) {
+// IsSafePaused returns true if the safe contract is paused
+func (dataGetter *mxClientDataGetter) IsSafePaused(ctx context.Context) (bool, error) {
+	builder := dataGetter.createSafeDefaultVmQueryBuilder()
+	builder.Function(isPausedFuncName)
+
+	return dataGetter.executeQueryBoolFromBuilder(ctx, builder)
+}
+
+// IsMintBurnToken returns true if the token is whitelisted for mint/burn operations
+func (dataGetter *mxClientDataGetter) IsMintBurnToken(ctx context.Context, token []byte) (bool, error) {
 	builder := dataGetter.createSafeDefaultVmQueryBuilder()
 	builder.Function(isMintBurnTokenFuncName).ArgBytes(token)
 
 	return dataGetter.executeQueryBoolFromBuilder(ctx, builder)
 }
 
-// isNativeToken returns true if the token is native
-func (dataGetter *mxClientDataGetter) isNativeToken(ctx context.Context, token []byte) (bool, error) {
+// IsNativeToken returns true if the token is native
+func (dataGetter *mxClientDataGetter) IsNativeToken(ctx context.Context, token []byte) (bool, error) {
 	builder := dataGetter.createSafeDefaultVmQueryBuilder()
 	builder.Function(isNativeTokenFuncName).ArgBytes(token)
 
 	return dataGetter.executeQueryBoolFromBuilder(ctx, builder)
 }
 
-func (dataGetter *mxClientDataGetter) getTotalBalances(ctx context.Context, token []byte) (*big.Int, error) {
+// GetTotalBalances returns the total stored tokens in the safe contract
+func (dataGetter *mxClientDataGetter) GetTotalBalances(ctx context.Context, token []byte) (*big.Int, error) {
 	builder := dataGetter.createSafeDefaultVmQueryBuilder()
 	builder.Function(getTotalBalances).ArgBytes(token)
 
 	return dataGetter.executeQueryBigIntFromBuilder(ctx, builder)
 }
 
-func (dataGetter *mxClientDataGetter) getMintBalances(ctx context.Context, token []byte) (*big.Int, error) {
+// GetMintBalances returns the minted tokens by the safe contract
+func (dataGetter *mxClientDataGetter) GetMintBalances(ctx context.Context, token []byte) (*big.Int, error) {
 	builder := dataGetter.createSafeDefaultVmQueryBuilder()
 	builder.Function(getMintBalances).ArgBytes(token)
 
 	return dataGetter.executeQueryBigIntFromBuilder(ctx, builder)
 }
 
-func (dataGetter *mxClientDataGetter) getBurnBalances(ctx context.Context, token []byte) (*big.Int, error) {
+// GetBurnBalances returns the burned tokens by the safe contract
+func (dataGetter *mxClientDataGetter) GetBurnBalances(ctx context.Context, token []byte) (*big.Int, error) {
 	builder := dataGetter.createSafeDefaultVmQueryBuilder()
 	builder.Function(getBurnBalances).ArgBytes(token)
 
@@ -515,8 +638,10 @@ func (dataGetter *mxClientDataGetter) addBatchInfo(builder builders.VMQueryBuild
 			ArgBytes(dt.ToBytes).
 			ArgBytes(dt.DestinationTokenBytes).
 			ArgBigInt(dt.Amount).
-			ArgInt64(int64(dt.Nonce)).
-			ArgBytes(dt.Data)
+			ArgInt64(int64(dt.Nonce))
+		if dataGetter.isDepositDataSupported() {
+			builder.ArgBytes(dt.Data)
+		}
 	}
 }
 
@@ -155,6 +155,26 @@ func TestNewMXClientDataGetter(t *testing.T) {
 		assert.True(t, strings.Contains(err.Error(), "RelayerAddress"))
 		assert.True(t, check.IfNil(dg))
 	})
+	t.Run("unknown contracts version", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.ContractsVersion = "v1"
+
+		dg, err := NewMXClientDataGetter(args)
+		assert.True(t, errors.Is(err, errUnknownContractsVersion))
+		assert.True(t, check.IfNil(dg))
+	})
+	t.Run("empty contracts version should default to v3", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.ContractsVersion = ""
+
+		dg, err := NewMXClientDataGetter(args)
+		assert.Nil(t, err)
+		assert.Equal(t, bridgeCore.ContractsVersionV3, dg.contractsVersion)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -681,6 +701,54 @@ func TestMXClientDataGetter_WasProposedTransfer(t *testing.T) {
 
 		dg, _ := NewMXClientDataGetter(args)
 
+		result, err := dg.WasProposedTransfer(context.Background(), batch)
+		assert.True(t, result)
+		assert.Nil(t, err)
+		assert.True(t, proxyCalled)
+	})
+	t.Run("should work with v2 contracts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.ContractsVersion = bridgeCore.ContractsVersionV2
+		proxyCalled := false
+		batch := createMockBatch()
+		batch.Deposits[0].Data = bridgeTests.CallDataMock
+
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				proxyCalled = true
+				assert.Equal(t, wasTransferActionProposedFuncName, vmRequest.FuncName)
+
+				expectedArgs := []string{
+					hex.EncodeToString(big.NewInt(112233).Bytes()),
+
+					hex.EncodeToString([]byte("from1")),
+					hex.EncodeToString([]byte("to1")),
+					hex.EncodeToString([]byte("converted_token1")),
+					hex.EncodeToString(big.NewInt(2).Bytes()),
+					hex.EncodeToString(big.NewInt(1).Bytes()),
+
+					hex.EncodeToString([]byte("from2")),
+					hex.EncodeToString([]byte("to2")),
+					hex.EncodeToString([]byte("converted_token2")),
+					hex.EncodeToString(big.NewInt(4).Bytes()),
+					hex.EncodeToString(big.NewInt(3).Bytes()),
+				}
+
+				assert.Equal(t, expectedArgs, vmRequest.Args)
+
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{{1}},
+					},
+				}, nil
+			},
+		}
+
+		dg, _ := NewMXClientDataGetter(args)
+
 		result, err := dg.WasProposedTransfer(context.Background(), batch)
 		assert.True(t, result)
 		assert.Nil(t, err)
@@ -1228,6 +1296,125 @@ func TestMXClientDataGetter_WasSigned(t *testing.T) {
 	assert.True(t, result)
 }
 
+func TestMXClientDataGetter_GetSignaturesProgress(t *testing.T) {
+	t.Parallel()
+
+	relayer1, _ := data.NewAddressFromBech32String("erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th")
+	relayer2, _ := data.NewAddressFromBech32String("erd1k2s324ww2g0yj38qn2ch2jwctdy8mnfxep94q9arncc6xecg3xaq6mjse8")
+	actionID := big.NewInt(112233)
+
+	t.Run("get quorum errors", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsMXClientDataGetter()
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		dg, _ := NewMXClientDataGetter(args)
+
+		progress, err := dg.GetSignaturesProgress(context.Background(), actionID.Uint64())
+		assert.Nil(t, progress)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMXClientDataGetter()
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				assert.Equal(t, getBech32Address(args.MultisigContractAddress), vmRequest.Address)
+
+				var returnData [][]byte
+				switch vmRequest.FuncName {
+				case getActionSignerCountFuncName:
+					returnData = [][]byte{{1}}
+				case getQuorumFuncName:
+					returnData = [][]byte{{2}}
+				case getAllStakedRelayersFuncName:
+					returnData = [][]byte{relayer1.AddressBytes(), relayer2.AddressBytes()}
+				case signedFuncName:
+					expectedArgs := []string{hex.EncodeToString(relayer2.AddressBytes()), hex.EncodeToString(actionID.Bytes())}
+					wasSigned := len(vmRequest.Args) == 2 && vmRequest.Args[0] == expectedArgs[0] && vmRequest.Args[1] == expectedArgs[1]
+					returnData = [][]byte{{0}}
+					if wasSigned {
+						returnData = [][]byte{{1}}
+					}
+				default:
+					assert.Fail(t, "unexpected function "+vmRequest.FuncName)
+				}
+
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: returnData,
+					},
+				}, nil
+			},
+		}
+		dg, _ := NewMXClientDataGetter(args)
+
+		progress, err := dg.GetSignaturesProgress(context.Background(), actionID.Uint64())
+		assert.Nil(t, err)
+		expectedProgress := &bridgeCore.SignaturesProgress{
+			ActionID:       actionID.Uint64(),
+			Quorum:         2,
+			NumSignatures:  1,
+			Signers:        []string{getBech32Address(relayer2)},
+			MissingSigners: []string{getBech32Address(relayer1)},
+		}
+		assert.Equal(t, expectedProgress, progress)
+	})
+	t.Run("should query the relayers only when the signer count changes", func(t *testing.T) {
+		t.Parallel()
+
+		signerCount := byte(1)
+		numRelayersQueries := 0
+		args := createMockArgsMXClientDataGetter()
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				var returnData [][]byte
+				switch vmRequest.FuncName {
+				case getActionSignerCountFuncName:
+					returnData = [][]byte{{signerCount}}
+				case getQuorumFuncName:
+					returnData = [][]byte{{2}}
+				case getAllStakedRelayersFuncName:
+					numRelayersQueries++
+					returnData = [][]byte{relayer1.AddressBytes(), relayer2.AddressBytes()}
+				case signedFuncName:
+					returnData = [][]byte{{1}}
+				default:
+					assert.Fail(t, "unexpected function "+vmRequest.FuncName)
+				}
+
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: returnData,
+					},
+				}, nil
+			},
+		}
+		dg, _ := NewMXClientDataGetter(args)
+
+		_, _ = dg.GetSignaturesProgress(context.Background(), actionID.Uint64())
+		_, _ = dg.GetSignaturesProgress(context.Background(), actionID.Uint64())
+		assert.Equal(t, 1, numRelayersQueries)
+
+		signerCount = 2
+		progress, err := dg.GetSignaturesProgress(context.Background(), actionID.Uint64())
+		assert.Nil(t, err)
+		assert.Equal(t, 2, progress.NumSignatures)
+		assert.Equal(t, 2, numRelayersQueries)
+
+		_, _ = dg.GetSignaturesProgress(context.Background(), actionID.Uint64()+1)
+		assert.Equal(t, 3, numRelayersQueries)
+	})
+}
+
 func TestMXClientDataGetter_GetAllStakedRelayers(t *testing.T) {
 	t.Parallel()
 
@@ -1427,7 +1614,40 @@ func TestMultiversXClientDataGetter_IsPaused(t *testing.T) {
 	assert.True(t, proxyCalled)
 }
 
-func TestMultiversXClientDataGetter_isMintBurnToken(t *testing.T) {
+func TestMultiversXClientDataGetter_IsSafePaused(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMXClientDataGetter()
+	proxyCalled := false
+	args.Proxy = &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			proxyCalled = true
+			assert.Equal(t, getBech32Address(args.RelayerAddress), vmRequest.CallerAddr)
+			assert.Equal(t, getBech32Address(args.SafeContractAddress), vmRequest.Address)
+			assert.Equal(t, "", vmRequest.CallValue)
+			assert.Equal(t, isPausedFuncName, vmRequest.FuncName)
+			assert.Empty(t, vmRequest.Args)
+
+			strResponse := "AQ=="
+			response, _ := base64.StdEncoding.DecodeString(strResponse)
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: [][]byte{response},
+				},
+			}, nil
+		},
+	}
+
+	dg, _ := NewMXClientDataGetter(args)
+
+	result, err := dg.IsSafePaused(context.Background())
+	assert.Nil(t, err)
+	assert.True(t, result)
+	assert.True(t, proxyCalled)
+}
+
+func TestMultiversXClientDataGetter_IsMintBurnToken(t *testing.T) {
 	t.Parallel()
 
 	args := createMockArgsMXClientDataGetter()
@@ -1454,13 +1674,13 @@ func TestMultiversXClientDataGetter_isMintBurnToken(t *testing.T) {
 
 	dg, _ := NewMXClientDataGetter(args)
 
-	result, err := dg.isMintBurnToken(context.Background(), []byte("token"))
+	result, err := dg.IsMintBurnToken(context.Background(), []byte("token"))
 	assert.Nil(t, err)
 	assert.True(t, result)
 	assert.True(t, proxyCalled)
 }
 
-func TestMultiversXClientDataGetter_isNativeToken(t *testing.T) {
+func TestMultiversXClientDataGetter_IsNativeToken(t *testing.T) {
 	t.Parallel()
 
 	args := createMockArgsMXClientDataGetter()
@@ -1487,13 +1707,13 @@ func TestMultiversXClientDataGetter_isNativeToken(t *testing.T) {
 
 	dg, _ := NewMXClientDataGetter(args)
 
-	result, err := dg.isNativeToken(context.Background(), []byte("token"))
+	result, err := dg.IsNativeToken(context.Background(), []byte("token"))
 	assert.Nil(t, err)
 	assert.True(t, result)
 	assert.True(t, proxyCalled)
 }
 
-func TestMultiversXClientDataGetter_getTotalBalances(t *testing.T) {
+func TestMultiversXClientDataGetter_GetTotalBalances(t *testing.T) {
 	t.Parallel()
 
 	args := createMockArgsMXClientDataGetter()
@@ -1519,13 +1739,13 @@ func TestMultiversXClientDataGetter_getTotalBalances(t *testing.T) {
 
 	dg, _ := NewMXClientDataGetter(args)
 
-	result, err := dg.getTotalBalances(context.Background(), []byte("token"))
+	result, err := dg.GetTotalBalances(context.Background(), []byte("token"))
 	assert.Nil(t, err)
 	assert.Equal(t, result, expectedAccumulatedBurnedTokens)
 	assert.True(t, proxyCalled)
 }
 
-func TestMultiversXClientDataGetter_getMintBalances(t *testing.T) {
+func TestMultiversXClientDataGetter_GetMintBalances(t *testing.T) {
 	t.Parallel()
 
 	args := createMockArgsMXClientDataGetter()
@@ -1551,13 +1771,13 @@ func TestMultiversXClientDataGetter_getMintBalances(t *testing.T) {
 
 	dg, _ := NewMXClientDataGetter(args)
 
-	result, err := dg.getMintBalances(context.Background(), []byte("token"))
+	result, err := dg.GetMintBalances(context.Background(), []byte("token"))
 	assert.Nil(t, err)
 	assert.Equal(t, result, expectedAccumulatedMintedTokens)
 	assert.True(t, proxyCalled)
 }
 
-func TestMultiversXClientDataGetter_getBurnBalances(t *testing.T) {
+func TestMultiversXClientDataGetter_GetBurnBalances(t *testing.T) {
 	t.Parallel()
 
 	args := createMockArgsMXClientDataGetter()
@@ -1583,7 +1803,7 @@ func TestMultiversXClientDataGetter_getBurnBalances(t *testing.T) {
 
 	dg, _ := NewMXClientDataGetter(args)
 
-	result, err := dg.getBurnBalances(context.Background(), []byte("token"))
+	result, err := dg.GetBurnBalances(context.Background(), []byte("token"))
 	assert.Nil(t, err)
 	assert.Equal(t, result, expectedAccumulatedBurnedTokens)
 	assert.True(t, proxyCalled)
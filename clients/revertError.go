@@ -0,0 +1,95 @@
+package clients
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const executionRevertedMessage = "execution reverted"
+
+// RevertError is the error returned when the simulation of a transaction reverts. It holds the decoded revert reason
+// and the raw revert data, if provided by the node
+type RevertError struct {
+	Reason string
+	Data   []byte
+}
+
+// Error returns the error string
+func (err *RevertError) Error() string {
+	if len(err.Reason) == 0 {
+		return ErrTransactionReverted.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", ErrTransactionReverted.Error(), err.Reason)
+}
+
+// Unwrap returns ErrTransactionReverted so the error can be checked with errors.Is
+func (err *RevertError) Unwrap() error {
+	return ErrTransactionReverted
+}
+
+// NewRevertError converts the error returned by an eth_call into a *RevertError, decoding the revert data with the
+// provided contract ABI. The error is returned unchanged if it does not signal a revert
+func NewRevertError(err error, contractAbi *abi.ABI) error {
+	if err == nil {
+		return nil
+	}
+
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		hexData, ok := dataErr.ErrorData().(string)
+		if ok {
+			data, errDecode := hexutil.Decode(hexData)
+			if errDecode == nil {
+				return &RevertError{
+					Reason: decodeRevertReason(data, contractAbi),
+					Data:   data,
+				}
+			}
+		}
+	}
+
+	if !strings.Contains(err.Error(), executionRevertedMessage) {
+		return err
+	}
+
+	reason := strings.TrimPrefix(err.Error(), executionRevertedMessage)
+	reason = strings.TrimPrefix(reason, ":")
+
+	return &RevertError{
+		Reason: strings.TrimSpace(reason),
+	}
+}
+
+func decodeRevertReason(data []byte, contractAbi *abi.ABI) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	reason, err := abi.UnpackRevert(data)
+	if err == nil {
+		return reason
+	}
+
+	if contractAbi != nil && len(data) >= 4 {
+		var selector [4]byte
+		copy(selector[:], data[:4])
+		abiError, errFind := contractAbi.ErrorByID(selector)
+		if errFind == nil {
+			values, errUnpack := abiError.Unpack(data)
+			if errUnpack == nil {
+				return fmt.Sprintf("%s%v", abiError.Name, values)
+			}
+
+			return abiError.Name
+		}
+	}
+
+	return hex.EncodeToString(data)
+}
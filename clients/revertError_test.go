@@ -0,0 +1,84 @@
+package clients
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testDataError struct {
+	data interface{}
+}
+
+func (err *testDataError) Error() string {
+	return executionRevertedMessage
+}
+
+func (err *testDataError) ErrorData() interface{} {
+	return err.data
+}
+
+func TestNewRevertError(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil error should return nil", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, NewRevertError(nil, nil))
+	})
+	t.Run("not a revert error should return the error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("connection refused")
+		assert.Equal(t, expectedErr, NewRevertError(expectedErr, nil))
+	})
+	t.Run("revert message without data should keep the reason", func(t *testing.T) {
+		t.Parallel()
+
+		err := NewRevertError(errors.New("execution reverted: Not enough signatures"), nil)
+		assert.ErrorIs(t, err, ErrTransactionReverted)
+		assert.Equal(t, "transaction reverted: Not enough signatures", err.Error())
+	})
+	t.Run("Error(string) revert data should be decoded", func(t *testing.T) {
+		t.Parallel()
+
+		err := NewRevertError(&testDataError{
+			data: "0x08c379a0" +
+				"0000000000000000000000000000000000000000000000000000000000000020" +
+				"0000000000000000000000000000000000000000000000000000000000000015" +
+				"4261746368206e6f6e6365206e6f742076616c69640000000000000000000000",
+		}, nil)
+		revertErr, ok := err.(*RevertError)
+		require.True(t, ok)
+		assert.Equal(t, "Batch nonce not valid", revertErr.Reason)
+		assert.Equal(t, 4+32*3, len(revertErr.Data))
+	})
+	t.Run("custom error revert data should be decoded with the contract ABI", func(t *testing.T) {
+		t.Parallel()
+
+		contractAbi, err := abi.JSON(strings.NewReader(`[{"inputs":[{"internalType":"uint256","name":"quorum","type":"uint256"}],"name":"QuorumNotReached","type":"error"}]`))
+		require.Nil(t, err)
+		selector := contractAbi.Errors["QuorumNotReached"].ID.Bytes()[:4]
+		data := "0x" + hex.EncodeToString(selector) + "0000000000000000000000000000000000000000000000000000000000000003"
+
+		revertErr := NewRevertError(&testDataError{data: data}, &contractAbi)
+		assert.Equal(t, "transaction reverted: QuorumNotReached[3]", revertErr.Error())
+	})
+	t.Run("unknown revert data should be hex encoded", func(t *testing.T) {
+		t.Parallel()
+
+		err := NewRevertError(&testDataError{data: "0xdeadbeef01"}, nil)
+		assert.Equal(t, "transaction reverted: deadbeef01", err.Error())
+	})
+	t.Run("empty revert data should not have a reason", func(t *testing.T) {
+		t.Parallel()
+
+		err := NewRevertError(&testDataError{data: "0x"}, nil)
+		assert.Equal(t, ErrTransactionReverted.Error(), err.Error())
+	})
+}
@@ -75,6 +75,12 @@ var (
 		Name:  "log-logger-name",
 		Usage: "Boolean option for logger name in the logs.",
 	}
+	// checkConfig defines a flag that only runs the self-check of the configuration and exits
+	checkConfig = cli.BoolFlag{
+		Name: "check-config",
+		Usage: "Boolean option for running the self-check of the configuration and of the environment and exiting. " +
+			"The application exits with a non-zero code if any of the checks failed.",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -88,6 +94,7 @@ func getFlags() []cli.Flag {
 		logWithLoggerName,
 		profileMode,
 		restApiInterface,
+		checkConfig,
 	}
 }
 func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
@@ -102,6 +109,7 @@ func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
 	flagsConfig.EnableLogName = ctx.GlobalBool(logWithLoggerName.Name)
 	flagsConfig.EnablePprof = ctx.GlobalBool(profileMode.Name)
 	flagsConfig.RestApiInterface = ctx.GlobalString(restApiInterface.Name)
+	flagsConfig.CheckConfig = ctx.GlobalBool(checkConfig.Name)
 
 	return flagsConfig
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -12,7 +13,6 @@ import (
 	ethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
-	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/wrappers"
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	"github.com/multiversx/mx-bridge-eth-go/core"
@@ -20,6 +20,7 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/p2p"
 	"github.com/multiversx/mx-bridge-eth-go/status"
 	"github.com/multiversx/mx-chain-communication-go/p2p/libp2p"
+	p2pDisabled "github.com/multiversx/mx-chain-communication-go/p2p/libp2p/disabled"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
@@ -178,17 +179,56 @@ func startRelay(ctx *cli.Context, version string) error {
 	}
 
 	bridgeEthAddress := ethCommon.HexToAddress(cfg.Eth.MultisigContractAddress)
-	multiSigInstance, err := contract.NewBridge(bridgeEthAddress, ethClient)
-	if err != nil {
-		return err
+	safeEthAddress := ethCommon.HexToAddress(cfg.Eth.SafeContractAddress)
+
+	contractsVersionHandler := func(ctx context.Context) (core.ContractsVersion, error) {
+		if len(cfg.Eth.ContractsVersion) > 0 {
+			return core.ContractsVersion(cfg.Eth.ContractsVersion), nil
+		}
+
+		return wrappers.DetectContractsVersion(ctx, ethClient, bridgeEthAddress)
 	}
 
-	safeEthAddress := ethCommon.HexToAddress(cfg.Eth.SafeContractAddress)
-	safeInstance, err := contract.NewERC20Safe(safeEthAddress, ethClient)
+	isSelfCheckRequired := flagsConfig.CheckConfig || cfg.SelfCheck.Enabled
+	contractsVersion, errContractsVersion := contractsVersionHandler(context.Background())
+	switch {
+	case errContractsVersion != nil && !isSelfCheckRequired:
+		return errContractsVersion
+	case errContractsVersion != nil:
+		// the error is reported by the self-check, meanwhile the other checks use the current contracts version
+		log.Warn("can not get the Ethereum contracts version", "error", errContractsVersion)
+		contractsVersion = core.ContractsVersionV3
+	case len(cfg.Eth.ContractsVersion) == 0:
+		log.Info("detected the Ethereum contracts version", "version", contractsVersion)
+	}
+
+	argsClientWrapper := wrappers.ArgsVersionedEthereumChainWrapper{
+		StatusHandler:           ethClientStatusHandler,
+		ContractsVersion:        contractsVersion,
+		MultiSigContractAddress: bridgeEthAddress,
+		SafeContractAddress:     safeEthAddress,
+		BlockchainClient:        ethClient,
+	}
+
+	clientWrapper, err := wrappers.NewVersionedEthereumChainWrapper(argsClientWrapper)
 	if err != nil {
 		return err
 	}
 
+	if isSelfCheckRequired {
+		err = runSelfCheck(cfg, clientWrapper, contractsVersionHandler, proxy)
+		if err != nil {
+			return err
+		}
+		if errContractsVersion != nil {
+			// the relayer should not start with the fallback contracts version
+			return errContractsVersion
+		}
+		if flagsConfig.CheckConfig {
+			return nil
+		}
+	}
+
 	argsContractsHolder := ethereum.ArgsErc20SafeContractsHolder{
 		EthClient:              ethClient,
 		EthClientStatusHandler: ethClientStatusHandler,
@@ -214,18 +254,6 @@ func startRelay(ctx *cli.Context, version string) error {
 		FlagsConfig:     flagsConfig,
 	}
 
-	argsClientWrapper := wrappers.ArgsEthereumChainWrapper{
-		StatusHandler:    ethClientStatusHandler,
-		MultiSigContract: multiSigInstance,
-		SafeContract:     safeInstance,
-		BlockchainClient: ethClient,
-	}
-
-	clientWrapper, err := wrappers.NewEthereumChainWrapper(argsClientWrapper)
-	if err != nil {
-		return err
-	}
-
 	var appStatusHandlers []chainCore.AppStatusHandler
 	statusMetrics := statusHandler.NewStatusMetrics()
 	appStatusHandlers = append(appStatusHandlers, statusMetrics)
@@ -259,7 +287,14 @@ func startRelay(ctx *cli.Context, version string) error {
 		return err
 	}
 
-	webServer, err := factory.StartWebServer(configs, metricsHolder)
+	webServer, err := factory.StartWebServer(
+		configs,
+		metricsHolder,
+		ethToMultiversXComponents.MisbehaviourEvidenceProvider(),
+		ethToMultiversXComponents.SignaturesProgressProvider(),
+		ethToMultiversXComponents.FeeAccountingProvider(),
+		ethToMultiversXComponents.BatchTimelinesProvider(),
+	)
 	if err != nil {
 		return err
 	}
@@ -354,6 +389,29 @@ func attachFileLogger(log logger.Logger, flagsConfig config.ContextFlagsConfig)
 }
 
 func buildNetMessenger(cfg config.Config, marshalizer marshal.Marshalizer) (p2p.NetMessenger, error) {
+	p2pSingleSigner := &singlesig.Secp256k1Signer{}
+	p2pKeyGen := signing.NewKeyGenerator(secp256k1.NewSecp256k1())
+	p2pPrivKey, _ := p2pKeyGen.GeneratePair()
+
+	initialPeerList := cfg.P2P.InitialPeerList
+	if cfg.P2P.PrivateNetwork.Enabled {
+		// in a private network the peer IDs are pinned, so the P2P key should be persistent
+		p2pKeyBytes, err := chainCommon.GetSkBytesFromP2pKey(cfg.P2P.PrivateNetwork.P2PKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if len(p2pKeyBytes) == 0 {
+			return nil, fmt.Errorf("missing P2P key file %s required by the private network", cfg.P2P.PrivateNetwork.P2PKeyFile)
+		}
+
+		p2pPrivKey, err = p2pKeyGen.PrivateKeyFromByteArray(p2pKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		initialPeerList = cfg.P2P.PrivateNetwork.StaticPeers
+	}
+
 	nodeConfig := p2pConfig.NodeConfig{
 		Port:                       cfg.P2P.Port,
 		MaximumExpectedPeerCount:   0,
@@ -365,7 +423,7 @@ func buildNetMessenger(cfg config.Config, marshalizer marshal.Marshalizer) (p2p.
 		Enabled:                          true,
 		RefreshIntervalInSec:             5,
 		ProtocolID:                       cfg.P2P.ProtocolID,
-		InitialPeerList:                  cfg.P2P.InitialPeerList,
+		InitialPeerList:                  initialPeerList,
 		BucketSize:                       0,
 		RoutingTableRefreshIntervalInSec: 300,
 		Type:                             p2pPeerNetworkDiscoverer,
@@ -403,10 +461,6 @@ func buildNetMessenger(cfg config.Config, marshalizer marshal.Marshalizer) (p2p.
 		return nil, err
 	}
 
-	p2pSingleSigner := &singlesig.Secp256k1Signer{}
-	p2pKeyGen := signing.NewKeyGenerator(secp256k1.NewSecp256k1())
-	p2pPrivKey, _ := p2pKeyGen.GeneratePair()
-
 	args := libp2p.ArgsNetworkMessenger{
 		Marshaller:            marshalizer,
 		P2pConfig:             p2pCfg,
@@ -420,5 +474,31 @@ func buildNetMessenger(cfg config.Config, marshalizer marshal.Marshalizer) (p2p.
 		Logger:                p2pLog,
 	}
 
-	return libp2p.NewNetworkMessenger(args)
+	messenger, err := libp2p.NewNetworkMessenger(args)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.P2P.PrivateNetwork.Enabled {
+		return messenger, nil
+	}
+
+	// the host is already listening at this point, so the unpinned peers should be dropped right away, not only
+	// after the bridge components replace this evaluator with the antiflood-aware one
+	// TODO: add the libp2p pre-shared key (pnet) option to the host once the messenger accepts extra host options
+	log.Warn("the private network does not provide a libp2p pre-shared key yet, non-relayers can still complete " +
+		"the transport handshake, unpinned peers are dropped after it. Firewall the P2P port to the relayers' addresses")
+	argsPrivateNetworkEvaluator := p2p.ArgsPrivateNetworkPeerDenialEvaluator{
+		PeerDenialEvaluator: &p2pDisabled.PeerDenialEvaluator{},
+		StaticPeers:         cfg.P2P.PrivateNetwork.StaticPeers,
+	}
+	privateNetworkEvaluator, err := p2p.NewPrivateNetworkPeerDenialEvaluator(argsPrivateNetworkEvaluator)
+	if err == nil {
+		err = messenger.SetPeerDenialEvaluator(privateNetworkEvaluator)
+	}
+	if err != nil {
+		_ = messenger.Close()
+		return nil, err
+	}
+
+	return messenger, nil
 }
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/selfCheck"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/interactors"
+)
+
+const selfCheckLogId = "SelfCheck"
+
+// runSelfCheck verifies the configuration against the connected chains and the local environment, prints the
+// report and returns an error if any of the checks failed
+func runSelfCheck(
+	cfg config.Config,
+	clientWrapper selfCheck.EthereumClientWrapper,
+	contractsVersionHandler selfCheck.EthContractsVersionHandler,
+	proxy multiversx.Proxy,
+) error {
+	cryptoHandler, err := ethereum.NewCryptoHandler(cfg.Eth.PrivateKeyFile)
+	if err != nil {
+		return err
+	}
+
+	wallet := interactors.NewWallet()
+	multiversXPrivateKeyBytes, err := wallet.LoadPrivateKeyFromPemFile(cfg.MultiversX.PrivateKeyFile)
+	if err != nil {
+		return err
+	}
+	multiversXRelayerAddress, err := wallet.GetAddressFromPrivateKey(multiversXPrivateKeyBytes)
+	if err != nil {
+		return err
+	}
+
+	multisigAddress, err := data.NewAddressFromBech32String(cfg.MultiversX.MultisigContractAddress)
+	if err != nil {
+		return fmt.Errorf("%w for MultiversX.MultisigContractAddress", err)
+	}
+	safeAddress, err := data.NewAddressFromBech32String(cfg.MultiversX.SafeContractAddress)
+	if err != nil {
+		return fmt.Errorf("%w for MultiversX.SafeContractAddress", err)
+	}
+
+	argsDataGetter := multiversx.ArgsMXClientDataGetter{
+		MultisigContractAddress: multisigAddress,
+		SafeContractAddress:     safeAddress,
+		RelayerAddress:          multiversXRelayerAddress,
+		Proxy:                   proxy,
+		Log:                     core.NewLoggerWithIdentifier(logger.GetOrCreate(selfCheckLogId), selfCheckLogId),
+		ContractsVersion:        core.ContractsVersion(cfg.MultiversX.ContractsVersion),
+	}
+	dataGetter, err := multiversx.NewMXClientDataGetter(argsDataGetter)
+	if err != nil {
+		return err
+	}
+
+	argsSelfChecker := selfCheck.ArgsSelfChecker{
+		Log:                        logger.GetOrCreate("selfCheck"),
+		Config:                     cfg.SelfCheck,
+		Chain:                      cfg.Eth.Chain,
+		EthClientWrapper:           clientWrapper,
+		EthRelayerAddress:          cryptoHandler.GetAddress(),
+		EthContractsVersionHandler: contractsVersionHandler,
+		MultiversXDataGetter:       dataGetter,
+		MultiversXProxy:            proxy,
+		MultiversXRelayerAddress:   multiversXRelayerAddress,
+		P2PPort:                    cfg.P2P.Port,
+		NTPQueryHandler:            selfCheck.QueryNTPClockOffset,
+	}
+	checker, err := selfCheck.NewSelfChecker(argsSelfChecker)
+	if err != nil {
+		return err
+	}
+
+	report := checker.Check()
+	fmt.Println(report.String())
+	if report.NumFailed() > 0 {
+		return fmt.Errorf("self-check failed: %d of %d checks failed", report.NumFailed(), len(report.Results))
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/core/converters"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/urfave/cli"
+)
+
+const bech32Prefix = "erd1"
+
+type displayableAddress struct {
+	Bech32   string `json:"bech32,omitempty"`
+	Hex      string `json:"hex"`
+	Ethereum string `json:"ethereum,omitempty"`
+}
+
+func convertAddress(ctx *cli.Context) error {
+	result, err := newDisplayableAddress(ctx.String(address.Name))
+	if err != nil {
+		return err
+	}
+
+	return printJson(result)
+}
+
+func newDisplayableAddress(value string) (*displayableAddress, error) {
+	value = converters.TrimWhiteSpaceCharacters(value)
+
+	addressConverter, err := converters.NewAddressConverter()
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasPrefix(value, bech32Prefix) {
+		mvxAddress, errDecode := data.NewAddressFromBech32String(value)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		return &displayableAddress{
+			Bech32: value,
+			Hex:    addressConverter.ToHexString(mvxAddress.AddressBytes()),
+		}, nil
+	}
+
+	addressBytes, err := decodeHex(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w, the address should be bech32 or hex encoded", err)
+	}
+
+	switch len(addressBytes) {
+	case common.AddressLength:
+		return &displayableAddress{
+			Hex:      addressConverter.ToHexString(addressBytes),
+			Ethereum: common.BytesToAddress(addressBytes).String(),
+		}, nil
+	case sdkCore.AddressBytesLen:
+		bech32Address, errEncode := addressConverter.ToBech32String(addressBytes)
+		if errEncode != nil {
+			return nil, errEncode
+		}
+
+		return &displayableAddress{
+			Bech32: bech32Address,
+			Hex:    addressConverter.ToHexString(addressBytes),
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid address length %d, expected %d for Ethereum or %d for MultiversX addresses",
+			len(addressBytes), common.AddressLength, sdkCore.AddressBytesLen)
+	}
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDisplayableAddress(t *testing.T) {
+	t.Parallel()
+
+	aliceBech32 := "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	aliceHex := "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+
+	tests := []struct {
+		name          string
+		value         string
+		expected      *displayableAddress
+		expectedError string
+	}{
+		{
+			name:  "bech32 address",
+			value: " " + aliceBech32 + "\n",
+			expected: &displayableAddress{
+				Bech32: aliceBech32,
+				Hex:    aliceHex,
+			},
+		},
+		{
+			name:  "hex MultiversX address",
+			value: aliceHex,
+			expected: &displayableAddress{
+				Bech32: aliceBech32,
+				Hex:    aliceHex,
+			},
+		},
+		{
+			name:  "hex MultiversX address with prefix",
+			value: "0x" + aliceHex,
+			expected: &displayableAddress{
+				Bech32: aliceBech32,
+				Hex:    aliceHex,
+			},
+		},
+		{
+			name:  "Ethereum address",
+			value: "0x880ec53af800b5cd051531672ef4fc4de233bd5d",
+			expected: &displayableAddress{
+				Hex:      "880ec53af800b5cd051531672ef4fc4de233bd5d",
+				Ethereum: "0x880EC53Af800b5Cd051531672EF4fc4De233bD5d",
+			},
+		},
+		{
+			name:          "invalid bech32 address should error",
+			value:         "erd1invalid",
+			expectedError: "invalid character not part of charset",
+		},
+		{
+			name:          "invalid hex should error",
+			value:         "0xzz",
+			expectedError: "the address should be bech32 or hex encoded",
+		},
+		{
+			name:          "invalid length should error",
+			value:         "0x0102",
+			expectedError: "invalid address length 2, expected 20 for Ethereum or 32 for MultiversX addresses",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := newDisplayableAddress(tt.value)
+			if len(tt.expectedError) > 0 {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethereumClient "github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	multiversxClient "github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-bridge-eth-go/core/converters"
+	"github.com/urfave/cli"
+)
+
+// batchInput is the json input used when computing the message hash of a MultiversX to Ethereum batch
+type batchInput struct {
+	BatchID  uint64          `json:"batchId"`
+	Deposits []*depositInput `json:"deposits"`
+}
+
+type depositInput struct {
+	Nonce     uint64 `json:"nonce"`
+	Recipient string `json:"recipient"`
+	Token     string `json:"token"`
+	Amount    string `json:"amount"`
+}
+
+type displayableBatch struct {
+	ID          uint64                `json:"batchId"`
+	BlockNumber uint64                `json:"blockNumber"`
+	Deposits    []*displayableDeposit `json:"deposits"`
+}
+
+type displayableDeposit struct {
+	Nonce  uint64 `json:"nonce"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Token  string `json:"token"`
+	Amount string `json:"amount"`
+}
+
+type recoveredSigner struct {
+	Signature string `json:"signature"`
+	Address   string `json:"address,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+func printBatch(ctx *cli.Context) error {
+	items, err := readReturnData(ctx)
+	if err != nil {
+		return err
+	}
+
+	batch, err := newDisplayableBatch(items)
+	if err != nil {
+		return err
+	}
+
+	return printJson(batch)
+}
+
+func newDisplayableBatch(items [][]byte) (*displayableBatch, error) {
+	addressConverter, err := converters.NewAddressConverter()
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := multiversxClient.ParseBatchFromResponse(items, addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &displayableBatch{
+		ID:          batch.ID,
+		BlockNumber: batch.BlockNumber,
+		Deposits:    make([]*displayableDeposit, 0, len(batch.Deposits)),
+	}
+	for _, deposit := range batch.Deposits {
+		result.Deposits = append(result.Deposits, &displayableDeposit{
+			Nonce:  deposit.Nonce,
+			From:   deposit.DisplayableFrom,
+			To:     deposit.DisplayableTo,
+			Token:  deposit.DisplayableToken,
+			Amount: deposit.Amount.String(),
+		})
+	}
+
+	return result, nil
+}
+
+func computeMessageHash(ctx *cli.Context) error {
+	hash, err := messageHashFromInput(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(hash.Hex())
+
+	return nil
+}
+
+func messageHashFromInput(ctx *cli.Context) (common.Hash, error) {
+	buff, err := readInput(ctx)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	batchData := &batchInput{}
+	err = json.Unmarshal(buff, batchData)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("%w while decoding the batch", err)
+	}
+
+	batch, err := createTransferBatch(batchData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	argLists := batchProcessor.ExtractListMvxToEth(batch)
+
+	return ethereumClient.GenerateMessageHash(argLists, batch.ID)
+}
+
+func createTransferBatch(batchData *batchInput) (*bridgeCore.TransferBatch, error) {
+	batch := &bridgeCore.TransferBatch{
+		ID:       batchData.BatchID,
+		Deposits: make([]*bridgeCore.DepositTransfer, 0, len(batchData.Deposits)),
+	}
+
+	for index, deposit := range batchData.Deposits {
+		if !common.IsHexAddress(deposit.Recipient) {
+			return nil, fmt.Errorf("invalid recipient %s for deposit at index %d", deposit.Recipient, index)
+		}
+		if !common.IsHexAddress(deposit.Token) {
+			return nil, fmt.Errorf("invalid token %s for deposit at index %d", deposit.Token, index)
+		}
+		amount, ok := big.NewInt(0).SetString(deposit.Amount, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid amount %s for deposit at index %d", deposit.Amount, index)
+		}
+
+		batch.Deposits = append(batch.Deposits, &bridgeCore.DepositTransfer{
+			Nonce:                 deposit.Nonce,
+			ToBytes:               common.HexToAddress(deposit.Recipient).Bytes(),
+			DestinationTokenBytes: common.HexToAddress(deposit.Token).Bytes(),
+			Amount:                amount,
+		})
+	}
+
+	return batch, nil
+}
+
+func recoverSigners(ctx *cli.Context) error {
+	var hash common.Hash
+	if ctx.IsSet(messageHash.Name) {
+		hashBytes, err := decodeHex(ctx.String(messageHash.Name))
+		if err != nil {
+			return fmt.Errorf("%w in message hash", err)
+		}
+		if len(hashBytes) != common.HashLength {
+			return fmt.Errorf("invalid message hash length: expected %d, got %d", common.HashLength, len(hashBytes))
+		}
+		hash = common.BytesToHash(hashBytes)
+	} else {
+		var err error
+		hash, err = messageHashFromInput(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	sigs, err := parseHexList(ctx.String(signatures.Name))
+	if err != nil {
+		return fmt.Errorf("%w in signatures", err)
+	}
+
+	results := make([]*recoveredSigner, 0, len(sigs))
+	for _, sig := range sigs {
+		result := &recoveredSigner{
+			Signature: hex.EncodeToString(sig),
+		}
+
+		signer, errRecover := ethereumClient.RecoverSigner(hash, sig)
+		if errRecover != nil {
+			result.Error = errRecover.Error()
+		} else {
+			result.Address = signer.String()
+		}
+
+		results = append(results, result)
+	}
+
+	log.Info("recovered signers", "message hash", hash.Hex())
+
+	return printJson(results)
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDisplayableBatch(t *testing.T) {
+	t.Parallel()
+
+	from := bytes.Repeat([]byte{0x01}, 32)
+	to := bytes.Repeat([]byte{0x02}, 20)
+
+	tests := []struct {
+		name          string
+		items         [][]byte
+		expected      *displayableBatch
+		expectedError string
+	}{
+		{
+			name:          "empty return data should error",
+			items:         [][]byte{},
+			expectedError: "invalid number of arguments, got 0 argument(s)",
+		},
+		{
+			name:          "incomplete deposit should error",
+			items:         [][]byte{{0x01}, {0x02}, {0x03}},
+			expectedError: "invalid number of arguments, got 3 argument(s)",
+		},
+		{
+			name: "should work",
+			items: [][]byte{
+				{0x05},
+				{0x64}, {0x07}, from, to, []byte("ETHUSDC-0ae8ee"), {0x4e, 0x20},
+				{0x65}, {0x08}, from, to, []byte("ETHUSDC-0ae8ee"), {0x01},
+			},
+			expected: &displayableBatch{
+				ID:          5,
+				BlockNumber: 100,
+				Deposits: []*displayableDeposit{
+					{
+						Nonce:  7,
+						From:   toBech32(t, 0x01),
+						To:     "0x0202020202020202020202020202020202020202",
+						Token:  "ETHUSDC-0ae8ee",
+						Amount: "20000",
+					},
+					{
+						Nonce:  8,
+						From:   toBech32(t, 0x01),
+						To:     "0x0202020202020202020202020202020202020202",
+						Token:  "ETHUSDC-0ae8ee",
+						Amount: "1",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			batch, err := newDisplayableBatch(tt.items)
+			if len(tt.expectedError) > 0 {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, batch)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, batch)
+		})
+	}
+}
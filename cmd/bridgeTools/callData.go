@@ -0,0 +1,184 @@
+package main
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	"github.com/urfave/cli"
+)
+
+type displayableCallData struct {
+	Type      byte     `json:"type"`
+	Function  string   `json:"function"`
+	GasLimit  uint64   `json:"gasLimit"`
+	Arguments []string `json:"arguments"`
+}
+
+type displayablePendingOperation struct {
+	ID            uint64               `json:"id"`
+	From          string               `json:"from"`
+	To            string               `json:"to"`
+	Token         string               `json:"token"`
+	Amount        string               `json:"amount"`
+	Nonce         uint64               `json:"nonce"`
+	RawCallData   string               `json:"rawCallData"`
+	CallData      *displayableCallData `json:"callData,omitempty"`
+	CallDataError string               `json:"callDataError,omitempty"`
+}
+
+var codec = &parsers.MultiversxCodec{}
+
+func decodePendingOperations(ctx *cli.Context) error {
+	items, err := readReturnData(ctx)
+	if err != nil {
+		return err
+	}
+
+	operations, err := decodePendingOperationsFromReturnData(items)
+	if err != nil {
+		return err
+	}
+
+	return printJson(operations)
+}
+
+func decodePendingOperationsFromReturnData(items [][]byte) ([]*displayablePendingOperation, error) {
+	if len(items)%2 != 0 {
+		return nil, fmt.Errorf("invalid number of return data items: expected an even number, got %d", len(items))
+	}
+
+	operations := make([]*displayablePendingOperation, 0, len(items)/2)
+	for i := 0; i < len(items); i += 2 {
+		operation, errDecode := decodePendingOperation(items[i], items[i+1])
+		if errDecode != nil {
+			return nil, fmt.Errorf("%w for return data at index %d", errDecode, i+1)
+		}
+
+		operations = append(operations, operation)
+	}
+
+	sort.Slice(operations, func(i, j int) bool {
+		return operations[i].ID < operations[j].ID
+	})
+
+	return operations, nil
+}
+
+func decodePendingOperation(idBytes []byte, buff []byte) (*displayablePendingOperation, error) {
+	callData, err := codec.DecodeProxySCCompleteCallData(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddress, err := callData.To.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	operation := &displayablePendingOperation{
+		ID:          big.NewInt(0).SetBytes(idBytes).Uint64(),
+		From:        callData.From.String(),
+		To:          toAddress,
+		Token:       callData.Token,
+		Amount:      callData.Amount.String(),
+		Nonce:       callData.Nonce,
+		RawCallData: hex.EncodeToString(callData.RawCallData),
+	}
+
+	decodedCallData, err := codec.DecodeCallData(callData.RawCallData)
+	if err != nil {
+		operation.CallDataError = err.Error()
+		return operation, nil
+	}
+	operation.CallData = newDisplayableCallData(decodedCallData)
+
+	return operation, nil
+}
+
+func newDisplayableCallData(callData parsers.CallData) *displayableCallData {
+	result := &displayableCallData{
+		Type:      callData.Type,
+		Function:  callData.Function,
+		GasLimit:  callData.GasLimit,
+		Arguments: make([]string, 0, len(callData.Arguments)),
+	}
+	for _, arg := range callData.Arguments {
+		result.Arguments = append(result.Arguments, hex.EncodeToString([]byte(arg)))
+	}
+
+	return result
+}
+
+func encodeCallData(ctx *cli.Context) error {
+	args, err := parseHexList(ctx.String(arguments.Name))
+	if err != nil {
+		return fmt.Errorf("%w in arguments", err)
+	}
+
+	encoded, err := encodeCallDataToHex(ctx.String(function.Name), ctx.Uint64(gasLimit.Name), args, ctx.Bool(strict.Name))
+	if err != nil {
+		return err
+	}
+	fmt.Println(encoded)
+
+	return nil
+}
+
+func encodeCallDataToHex(functionName string, gasLimitValue uint64, args [][]byte, isStrict bool) (string, error) {
+	callData := parsers.CallData{
+		Type:      bridgeCore.DataPresentProtocolMarker,
+		Function:  functionName,
+		GasLimit:  gasLimitValue,
+		Arguments: make([]string, 0, len(args)),
+	}
+	for _, arg := range args {
+		callData.Arguments = append(callData.Arguments, string(arg))
+	}
+
+	if isStrict {
+		return hex.EncodeToString(codec.EncodeCallDataStrict(callData)), nil
+	}
+
+	buff, err := codec.EncodeCallData(callData)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(buff), nil
+}
+
+func decodeCallData(ctx *cli.Context) error {
+	callData, err := decodeCallDataFromHex(ctx.String(callDataHex.Name), ctx.Bool(strict.Name))
+	if err != nil {
+		return err
+	}
+
+	return printJson(callData)
+}
+
+func decodeCallDataFromHex(value string, isStrict bool) (*displayableCallData, error) {
+	buff, err := decodeHex(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if isStrict {
+		// the strict call data does not contain the protocol marker and the length, so we add them before decoding.
+		// The positions reported in the decoding errors will include these 5 bytes
+		header := []byte{bridgeCore.DataPresentProtocolMarker}
+		header = binary.BigEndian.AppendUint32(header, uint32(len(buff)))
+		buff = append(header, buff...)
+	}
+
+	callData, err := codec.DecodeCallData(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return newDisplayableCallData(callData), nil
+}
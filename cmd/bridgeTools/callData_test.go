@@ -0,0 +1,263 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	noArgsStrictCallDataHex   = "00000003616263000000000000c35000"
+	withArgsStrictCallDataHex = "00000003616263000000000000c3500100000002000000010100000002" + "0203"
+)
+
+func createPendingOperationBuffer(fromByte byte, toByte byte, nonce uint64, rawCallData []byte) []byte {
+	buff := bytes.Repeat([]byte{fromByte}, 20)
+	buff = append(buff, bytes.Repeat([]byte{toByte}, 32)...)
+	buff = binary.BigEndian.AppendUint32(buff, uint32(len("ETHUSDC-0ae8ee")))
+	buff = append(buff, "ETHUSDC-0ae8ee"...)
+	buff = binary.BigEndian.AppendUint32(buff, 2)
+	buff = append(buff, 0x4e, 0x20)
+	buff = binary.BigEndian.AppendUint64(buff, nonce)
+
+	return append(buff, rawCallData...)
+}
+
+func toBech32(t *testing.T, addressByte byte) string {
+	bech32Address, err := data.NewAddressFromBytes(bytes.Repeat([]byte{addressByte}, 32)).AddressAsBech32String()
+	require.Nil(t, err)
+
+	return bech32Address
+}
+
+func TestDecodePendingOperationsFromReturnData(t *testing.T) {
+	t.Parallel()
+
+	validCallData, _ := hex.DecodeString("01000000" + "10" + noArgsStrictCallDataHex)
+
+	tests := []struct {
+		name          string
+		items         [][]byte
+		expected      []*displayablePendingOperation
+		expectedError string
+	}{
+		{
+			name:     "empty return data should return no operations",
+			items:    [][]byte{},
+			expected: []*displayablePendingOperation{},
+		},
+		{
+			name:          "odd number of items should error",
+			items:         [][]byte{{0x01}},
+			expectedError: "invalid number of return data items: expected an even number, got 1",
+		},
+		{
+			name:          "buffer too short should error",
+			items:         [][]byte{{0x01}, {0x02}},
+			expectedError: "for return data at index 1",
+		},
+		{
+			name: "should decode and sort the operations",
+			items: [][]byte{
+				{0x02}, createPendingOperationBuffer(0x02, 0x03, 8, []byte{0x03}),
+				{0x01}, createPendingOperationBuffer(0x01, 0x01, 7, validCallData),
+			},
+			expected: []*displayablePendingOperation{
+				{
+					ID:          1,
+					From:        "0x0101010101010101010101010101010101010101",
+					To:          toBech32(t, 0x01),
+					Token:       "ETHUSDC-0ae8ee",
+					Amount:      "20000",
+					Nonce:       7,
+					RawCallData: hex.EncodeToString(validCallData),
+					CallData: &displayableCallData{
+						Type:      bridgeCore.DataPresentProtocolMarker,
+						Function:  "abc",
+						GasLimit:  50000,
+						Arguments: []string{},
+					},
+				},
+				{
+					ID:            2,
+					From:          "0x0202020202020202020202020202020202020202",
+					To:            toBech32(t, 0x03),
+					Token:         "ETHUSDC-0ae8ee",
+					Amount:        "20000",
+					Nonce:         8,
+					RawCallData:   "03",
+					CallDataError: "unexpected protocol indicator: 3 at position 0",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			operations, err := decodePendingOperationsFromReturnData(tt.items)
+			if len(tt.expectedError) > 0 {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, operations)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, operations)
+		})
+	}
+}
+
+func TestEncodeCallDataToHex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		function string
+		gasLimit uint64
+		args     [][]byte
+		strict   bool
+		expected string
+	}{
+		{
+			name:     "no arguments",
+			function: "abc",
+			gasLimit: 50000,
+			args:     [][]byte{},
+			expected: "0100000010" + noArgsStrictCallDataHex,
+		},
+		{
+			name:     "no arguments strict",
+			function: "abc",
+			gasLimit: 50000,
+			args:     [][]byte{},
+			strict:   true,
+			expected: noArgsStrictCallDataHex,
+		},
+		{
+			name:     "with arguments",
+			function: "abc",
+			gasLimit: 50000,
+			args:     [][]byte{{0x01}, {0x02, 0x03}},
+			expected: "010000001f" + withArgsStrictCallDataHex,
+		},
+		{
+			name:     "with arguments strict",
+			function: "abc",
+			gasLimit: 50000,
+			args:     [][]byte{{0x01}, {0x02, 0x03}},
+			strict:   true,
+			expected: withArgsStrictCallDataHex,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := encodeCallDataToHex(tt.function, tt.gasLimit, tt.args, tt.strict)
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, encoded)
+		})
+	}
+}
+
+func TestDecodeCallDataFromHex(t *testing.T) {
+	t.Parallel()
+
+	withArgs := &displayableCallData{
+		Type:      bridgeCore.DataPresentProtocolMarker,
+		Function:  "abc",
+		GasLimit:  50000,
+		Arguments: []string{"01", "0203"},
+	}
+
+	tests := []struct {
+		name          string
+		value         string
+		strict        bool
+		expected      *displayableCallData
+		expectedError string
+	}{
+		{
+			name:          "invalid hex should error",
+			value:         "0x0z",
+			expectedError: "invalid byte",
+		},
+		{
+			name:          "empty value should error",
+			value:         "",
+			expectedError: "buffer too short for protocol indicator",
+		},
+		{
+			name:          "malformed call data should error",
+			value:         "0100000010" + noArgsStrictCallDataHex[:20],
+			expectedError: "buffer length mismatch: actual 10, declared 16",
+		},
+		{
+			name:  "missing data marker",
+			value: "00",
+			expected: &displayableCallData{
+				Type:      bridgeCore.MissingDataProtocolMarker,
+				Arguments: []string{},
+			},
+		},
+		{
+			name:     "with arguments and hex prefix",
+			value:    "0x010000001f" + withArgsStrictCallDataHex,
+			expected: withArgs,
+		},
+		{
+			name:     "with arguments strict",
+			value:    withArgsStrictCallDataHex,
+			strict:   true,
+			expected: withArgs,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			callData, err := decodeCallDataFromHex(tt.value, tt.strict)
+			if len(tt.expectedError) > 0 {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				assert.Nil(t, callData)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, callData)
+		})
+	}
+}
+
+func TestEncodeDecodeCallDataRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	args := [][]byte{{0x01}, {}, bytes.Repeat([]byte{0xff}, 100)}
+	encoded, err := encodeCallDataToHex("deposit", 1000000, args, false)
+	require.Nil(t, err)
+
+	callData, err := decodeCallDataFromHex(encoded, false)
+	require.Nil(t, err)
+	assert.Equal(t, newDisplayableCallData(parsers.CallData{
+		Type:      bridgeCore.DataPresentProtocolMarker,
+		Function:  "deposit",
+		GasLimit:  1000000,
+		Arguments: []string{string(args[0]), string(args[1]), string(args[2])},
+	}), callData)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var (
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	input = cli.StringFlag{
+		Name: "input",
+		Usage: "The `" + filePathPlaceholder + "` for the .json input file. Use " + stdinMarker + " to read the " +
+			"input from the standard input",
+		Value: stdinMarker,
+	}
+	returnData = cli.StringFlag{
+		Name: "return-data",
+		Usage: "Comma-separated hex encoded items of a VM query return data. If set, the input file is not used. " +
+			"The input file can contain a proxy VM query response or a json array of base64 encoded items",
+	}
+	callDataHex = cli.StringFlag{
+		Name:  "data",
+		Usage: "The hex encoded call data",
+	}
+	function = cli.StringFlag{
+		Name:  "function",
+		Usage: "The function to be called on the MultiversX contract",
+	}
+	gasLimit = cli.Uint64Flag{
+		Name:  "gas-limit",
+		Usage: "The gas limit to be used when calling the MultiversX contract",
+	}
+	arguments = cli.StringFlag{
+		Name:  "arguments",
+		Usage: "Comma-separated hex encoded arguments for the MultiversX contract call",
+	}
+	strict = cli.BoolFlag{
+		Name: "strict",
+		Usage: "Boolean option for working with the call data without the protocol marker and the length, " +
+			"as it is provided in the Ethereum deposits",
+	}
+	messageHash = cli.StringFlag{
+		Name:  "message-hash",
+		Usage: "The hex encoded message hash. If set, the input file is not used",
+	}
+	signatures = cli.StringFlag{
+		Name:  "signatures",
+		Usage: "Comma-separated hex encoded signatures",
+	}
+	address = cli.StringFlag{
+		Name:  "address",
+		Usage: "The address to be converted. Can be a bech32 address, a hex encoded MultiversX address or an Ethereum address",
+	}
+)
+
+func getFlags() []cli.Flag {
+	return []cli.Flag{
+		logLevel,
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/urfave/cli"
+)
+
+const (
+	filePathPlaceholder = "[path]"
+	stdinMarker         = "-"
+	hexPrefix           = "0x"
+)
+
+var log = logger.GetOrCreate("main")
+
+func main() {
+	app := cli.NewApp()
+	app.Name = "Bridge tools CLI app"
+	app.Usage = "This tool encodes, decodes and verifies the bridge payloads. It works offline, from json or hex input"
+	app.Flags = getFlags()
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Before = func(c *cli.Context) error {
+		return logger.SetLogLevel(c.GlobalString(logLevel.Name))
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "decode-pending",
+			Usage:  "decodes the getPendingTransactions response of the SC proxy contract",
+			Flags:  []cli.Flag{input, returnData},
+			Action: decodePendingOperations,
+		},
+		{
+			Name:   "encode-call-data",
+			Usage:  "encodes the call data for an Ethereum deposit with SC call",
+			Flags:  []cli.Flag{function, gasLimit, arguments, strict},
+			Action: encodeCallData,
+		},
+		{
+			Name:   "decode-call-data",
+			Usage:  "decodes the call data of a deposit with SC call",
+			Flags:  []cli.Flag{callDataHex, strict},
+			Action: decodeCallData,
+		},
+		{
+			Name:   "print-batch",
+			Usage:  "pretty-prints the getBatch or getCurrentTxBatch response of the MultiversX multisig contract",
+			Flags:  []cli.Flag{input, returnData},
+			Action: printBatch,
+		},
+		{
+			Name:   "message-hash",
+			Usage:  "computes the message hash signed by the relayers for a MultiversX to Ethereum batch",
+			Flags:  []cli.Flag{input},
+			Action: computeMessageHash,
+		},
+		{
+			Name:   "recover-signer",
+			Usage:  "recovers the Ethereum address of the relayer that produced the provided signatures",
+			Flags:  []cli.Flag{input, messageHash, signatures},
+			Action: recoverSigners,
+		},
+		{
+			Name:   "convert-address",
+			Usage:  "converts an address between the bech32, hex and Ethereum representations",
+			Flags:  []cli.Flag{address},
+			Action: convertAddress,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func readInput(ctx *cli.Context) ([]byte, error) {
+	inputFile := ctx.String(input.Name)
+	if inputFile == stdinMarker {
+		return io.ReadAll(os.Stdin)
+	}
+
+	return os.ReadFile(inputFile)
+}
+
+// readReturnData reads the VM query return data either from the hex flag or from the json input. The json input
+// can be a proxy VM query response or an array of base64 encoded items
+func readReturnData(ctx *cli.Context) ([][]byte, error) {
+	if ctx.IsSet(returnData.Name) {
+		return parseHexList(ctx.String(returnData.Name))
+	}
+
+	buff, err := readInput(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &data.ResponseVmValue{}
+	err = json.Unmarshal(buff, response)
+	if err == nil && response.Data.Data != nil {
+		return response.Data.Data.ReturnData, nil
+	}
+
+	items := make([][]byte, 0)
+	err = json.Unmarshal(buff, &items)
+	if err != nil {
+		return nil, fmt.Errorf("%w while decoding the return data, expected a VM query response or an array of base64 items", err)
+	}
+
+	return items, nil
+}
+
+func parseHexList(value string) ([][]byte, error) {
+	items := make([][]byte, 0)
+	if len(strings.TrimSpace(value)) == 0 {
+		return items, nil
+	}
+
+	for index, item := range strings.Split(value, ",") {
+		decoded, err := decodeHex(item)
+		if err != nil {
+			return nil, fmt.Errorf("%w for item at index %d", err, index)
+		}
+
+		items = append(items, decoded)
+	}
+
+	return items, nil
+}
+
+func decodeHex(value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	value = strings.TrimPrefix(value, hexPrefix)
+
+	return hex.DecodeString(value)
+}
+
+func printJson(value interface{}) error {
+	buff, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(buff))
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package disabled
+
+import sdkCore "github.com/multiversx/mx-sdk-go/core"
+
+// RoleProvider represents the disabled role provider implementation
+type RoleProvider struct {
+}
+
+// IsWhitelisted returns true, the whitelist check is done by the multisig contract
+func (provider *RoleProvider) IsWhitelisted(_ sdkCore.AddressHandler) bool {
+	return true
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *RoleProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
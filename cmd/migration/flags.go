@@ -25,12 +25,14 @@ var (
 	}
 	mode = cli.StringFlag{
 		Name:  "mode",
-		Usage: "This flag specifies the operation mode. Usage: query, sign or execute",
+		Usage: "This flag specifies the operation mode. Usage: query, sign, execute, simulate, collect or verify",
 		Value: queryMode,
 	}
 	migrationJsonFile = cli.StringFlag{
-		Name:  "migration-file",
-		Usage: "The output .json file containing the migration data",
+		Name: "migration-file",
+		Usage: "The .json file containing the migration data. In sign, execute, simulate and collect modes, if this flag is set, the " +
+			"batch is loaded from this file and re-checked against the chain state instead of being regenerated, a missing file " +
+			"being an error. Otherwise, the newly generated batch is written in the default file. In verify mode, it is the executed migration batch",
 		Value: path.Join(configPath, "migration-"+timestampPlaceholder+".json"),
 	}
 	signatureJsonFile = cli.StringFlag{
@@ -38,9 +40,38 @@ var (
 		Usage: "The output .json file containing the signature data",
 		Value: path.Join(configPath, publicKeyPlaceholder+"-"+timestampPlaceholder+".json"),
 	}
+	signaturesServer = cli.StringFlag{
+		Name: "signatures-server",
+		Usage: "The URL of the signatures collector server (started with the collect mode). If set, the sign mode " +
+			"submits the signature to it and the execute mode pulls the collected signatures from it, along with " +
+			"the ones found in the config directory",
+		Value: "",
+	}
+	collectorListenAddress = cli.StringFlag{
+		Name: "collector-listen-address",
+		Usage: "The address on which the signatures collector server listens, in collect mode. Use an address " +
+			"reachable by the other relayers only when they submit their signatures over the network",
+		Value: "127.0.0.1:8090",
+	}
+	txHash = cli.StringFlag{
+		Name:  "tx-hash",
+		Usage: "The hash of the executed migration transaction, in verify mode",
+		Value: "",
+	}
+	verificationReportFile = cli.StringFlag{
+		Name:  "verification-report-file",
+		Usage: "The output .json file containing the verification report, in verify mode",
+		Value: path.Join(configPath, "verification-"+timestampPlaceholder+".json"),
+	}
+	migrationSide = cli.StringFlag{
+		Name: "migration-side",
+		Usage: "This flag specifies the chain on which the safe is migrated. Usage: ethereum or multiversx. The " +
+			"multiversx side supports only the query, sign and execute modes",
+		Value: ethereumSide,
+	}
 	newSafeAddress = cli.StringFlag{
 		Name:  "new-safe-address",
-		Usage: "The new safe address on Ethereum",
+		Usage: "The new safe address on Ethereum or the bech32 new safe address on MultiversX, depending on the migration side",
 		Value: "",
 	}
 	partialMigration = cli.StringFlag{
@@ -57,8 +88,13 @@ func getFlags() []cli.Flag {
 		logLevel,
 		configurationFile,
 		mode,
+		migrationSide,
 		migrationJsonFile,
 		signatureJsonFile,
+		signaturesServer,
+		collectorListenAddress,
+		txHash,
+		verificationReportFile,
 		newSafeAddress,
 		partialMigration,
 	}
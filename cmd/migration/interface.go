@@ -6,10 +6,33 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/multiversx/mx-bridge-eth-go/executors/ethereum"
+	mvxMigration "github.com/multiversx/mx-bridge-eth-go/executors/multiversx/migration"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
 )
 
 // BatchCreator defines the operations implemented by an entity that can create an Ethereum batch message that can be used
 // in signing or transfer execution
 type BatchCreator interface {
 	CreateBatchInfo(ctx context.Context, newSafeAddress common.Address, partialMigration map[string]*big.Float) (*ethereum.BatchInfo, error)
+	VerifyBatchInfo(ctx context.Context, batch *ethereum.BatchInfo) error
+}
+
+// BatchExecutor defines the operations implemented by an entity that can simulate or execute the migration batch
+type BatchExecutor interface {
+	ExecuteTransfer(ctx context.Context) error
+	SimulateTransfer(ctx context.Context) error
+}
+
+// MultiversXBatchCreator defines the operations implemented by an entity that can create the MultiversX migration batch
+// that is proposed on the multisig contract
+type MultiversXBatchCreator interface {
+	CreateBatchInfo(ctx context.Context, newSafeAddress sdkCore.AddressHandler) (*mvxMigration.BatchInfo, error)
+	VerifyBatchInfo(ctx context.Context, batch *mvxMigration.BatchInfo) error
+}
+
+// MultiversXBatchExecutor defines the operations implemented by an entity that can propose, sign and perform the
+// MultiversX migration batch
+type MultiversXBatchExecutor interface {
+	ProposeOrSign(ctx context.Context) error
+	ExecuteTransfer(ctx context.Context) error
 }
@@ -6,13 +6,17 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	ethereumClient "github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/wrappers"
 	"github.com/multiversx/mx-bridge-eth-go/clients/gasManagement"
 	"github.com/multiversx/mx-bridge-eth-go/clients/gasManagement/factory"
 	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
@@ -35,9 +39,14 @@ const (
 	queryMode            = "query"
 	signMode             = "sign"
 	executeMode          = "execute"
+	simulateMode         = "simulate"
+	collectMode          = "collect"
+	verifyMode           = "verify"
 	configPath           = "config"
 	timestampPlaceholder = "[timestamp]"
 	publicKeyPlaceholder = "[public-key]"
+	ethereumSide         = "ethereum"
+	multiversXSide       = "multiversx"
 )
 
 var log = logger.GetOrCreate("main")
@@ -48,6 +57,8 @@ type internalComponents struct {
 	cryptoHandler        ethereumClient.CryptoHandler
 	ethClient            *ethclient.Client
 	ethereumChainWrapper ethereum.EthereumChainWrapper
+	erc20ContractsHolder ethereum.Erc20ContractsHolder
+	mvxBalancesGetter    ethereum.MvxBalancesGetter
 }
 
 func main() {
@@ -91,6 +102,15 @@ func execute(ctx *cli.Context) error {
 	log.Info("starting migration help tool", "pid", os.Getpid())
 
 	operationMode := strings.ToLower(ctx.GlobalString(mode.Name))
+	side := strings.ToLower(ctx.GlobalString(migrationSide.Name))
+	switch side {
+	case ethereumSide:
+	case multiversXSide:
+		return executeMultiversXMigration(ctx, cfg, operationMode)
+	default:
+		return fmt.Errorf("unknown migration side: %s", side)
+	}
+
 	switch operationMode {
 	case queryMode:
 		return executeQuery(cfg)
@@ -99,6 +119,12 @@ func execute(ctx *cli.Context) error {
 		return err
 	case executeMode:
 		return executeTransfer(ctx, cfg)
+	case simulateMode:
+		return simulateTransfer(ctx, cfg)
+	case collectMode:
+		return collectSignatures(ctx, cfg)
+	case verifyMode:
+		return verifyMigration(ctx, cfg)
 	}
 
 	return fmt.Errorf("unknown execution mode: %s", operationMode)
@@ -125,38 +151,15 @@ func executeQuery(cfg config.MigrationToolConfig) error {
 }
 
 func createInternalComponentsWithBatchCreator(cfg config.MigrationToolConfig) (*internalComponents, error) {
-	argsProxy := blockchain.ArgsProxy{
-		ProxyURL:            cfg.MultiversX.NetworkAddress,
-		SameScState:         false,
-		ShouldBeSynced:      false,
-		FinalityCheck:       cfg.MultiversX.Proxy.FinalityCheck,
-		AllowedDeltaToFinal: cfg.MultiversX.Proxy.MaxNoncesDelta,
-		CacheExpirationTime: time.Second * time.Duration(cfg.MultiversX.Proxy.CacherExpirationSeconds),
-		EntityType:          sdkCore.RestAPIEntityType(cfg.MultiversX.Proxy.RestAPIEntityType),
-	}
-	proxy, err := blockchain.NewProxy(argsProxy)
-	if err != nil {
-		return nil, err
-	}
-
-	dummyAddress := data.NewAddressFromBytes(bytes.Repeat([]byte{0x1}, 32))
-	multisigAddress, err := data.NewAddressFromBech32String(cfg.MultiversX.MultisigContractAddress)
+	proxy, err := createMultiversXProxy(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	safeAddress, err := data.NewAddressFromBech32String(cfg.MultiversX.SafeContractAddress)
+	argsMXClientDataGetter, err := createArgsMXClientDataGetter(cfg, proxy)
 	if err != nil {
 		return nil, err
 	}
-
-	argsMXClientDataGetter := multiversx.ArgsMXClientDataGetter{
-		MultisigContractAddress: multisigAddress,
-		SafeContractAddress:     safeAddress,
-		RelayerAddress:          dummyAddress,
-		Proxy:                   proxy,
-		Log:                     log,
-	}
 	mxDataGetter, err := multiversx.NewMXClientDataGetter(argsMXClientDataGetter)
 	if err != nil {
 		return nil, err
@@ -185,9 +188,10 @@ func createInternalComponentsWithBatchCreator(cfg config.MigrationToolConfig) (*
 	}
 
 	argsClientWrapper := bridgeV2Wrappers.ArgsEthereumChainWrapper{
-		StatusHandler:    &disabled.StatusHandler{},
-		MultiSigContract: multiSigInstance,
-		BlockchainClient: ethClient,
+		StatusHandler:           &disabled.StatusHandler{},
+		MultiSigContract:        multiSigInstance,
+		MultiSigContractAddress: bridgeEthAddress,
+		BlockchainClient:        ethClient,
 	}
 	ethereumChainWrapper, err := bridgeV2Wrappers.NewEthereumChainWrapper(argsClientWrapper)
 	if err != nil {
@@ -211,32 +215,53 @@ func createInternalComponentsWithBatchCreator(cfg config.MigrationToolConfig) (*
 		creator:              creator,
 		ethClient:            ethClient,
 		ethereumChainWrapper: ethereumChainWrapper,
+		erc20ContractsHolder: erc20ContractsHolder,
+		mvxBalancesGetter:    mxDataGetter,
 	}, nil
 }
 
-func generateAndSign(ctx *cli.Context, cfg config.MigrationToolConfig) (*internalComponents, error) {
-	components, err := createInternalComponentsWithBatchCreator(cfg)
-	if err != nil {
-		return nil, err
+func createMultiversXProxy(cfg config.MigrationToolConfig) (multiversx.Proxy, error) {
+	argsProxy := blockchain.ArgsProxy{
+		ProxyURL:            cfg.MultiversX.NetworkAddress,
+		SameScState:         false,
+		ShouldBeSynced:      false,
+		FinalityCheck:       cfg.MultiversX.Proxy.FinalityCheck,
+		AllowedDeltaToFinal: cfg.MultiversX.Proxy.MaxNoncesDelta,
+		CacheExpirationTime: time.Second * time.Duration(cfg.MultiversX.Proxy.CacherExpirationSeconds),
+		EntityType:          sdkCore.RestAPIEntityType(cfg.MultiversX.Proxy.RestAPIEntityType),
 	}
 
-	newSafeAddressString := ctx.GlobalString(newSafeAddress.Name)
-	if len(newSafeAddressString) == 0 {
-		return nil, fmt.Errorf("invalid new safe address for Ethereum")
+	return blockchain.NewProxy(argsProxy)
+}
+
+func createArgsMXClientDataGetter(cfg config.MigrationToolConfig, proxy multiversx.Proxy) (multiversx.ArgsMXClientDataGetter, error) {
+	dummyAddress := data.NewAddressFromBytes(bytes.Repeat([]byte{0x1}, 32))
+	multisigAddress, err := data.NewAddressFromBech32String(cfg.MultiversX.MultisigContractAddress)
+	if err != nil {
+		return multiversx.ArgsMXClientDataGetter{}, err
 	}
-	newSafeAddressValue := common.HexToAddress(ctx.GlobalString(newSafeAddress.Name))
 
-	partialMigration, err := ethereum.ConvertPartialMigrationStringToMap(ctx.GlobalString(partialMigration.Name))
+	safeAddress, err := data.NewAddressFromBech32String(cfg.MultiversX.SafeContractAddress)
 	if err != nil {
-		return nil, err
+		return multiversx.ArgsMXClientDataGetter{}, err
 	}
 
-	components.batch, err = components.creator.CreateBatchInfo(context.Background(), newSafeAddressValue, partialMigration)
+	return multiversx.ArgsMXClientDataGetter{
+		MultisigContractAddress: multisigAddress,
+		SafeContractAddress:     safeAddress,
+		RelayerAddress:          dummyAddress,
+		Proxy:                   proxy,
+		Log:                     log,
+	}, nil
+}
+
+func createInternalComponentsWithBatch(ctx *cli.Context, cfg config.MigrationToolConfig) (*internalComponents, error) {
+	components, err := createInternalComponentsWithBatchCreator(cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	val, err := json.MarshalIndent(components.batch, "", "  ")
+	components.batch, err = loadOrGenerateBatch(ctx, components)
 	if err != nil {
 		return nil, err
 	}
@@ -246,19 +271,19 @@ func generateAndSign(ctx *cli.Context, cfg config.MigrationToolConfig) (*interna
 		return nil, err
 	}
 
-	log.Info("signing batch", "message hash", components.batch.MessageHash.String(),
-		"public key", components.cryptoHandler.GetAddress().String())
+	return components, nil
+}
 
-	signature, err := components.cryptoHandler.Sign(components.batch.MessageHash)
+func generateAndSign(ctx *cli.Context, cfg config.MigrationToolConfig) (*internalComponents, error) {
+	components, err := createInternalComponentsWithBatch(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	log.Info("Migration .json file contents: \n" + string(val))
+	log.Info("signing batch", "message hash", components.batch.MessageHash.String(),
+		"public key", components.cryptoHandler.GetAddress().String())
 
-	jsonFilename := ctx.GlobalString(migrationJsonFile.Name)
-	jsonFilename = applyTimestamp(jsonFilename)
-	err = os.WriteFile(jsonFilename, val, os.ModePerm)
+	signature, err := components.cryptoHandler.Sign(components.batch.MessageHash)
 	if err != nil {
 		return nil, err
 	}
@@ -272,7 +297,7 @@ func generateAndSign(ctx *cli.Context, cfg config.MigrationToolConfig) (*interna
 	sigFilename := ctx.GlobalString(signatureJsonFile.Name)
 	sigFilename = applyTimestamp(sigFilename)
 	sigFilename = applyPublicKey(sigFilename, sigInfo.Address)
-	val, err = json.MarshalIndent(sigInfo, "", "  ")
+	val, err := json.MarshalIndent(sigInfo, "", "  ")
 	if err != nil {
 		return nil, err
 	}
@@ -284,15 +309,146 @@ func generateAndSign(ctx *cli.Context, cfg config.MigrationToolConfig) (*interna
 		return nil, err
 	}
 
+	err = submitSignature(ctx, *sigInfo)
+	if err != nil {
+		return nil, err
+	}
+
 	return components, nil
 }
 
+func submitSignature(ctx *cli.Context, sigInfo ethereum.SignatureInfo) error {
+	serverURL := ctx.GlobalString(signaturesServer.Name)
+	if len(serverURL) == 0 {
+		return nil
+	}
+
+	status, err := ethereum.SubmitSignature(context.Background(), serverURL, sigInfo)
+	if err != nil {
+		return fmt.Errorf("%w while submitting the signature to %s", err, serverURL)
+	}
+
+	log.Info("signature submitted to the signatures collector", "server", serverURL,
+		"num signatures", status.NumSignatures, "quorum", status.Quorum, "quorum reached", status.QuorumReached)
+
+	return nil
+}
+
+func loadOrGenerateBatch(ctx *cli.Context, components *internalComponents) (*ethereum.BatchInfo, error) {
+	migrationFilename, isInput, err := getInputMigrationFile(ctx.IsSet(migrationJsonFile.Name), ctx.GlobalString(migrationJsonFile.Name))
+	if err != nil {
+		return nil, err
+	}
+	if isInput {
+		return loadAndVerifyBatch(components, migrationFilename)
+	}
+
+	return generateBatch(ctx, components)
+}
+
+// getInputMigrationFile returns the migration file name and true if the batch should be loaded from an existing file.
+// An explicitly provided migration file that can not be accessed is an error, a new batch is generated only if the
+// flag was not set
+func getInputMigrationFile(isSet bool, filename string) (string, bool, error) {
+	if !isSet {
+		return "", false, nil
+	}
+
+	_, err := os.Stat(filename)
+	if err != nil {
+		return "", false, fmt.Errorf("%w for the provided migration file %s", err, filename)
+	}
+
+	return filename, true, nil
+}
+
+func loadAndVerifyBatch(components *internalComponents, filename string) (*ethereum.BatchInfo, error) {
+	batch, err := ethereum.LoadBatchInfo(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("loaded the batch from the migration file", "file", filename, "batch ID", batch.BatchID)
+
+	err = components.creator.VerifyBatchInfo(context.Background(), batch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while verifying the batch from file %s", err, filename)
+	}
+
+	return batch, nil
+}
+
+func generateBatch(ctx *cli.Context, components *internalComponents) (*ethereum.BatchInfo, error) {
+	newSafeAddressString := ctx.GlobalString(newSafeAddress.Name)
+	if len(newSafeAddressString) == 0 {
+		return nil, fmt.Errorf("invalid new safe address for Ethereum")
+	}
+	newSafeAddressValue := common.HexToAddress(ctx.GlobalString(newSafeAddress.Name))
+
+	partialMigration, err := ethereum.ConvertPartialMigrationStringToMap(ctx.GlobalString(partialMigration.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := components.creator.CreateBatchInfo(context.Background(), newSafeAddressValue, partialMigration)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := json.MarshalIndent(batch, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Migration .json file contents: \n" + string(val))
+
+	jsonFilename := ctx.GlobalString(migrationJsonFile.Name)
+	jsonFilename = applyTimestamp(jsonFilename)
+	err = os.WriteFile(jsonFilename, val, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
 func executeTransfer(ctx *cli.Context, cfg config.MigrationToolConfig) error {
 	components, err := generateAndSign(ctx, cfg)
 	if err != nil {
 		return err
 	}
 
+	executor, err := createMigrationBatchExecutor(ctx, cfg, components)
+	if err != nil {
+		return err
+	}
+
+	return executor.ExecuteTransfer(context.Background())
+}
+
+func simulateTransfer(ctx *cli.Context, cfg config.MigrationToolConfig) error {
+	components, err := createInternalComponentsWithBatch(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	executor, err := createMigrationBatchExecutor(ctx, cfg, components)
+	if err != nil {
+		return err
+	}
+
+	log.Info("simulating the migration batch", "batch ID", components.batch.BatchID,
+		"sender", components.cryptoHandler.GetAddress().String())
+
+	return executor.SimulateTransfer(context.Background())
+}
+
+func createMigrationBatchExecutor(ctx *cli.Context, cfg config.MigrationToolConfig, components *internalComponents) (BatchExecutor, error) {
+	signatures, err := loadSignatures(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	gasStationConfig := cfg.Eth.GasStation
 	argsGasStation := gasManagement.ArgsGasStation{
 		RequestURL:             gasStationConfig.URL,
@@ -306,26 +462,202 @@ func executeTransfer(ctx *cli.Context, cfg config.MigrationToolConfig) error {
 	}
 	gs, err := factory.CreateGasStation(argsGasStation, gasStationConfig.Enabled)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	args := ethereum.ArgsMigrationBatchExecutor{
 		EthereumChainWrapper:    components.ethereumChainWrapper,
 		CryptoHandler:           components.cryptoHandler,
 		Batch:                   *components.batch,
-		Signatures:              ethereum.LoadAllSignatures(log, configPath),
+		Signatures:              signatures,
 		Logger:                  log,
 		GasHandler:              gs,
 		TransferGasLimitBase:    cfg.Eth.GasLimitBase,
 		TransferGasLimitForEach: cfg.Eth.GasLimitForEach,
 	}
 
-	executor, err := ethereum.NewMigrationBatchExecutor(args)
+	return ethereum.NewMigrationBatchExecutor(args)
+}
+
+// loadSignatures returns the signatures found in the config directory together with the ones pulled from the
+// signatures collector server, if set
+func loadSignatures(ctx *cli.Context) ([]ethereum.SignatureInfo, error) {
+	signatures := ethereum.LoadAllSignatures(log, configPath)
+
+	serverURL := ctx.GlobalString(signaturesServer.Name)
+	if len(serverURL) == 0 {
+		return signatures, nil
+	}
+
+	collectedSignatures, err := ethereum.FetchSignatures(context.Background(), serverURL)
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the signatures from %s", err, serverURL)
+	}
+
+	log.Info("fetched signatures from the signatures collector", "server", serverURL, "num signatures", len(collectedSignatures))
+
+	return append(signatures, collectedSignatures...), nil
+}
+
+func collectSignatures(ctx *cli.Context, cfg config.MigrationToolConfig) error {
+	components, err := createInternalComponentsWithBatchCreator(cfg)
 	if err != nil {
 		return err
 	}
 
-	return executor.ExecuteTransfer(context.Background())
+	components.batch, err = loadOrGenerateBatch(ctx, components)
+	if err != nil {
+		return err
+	}
+
+	relayers, err := components.ethereumChainWrapper.GetRelayers(context.Background())
+	if err != nil {
+		return err
+	}
+
+	quorum, err := components.ethereumChainWrapper.Quorum(context.Background())
+	if err != nil {
+		return err
+	}
+
+	argsCollector := ethereum.ArgsSignaturesCollector{
+		Batch:    components.batch,
+		Relayers: relayers,
+		Quorum:   quorum.Uint64(),
+		Logger:   log,
+	}
+	collector, err := ethereum.NewSignaturesCollector(argsCollector)
+	if err != nil {
+		return err
+	}
+
+	for _, sigInfo := range ethereum.LoadAllSignatures(log, configPath) {
+		errAdd := collector.AddSignature(sigInfo)
+		if errAdd != nil {
+			log.Debug("ignoring signature file from the config directory", "address", sigInfo.Address, "error", errAdd)
+		}
+	}
+
+	handler, err := ethereum.NewSignaturesCollectorHandler(collector, log)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              ctx.GlobalString(collectorListenAddress.Name),
+		Handler:           handler,
+		ReadHeaderTimeout: time.Second * 10,
+	}
+
+	chServerErr := make(chan error, 1)
+	go func() {
+		chServerErr <- server.ListenAndServe()
+	}()
+
+	log.Info("signatures collector started", "address", server.Addr, "batch ID", components.batch.BatchID,
+		"message hash", components.batch.MessageHash.String(), "quorum", quorum.Uint64())
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+
+	select {
+	case err = <-chServerErr:
+		return err
+	case <-sigs:
+	}
+
+	status := collector.Status()
+	log.Info("closing the signatures collector", "num signatures", status.NumSignatures,
+		"quorum", status.Quorum, "quorum reached", status.QuorumReached)
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	return server.Shutdown(shutdownCtx)
+}
+
+func verifyMigration(ctx *cli.Context, cfg config.MigrationToolConfig) error {
+	if !ctx.IsSet(migrationJsonFile.Name) {
+		return fmt.Errorf("the migration file should be provided in verify mode")
+	}
+	txHashString := ctx.GlobalString(txHash.Name)
+	if len(txHashString) == 0 {
+		return fmt.Errorf("the executed transaction hash should be provided in verify mode")
+	}
+
+	components, err := createInternalComponentsWithBatchCreator(cfg)
+	if err != nil {
+		return err
+	}
+
+	batch, err := ethereum.LoadBatchInfo(ctx.GlobalString(migrationJsonFile.Name))
+	if err != nil {
+		return err
+	}
+
+	// the migration moves the funds from the v2 safe contract to the current version safe contract
+	oldSafeBalancesGetter, err := createSafeBalancesGetter(cfg, components.ethClient, core.ContractsVersionV2, batch.OldSafeContractAddress)
+	if err != nil {
+		return err
+	}
+	newSafeBalancesGetter, err := createSafeBalancesGetter(cfg, components.ethClient, core.ContractsVersionV3, batch.NewSafeContractAddress)
+	if err != nil {
+		return err
+	}
+
+	argsVerifier := ethereum.ArgsMigrationVerifier{
+		Erc20ContractsHolder:     components.erc20ContractsHolder,
+		MvxBalancesGetter:        components.mvxBalancesGetter,
+		OldSafeBalancesGetter:    oldSafeBalancesGetter,
+		NewSafeBalancesGetter:    newSafeBalancesGetter,
+		EthereumChainWrapper:     components.ethereumChainWrapper,
+		TransactionReceiptGetter: components.ethClient,
+		Logger:                   log,
+	}
+	verifier, err := ethereum.NewMigrationVerifier(argsVerifier)
+	if err != nil {
+		return err
+	}
+
+	report, err := verifier.VerifyMigration(context.Background(), batch, common.HexToHash(txHashString))
+	if err != nil {
+		return err
+	}
+
+	val, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	reportFilename := applyTimestamp(ctx.GlobalString(verificationReportFile.Name))
+	err = os.WriteFile(reportFilename, val, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Migration verification report, saved in " + reportFilename + "\n" + ethereum.VerificationReportDisplayString(report))
+	if !report.Success {
+		return fmt.Errorf("migration verification failed for batch ID %d", report.BatchID)
+	}
+
+	return nil
+}
+
+func createSafeBalancesGetter(
+	cfg config.MigrationToolConfig,
+	ethClient *ethclient.Client,
+	contractsVersion core.ContractsVersion,
+	safeAddress string,
+) (ethereum.EthSafeBalancesGetter, error) {
+	argsWrapper := wrappers.ArgsVersionedEthereumChainWrapper{
+		StatusHandler:           &disabled.StatusHandler{},
+		ContractsVersion:        contractsVersion,
+		MultiSigContractAddress: common.HexToAddress(cfg.Eth.MultisigContractAddress),
+		SafeContractAddress:     common.HexToAddress(safeAddress),
+		BlockchainClient:        ethClient,
+	}
+
+	return wrappers.NewVersionedEthereumChainWrapper(argsWrapper)
 }
 
 func loadConfig(filepath string) (config.MigrationToolConfig, error) {
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInputMigrationFile(t *testing.T) {
+	t.Parallel()
+
+	existingFile := filepath.Join(t.TempDir(), "migration.json")
+	err := os.WriteFile(existingFile, []byte("{}"), os.ModePerm)
+	assert.Nil(t, err)
+	missingFile := filepath.Join(t.TempDir(), "missing.json")
+
+	tests := []struct {
+		name             string
+		isSet            bool
+		filename         string
+		expectedFilename string
+		expectedIsInput  bool
+		expectedError    bool
+	}{
+		{
+			name:     "flag not set should generate a new batch",
+			isSet:    false,
+			filename: missingFile,
+		},
+		{
+			name:             "flag set with an existing file should load the batch",
+			isSet:            true,
+			filename:         existingFile,
+			expectedFilename: existingFile,
+			expectedIsInput:  true,
+		},
+		{
+			name:          "flag set with a missing file should error",
+			isSet:         true,
+			filename:      missingFile,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filename, isInput, errGet := getInputMigrationFile(tt.isSet, tt.filename)
+			if tt.expectedError {
+				assert.True(t, os.IsNotExist(errors.Unwrap(errGet)))
+				assert.Contains(t, errGet.Error(), tt.filename)
+			} else {
+				assert.Nil(t, errGet)
+			}
+			assert.Equal(t, tt.expectedFilename, filename)
+			assert.Equal(t, tt.expectedIsInput, isInput)
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum/contract"
+	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx"
+	"github.com/multiversx/mx-bridge-eth-go/clients/multiversx/mappers"
+	"github.com/multiversx/mx-bridge-eth-go/cmd/migration/disabled"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	mvxMigration "github.com/multiversx/mx-bridge-eth-go/executors/multiversx/migration"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/urfave/cli"
+)
+
+type mvxInternalComponents struct {
+	creator    MultiversXBatchCreator
+	batch      *mvxMigration.BatchInfo
+	proxy      multiversx.Proxy
+	dataGetter mappers.DataGetter
+}
+
+func executeMultiversXMigration(ctx *cli.Context, cfg config.MigrationToolConfig, operationMode string) error {
+	switch operationMode {
+	case queryMode:
+		return executeMultiversXQuery(cfg)
+	case signMode:
+		_, err := generateAndSignMultiversX(ctx, cfg)
+		return err
+	case executeMode:
+		return executeMultiversXTransfer(ctx, cfg)
+	}
+
+	return fmt.Errorf("execution mode %s is not supported on the %s migration side", operationMode, multiversXSide)
+}
+
+func executeMultiversXQuery(cfg config.MigrationToolConfig) error {
+	components, err := createMultiversXInternalComponentsWithBatchCreator(cfg)
+	if err != nil {
+		return err
+	}
+
+	dummyNewSafeAddress := data.NewAddressFromBytes(make([]byte, 32))
+	info, err := components.creator.CreateBatchInfo(context.Background(), dummyNewSafeAddress)
+	if err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("Token balances for MultiversX safe address %s\n%s",
+		cfg.MultiversX.SafeContractAddress,
+		mvxMigration.TokensBalancesDisplayString(info),
+	))
+
+	return nil
+}
+
+func createMultiversXInternalComponentsWithBatchCreator(cfg config.MigrationToolConfig) (*mvxInternalComponents, error) {
+	proxy, err := createMultiversXProxy(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	argsMXClientDataGetter, err := createArgsMXClientDataGetter(cfg, proxy)
+	if err != nil {
+		return nil, err
+	}
+	mxDataGetter, err := multiversx.NewMXClientDataGetter(argsMXClientDataGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	ethClient, err := ethclient.Dial(cfg.Eth.NetworkAddress)
+	if err != nil {
+		return nil, err
+	}
+	ethereumSafe, err := contract.NewERC20Safe(common.HexToAddress(cfg.Eth.SafeContractAddress), ethClient)
+	if err != nil {
+		return nil, err
+	}
+
+	argsCreator := mvxMigration.ArgsMigrationBatchCreator{
+		MvxDataGetter:       mxDataGetter,
+		SafeContractAddress: argsMXClientDataGetter.SafeContractAddress,
+		EthereumSafe:        ethereumSafe,
+		RefundEthAddress:    common.HexToAddress(cfg.Eth.SafeContractAddress),
+		Logger:              log,
+	}
+	creator, err := mvxMigration.NewMigrationBatchCreator(argsCreator)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mvxInternalComponents{
+		creator:    creator,
+		proxy:      proxy,
+		dataGetter: mxDataGetter,
+	}, nil
+}
+
+func generateAndSignMultiversX(ctx *cli.Context, cfg config.MigrationToolConfig) (MultiversXBatchExecutor, error) {
+	components, err := createMultiversXInternalComponentsWithBatchCreator(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	components.batch, err = loadOrGenerateMultiversXBatch(ctx, components)
+	if err != nil {
+		return nil, err
+	}
+
+	executor, err := createMultiversXMigrationBatchExecutor(cfg, components)
+	if err != nil {
+		return nil, err
+	}
+
+	err = executor.ProposeOrSign(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return executor, nil
+}
+
+func executeMultiversXTransfer(ctx *cli.Context, cfg config.MigrationToolConfig) error {
+	executor, err := generateAndSignMultiversX(ctx, cfg)
+	if err != nil {
+		return err
+	}
+
+	return executor.ExecuteTransfer(context.Background())
+}
+
+func loadOrGenerateMultiversXBatch(ctx *cli.Context, components *mvxInternalComponents) (*mvxMigration.BatchInfo, error) {
+	migrationFilename, isInput, err := getInputMigrationFile(ctx.IsSet(migrationJsonFile.Name), ctx.GlobalString(migrationJsonFile.Name))
+	if err != nil {
+		return nil, err
+	}
+	if isInput {
+		return loadAndVerifyMultiversXBatch(components, migrationFilename)
+	}
+
+	return generateMultiversXBatch(ctx, components)
+}
+
+func loadAndVerifyMultiversXBatch(components *mvxInternalComponents, filename string) (*mvxMigration.BatchInfo, error) {
+	batch, err := mvxMigration.LoadBatchInfo(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("loaded the MultiversX batch from the migration file", "file", filename, "batch ID", batch.BatchID)
+
+	err = components.creator.VerifyBatchInfo(context.Background(), batch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while verifying the batch from file %s", err, filename)
+	}
+
+	return batch, nil
+}
+
+func generateMultiversXBatch(ctx *cli.Context, components *mvxInternalComponents) (*mvxMigration.BatchInfo, error) {
+	newSafeAddressString := ctx.GlobalString(newSafeAddress.Name)
+	if len(newSafeAddressString) == 0 {
+		return nil, fmt.Errorf("invalid new safe address for MultiversX")
+	}
+	newSafeAddressValue, err := data.NewAddressFromBech32String(newSafeAddressString)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the new safe address on MultiversX", err)
+	}
+
+	batch, err := components.creator.CreateBatchInfo(context.Background(), newSafeAddressValue)
+	if err != nil {
+		return nil, err
+	}
+
+	val, err := json.MarshalIndent(batch, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Migration .json file contents: \n" + string(val))
+
+	jsonFilename := ctx.GlobalString(migrationJsonFile.Name)
+	jsonFilename = applyTimestamp(jsonFilename)
+	err = os.WriteFile(jsonFilename, val, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func createMultiversXMigrationBatchExecutor(cfg config.MigrationToolConfig, components *mvxInternalComponents) (MultiversXBatchExecutor, error) {
+	mxClient, err := createMultiversXClient(cfg, components)
+	if err != nil {
+		return nil, err
+	}
+
+	args := mvxMigration.ArgsMigrationBatchExecutor{
+		MultiversXClient: mxClient,
+		Batch:            components.batch,
+		Logger:           log,
+	}
+
+	return mvxMigration.NewMigrationBatchExecutor(args)
+}
+
+func createMultiversXClient(cfg config.MigrationToolConfig, components *mvxInternalComponents) (mvxMigration.MultiversXClient, error) {
+	wallet := interactors.NewWallet()
+	privateKeyBytes, err := wallet.LoadPrivateKeyFromPemFile(cfg.MultiversX.PrivateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	privateKey, err := keyGen.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	multisigAddress, err := data.NewAddressFromBech32String(cfg.MultiversX.MultisigContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	safeAddress, err := data.NewAddressFromBech32String(cfg.MultiversX.SafeContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensMapper, err := mappers.NewMultiversXToErc20Mapper(components.dataGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	clientArgs := multiversx.ClientArgs{
+		GasMapConfig:                 cfg.MultiversX.GasMap,
+		Proxy:                        components.proxy,
+		Log:                          log,
+		RelayerPrivateKey:            privateKey,
+		MultisigContractAddress:      multisigAddress,
+		SafeContractAddress:          safeAddress,
+		IntervalToResendTxsInSeconds: cfg.MultiversX.IntervalToResendTxsInSeconds,
+		TokensMapper:                 tokensMapper,
+		RoleProvider:                 &disabled.RoleProvider{},
+		StatusHandler:                &disabled.StatusHandler{},
+		ClientAvailabilityAllowDelta: cfg.MultiversX.ClientAvailabilityAllowDelta,
+	}
+
+	return multiversx.NewClient(clientArgs)
+}
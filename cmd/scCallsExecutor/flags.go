@@ -23,6 +23,13 @@ var (
 			"configurations such as monitored SC, gateway URL, timings and so on",
 		Value: "config/config.toml",
 	}
+	// configurationApiFile defines a flag for the path to the api routes toml configuration file
+	configurationApiFile = cli.StringFlag{
+		Name: "config-api",
+		Usage: "The `" + filePathPlaceholder + "` for the api configuration file. This TOML file contains " +
+			"all available routes for Rest API and options to enable or disable them.",
+		Value: "config/api.toml",
+	}
 	// logFile is used when the log output needs to be logged in a file
 	logSaveFile = cli.BoolFlag{
 		Name:  "log-save",
@@ -83,6 +90,29 @@ var (
 		Name:  "private-key-file",
 		Usage: "The MultiversX private key file used to issue transaction for the SC calls",
 	}
+	// releaseQuarantined is used to release operations from the execution journal quarantine list
+	releaseQuarantined = cli.StringFlag{
+		Name: "release-quarantined",
+		Usage: "The comma-separated `IDs` of the quarantined operations that will be retried again, released before " +
+			"the first execution round. Use \"" + releaseAllQuarantined + "\" to release all the quarantined operations. " +
+			"While running, the operations can be released through the /executor/quarantined/release API route",
+	}
+	// dryRun is used to run the executor without signing and sending the transactions
+	dryRun = cli.BoolFlag{
+		Name: "dry-run",
+		Usage: "Boolean option for fetching, filtering and building the execution transactions without signing and " +
+			"sending them. A report with the outcome of each pending operation is logged on each polling step",
+	}
+	// dryRunReport is used to export the dry run report in a JSON file
+	dryRunReport = cli.StringFlag{
+		Name:  "dry-run-report",
+		Usage: "The `filepath` where the latest dry run report will be exported as JSON. Used only with --dry-run",
+	}
+	// dryRunOnce is used to close the executor after the first dry run report
+	dryRunOnce = cli.BoolFlag{
+		Name:  "dry-run-once",
+		Usage: "Boolean option for closing the application after the first dry run report. Used only with --dry-run",
+	}
 )
 
 func getFlags() []cli.Flag {
@@ -91,6 +121,7 @@ func getFlags() []cli.Flag {
 		logLevel,
 		disableAnsiColor,
 		configurationFile,
+		configurationApiFile,
 		logSaveFile,
 		logWithLoggerName,
 		profileMode,
@@ -98,6 +129,10 @@ func getFlags() []cli.Flag {
 		networkAddress,
 		scProxyBech32Address,
 		privateKeyFile,
+		releaseQuarantined,
+		dryRun,
+		dryRunReport,
+		dryRunOnce,
 	}
 }
 func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
@@ -107,6 +142,7 @@ func getFlagsConfig(ctx *cli.Context) config.ContextFlagsConfig {
 	flagsConfig.LogLevel = ctx.GlobalString(logLevel.Name)
 	flagsConfig.DisableAnsiColor = ctx.GlobalBool(disableAnsiColor.Name)
 	flagsConfig.ConfigurationFile = ctx.GlobalString(configurationFile.Name)
+	flagsConfig.ConfigurationApiFile = ctx.GlobalString(configurationApiFile.Name)
 	flagsConfig.SaveLogFile = ctx.GlobalBool(logSaveFile.Name)
 	flagsConfig.EnableLogName = ctx.GlobalBool(logWithLoggerName.Name)
 	flagsConfig.EnablePprof = ctx.GlobalBool(profileMode.Name)
@@ -4,12 +4,16 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"path"
 	"runtime"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/module"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	chainFactory "github.com/multiversx/mx-chain-go/cmd/node/factory"
@@ -20,13 +24,19 @@ import (
 )
 
 const (
-	filePathPlaceholder = "[path]"
-	defaultLogsPath     = "logs"
-	logFilePrefix       = "sc-calls-executor"
+	filePathPlaceholder   = "[path]"
+	defaultLogsPath       = "logs"
+	logFilePrefix         = "sc-calls-executor"
+	dbPath                = "db"
+	releaseAllQuarantined = "all"
 )
 
 var log = logger.GetOrCreate("main")
 
+type filterRulesReloader interface {
+	ReloadFilterRules() error
+}
+
 // appVersion should be populated at build time using ldflags
 // Usage examples:
 // linux/mac:
@@ -84,6 +94,12 @@ func startExecutor(ctx *cli.Context, version string) error {
 		return err
 	}
 
+	apiRoutesConfig, err := loadApiConfig(flagsConfig.ConfigurationApiFile)
+	if err != nil {
+		return err
+	}
+	log.Debug("config", "file", flagsConfig.ConfigurationApiFile)
+
 	if !check.IfNil(fileLogging) {
 		timeLogLifeSpan := time.Second * time.Duration(cfg.Logs.LogFileLifeSpanInSec)
 		sizeLogLifeSpanInMB := uint64(cfg.Logs.LogFileLifeSpanInMB)
@@ -122,10 +138,31 @@ func startExecutor(ctx *cli.Context, version string) error {
 		ProxyRestAPIEntityType:          cfg.ProxyRestAPIEntityType,
 		IntervalToResendTxsInSeconds:    cfg.IntervalToResendTxsInSeconds,
 		PrivateKeyFile:                  cfg.PrivateKeyFile,
+		SenderWallets:                   cfg.SenderWallets,
 		PollingIntervalInMillis:         cfg.PollingIntervalInMillis,
+		NumWorkers:                      cfg.NumWorkers,
+		MaxTransactionsInFlight:         cfg.MaxTransactionsInFlight,
 		Filter:                          cfg.Filter,
+		FilterRules:                     cfg.FilterRules,
 		Logs:                            cfg.Logs,
 		TransactionChecks:               cfg.TransactionChecks,
+		GasEstimation:                   cfg.GasEstimation,
+		ExecutionJournal:                cfg.ExecutionJournal,
+		Coordination:                    cfg.Coordination,
+		WebAntiflood:                    cfg.WebAntiflood,
+	}
+	args.ExecutionJournal.Storage.DB.FilePath = path.Join(flagsConfig.WorkingDir, dbPath, cfg.ExecutionJournal.Storage.DB.FilePath)
+	args.DryRun = config.DryRunConfig{
+		Enabled:              ctx.GlobalBool(dryRun.Name),
+		ReportFile:           ctx.GlobalString(dryRunReport.Name),
+		ExitAfterFirstReport: ctx.GlobalBool(dryRunOnce.Name),
+	}
+
+	if ctx.IsSet(releaseQuarantined.Name) {
+		args.ReleaseQuarantined, err = parseReleaseQuarantined(ctx.GlobalString(releaseQuarantined.Name))
+		if err != nil {
+			return err
+		}
 	}
 
 	chCloseApp := make(chan struct{}, 1)
@@ -134,17 +171,88 @@ func startExecutor(ctx *cli.Context, version string) error {
 		return err
 	}
 
+	webServer, err := factory.StartScCallsExecutorWebServer(
+		flagsConfig,
+		apiRoutesConfig,
+		cfg.WebAntiflood,
+		scCallsExecutor.GetMetricsHolder(),
+		scCallsExecutor,
+	)
+	if err != nil {
+		_ = scCallsExecutor.Close()
+		return err
+	}
+
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	sigsReload := make(chan os.Signal, 1)
+	signal.Notify(sigsReload, syscall.SIGHUP)
+
+	waitForClose(scCallsExecutor, sigs, sigsReload, chCloseApp)
+
+	var lastErr error
+	err = scCallsExecutor.Close()
+	if err != nil {
+		lastErr = err
+	}
 
-	select {
-	case <-sigs:
-		log.Info("application closing by user error input, calling Close on all subcomponents...")
-	case <-chCloseApp:
-		log.Info("application closing, requested internally, calling Close on all subcomponents...")
+	err = webServer.Close()
+	if err != nil {
+		lastErr = err
 	}
 
-	return scCallsExecutor.Close()
+	return lastErr
+}
+
+// waitForClose blocks until the application should close, reloading the filter rules each time a SIGHUP is received
+func waitForClose(reloader filterRulesReloader, sigs chan os.Signal, sigsReload chan os.Signal, chCloseApp chan struct{}) {
+	for {
+		select {
+		case <-sigsReload:
+			log.Info("reloading the filter rules...")
+			err := reloader.ReloadFilterRules()
+			if err != nil {
+				log.Error("can not reload the filter rules, keeping the previous ones", "error", err)
+			}
+		case <-sigs:
+			log.Info("application closing by user error input, calling Close on all subcomponents...")
+			return
+		case <-chCloseApp:
+			log.Info("application closing, requested internally, calling Close on all subcomponents...")
+			return
+		}
+	}
+}
+
+func parseReleaseQuarantined(value string) (config.QuarantineReleaseConfig, error) {
+	if strings.TrimSpace(value) == releaseAllQuarantined {
+		return config.QuarantineReleaseConfig{All: true}, nil
+	}
+
+	ids, err := parseOperationIDs(value)
+	if err != nil {
+		return config.QuarantineReleaseConfig{}, err
+	}
+
+	return config.QuarantineReleaseConfig{IDs: ids}, nil
+}
+
+func parseOperationIDs(value string) ([]uint64, error) {
+	ids := make([]uint64, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+
+		id, err := strconv.ParseUint(item, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w while parsing the operation ID %s", err, item)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
 }
 
 func loadConfig(filepath string) (config.ScCallsModuleConfig, error) {
@@ -157,6 +265,17 @@ func loadConfig(filepath string) (config.ScCallsModuleConfig, error) {
 	return cfg, nil
 }
 
+// loadApiConfig returns a ApiRoutesConfig by reading the config file provided
+func loadApiConfig(filepath string) (config.ApiRoutesConfig, error) {
+	cfg := config.ApiRoutesConfig{}
+	err := chainCore.LoadTomlFile(&cfg, filepath)
+	if err != nil {
+		return config.ApiRoutesConfig{}, err
+	}
+
+	return cfg, nil
+}
+
 func attachFileLogger(log logger.Logger, flagsConfig config.ContextFlagsConfig) (chainFactory.FileLoggingHandler, error) {
 	var fileLogging chainFactory.FileLoggingHandler
 	var err error
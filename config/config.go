@@ -23,6 +23,7 @@ type Config struct {
 	Logs              LogsConfig
 	WebAntiflood      WebAntifloodConfig
 	PeersRatingConfig PeersRatingConfig
+	SelfCheck         SelfCheckConfig
 }
 
 // EthereumConfig represents the Ethereum Config parameters
@@ -41,6 +42,8 @@ type EthereumConfig struct {
 	ClientAvailabilityAllowDelta       uint64
 	EventsBlockRangeFrom               int64
 	EventsBlockRangeTo                 int64
+	ContractsVersion                   string
+	BalanceMonitor                     BalanceMonitorConfig
 }
 
 // GasStationConfig represents the configuration for the gas station handler
@@ -56,6 +59,14 @@ type GasStationConfig struct {
 	GasPriceMultiplier         int
 }
 
+// BalanceMonitorConfig represents the configuration for the relayer balance monitor
+type BalanceMonitorConfig struct {
+	Enabled                   bool
+	PollingIntervalInSeconds  uint64
+	EstimatedDepositsPerBatch uint64
+	MinBatchesThreshold       uint64
+}
+
 // ConfigP2P configuration for the P2P communication
 type ConfigP2P struct {
 	Port            string
@@ -64,6 +75,16 @@ type ConfigP2P struct {
 	Transports      p2pConfig.P2PTransportConfig
 	AntifloodConfig config.AntifloodConfig
 	ResourceLimiter p2pConfig.P2PResourceLimiterConfig
+	PrivateNetwork  PrivateNetworkConfig
+}
+
+// PrivateNetworkConfig will hold the settings used when the relayers form a private network. The private network
+// relies on pinned peer IDs and connection gating. The libp2p pre-shared key is not provided yet, the messenger
+// builds the libp2p host options internally and does not accept one
+type PrivateNetworkConfig struct {
+	Enabled     bool
+	P2PKeyFile  string
+	StaticPeers []string
 }
 
 // ConfigRelayer configuration for general relayer configuration
@@ -71,6 +92,32 @@ type ConfigRelayer struct {
 	Marshalizer          config.MarshalizerConfig
 	RoleProvider         RoleProviderConfig
 	StatusMetricsStorage config.StorageConfig
+	SignaturesHolder     SignaturesHolderConfig
+	FeeAccounting        FeeAccountingConfig
+	TransferLatency      TransferLatencyConfig
+}
+
+// FeeAccountingConfig represents the configuration for the component accounting the fees paid by the relayer
+type FeeAccountingConfig struct {
+	Enabled                  bool
+	PollingIntervalInSeconds uint64
+	MaxTrackedTransactions   int
+	MaxResolveAttempts       int
+	RetentionInDays          int
+}
+
+// TransferLatencyConfig represents the configuration for the component tracking the end-to-end transfers latency
+type TransferLatencyConfig struct {
+	Enabled                   bool
+	PollingIntervalInSeconds  uint64
+	MaxTrackedBatches         int
+	HistogramBucketsInSeconds []uint64
+}
+
+// SignaturesHolderConfig represents the configuration for the component holding the signatures received over P2P
+type SignaturesHolderConfig struct {
+	ExpiryInSeconds           uint64
+	MaxMessageHashesPerSigner int
 }
 
 // ConfigStateMachine the configuration for the state machine
@@ -90,6 +137,18 @@ type ContextFlagsConfig struct {
 	EnableLogName        bool
 	RestApiInterface     string
 	EnablePprof          bool
+	CheckConfig          bool
+}
+
+// SelfCheckConfig will hold the settings for the checks done on the configuration and the environment of the relayer
+type SelfCheckConfig struct {
+	Enabled                      bool
+	TimeoutInSeconds             uint64
+	EthChainIDs                  []uint64
+	NTPHosts                     []string
+	NTPPort                      int
+	NTPTimeoutInMilliseconds     uint64
+	MaxClockOffsetInMilliseconds uint64
 }
 
 // WebServerAntifloodConfig will hold the anti-flooding parameters for the web server
@@ -150,7 +209,9 @@ type MultiversXConfig struct {
 	MaxRetriesOnQuorumReached       uint64
 	MaxRetriesOnWasTransferProposed uint64
 	ClientAvailabilityAllowDelta    uint64
+	ContractsVersion                string
 	Proxy                           ProxyConfig
+	BalanceMonitor                  BalanceMonitorConfig
 }
 
 // ProxyConfig represents the configuration for the MultiversX proxy
@@ -188,6 +249,23 @@ type PendingOperationsFilterConfig struct {
 	AllowedMvxAddresses []string
 	DeniedTokens        []string
 	AllowedTokens       []string
+	DeniedFunctions     []string
+	AllowedFunctions    []string
+	MaxGasLimit         uint64
+	TokensAmountLimits  []TokenAmountLimitConfig
+}
+
+// TokenAmountLimitConfig defines the minimum and maximum amount allowed for a token
+type TokenAmountLimitConfig struct {
+	Token     string
+	MinAmount string
+	MaxAmount string
+}
+
+// FilterRulesConfig defines the settings for loading the pending operations filter rules from a separate file
+type FilterRulesConfig struct {
+	RulesFile              string
+	CheckIntervalInSeconds uint64
 }
 
 // ScCallsModuleConfig will hold the settings for the SC calls module
@@ -203,10 +281,73 @@ type ScCallsModuleConfig struct {
 	ProxyRestAPIEntityType          string
 	IntervalToResendTxsInSeconds    uint64
 	PrivateKeyFile                  string
+	SenderWallets                   SenderWalletsConfig
 	PollingIntervalInMillis         uint64
+	NumWorkers                      int
+	MaxTransactionsInFlight         int
 	Filter                          PendingOperationsFilterConfig
+	FilterRules                     FilterRulesConfig
 	Logs                            LogsConfig
 	TransactionChecks               TransactionChecksConfig
+	GasEstimation                   GasEstimationConfig
+	ExecutionJournal                ExecutionJournalConfig
+	Coordination                    CoordinationConfig
+	WebAntiflood                    WebAntifloodConfig
+	DryRun                          DryRunConfig
+	ReleaseQuarantined              QuarantineReleaseConfig
+}
+
+// QuarantineReleaseConfig will hold the quarantined operations that should be released before the first execution.
+// The values are set from the command line flags
+type QuarantineReleaseConfig struct {
+	All bool
+	IDs []uint64
+}
+
+// DryRunConfig will hold the settings for running the SC calls executor without sending transactions. The values
+// are set from the command line flags
+type DryRunConfig struct {
+	Enabled              bool
+	ReportFile           string
+	ExitAfterFirstReport bool
+}
+
+// SenderWalletsConfig will hold the settings for the additional wallets used by the SC calls executor to send the
+// execution transactions and for their balance monitoring
+type SenderWalletsConfig struct {
+	PrivateKeyFiles               []string
+	SameShardAsScProxy            bool
+	MinBalance                    string
+	BalanceCheckIntervalInSeconds uint64
+}
+
+// CoordinationConfig will hold the settings for coordinating multiple SC calls executor instances
+type CoordinationConfig struct {
+	Mode                   string
+	InstanceIndex          int
+	NumInstances           int
+	TakeoverDelayInSeconds uint64
+	TakeoverStateFile      string
+	LeaseFile              string
+	InstanceID             string
+	LeaseDurationInSeconds uint64
+}
+
+// ExecutionJournalConfig will hold the settings for the SC calls execution journal and its retry policy
+type ExecutionJournalConfig struct {
+	Storage                 config.StorageConfig
+	MaxFinalizedEntries     int
+	MaxUnfinalizedEntries   int
+	MaxAttempts             uint32
+	InitialBackoffInSeconds uint64
+	MaxBackoffInSeconds     uint64
+}
+
+// GasEstimationConfig will hold the settings for estimating the gas limit of the SC calls by simulation
+type GasEstimationConfig struct {
+	Enabled                bool
+	SafetyMarginInPercent  uint64
+	DeviationWarningFactor uint64
 }
 
 // TransactionChecksConfig will hold the setting for how to handle the transaction execution
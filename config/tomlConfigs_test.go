@@ -38,6 +38,13 @@ func TestConfigs(t *testing.T) {
 			ClientAvailabilityAllowDelta: 10,
 			EventsBlockRangeFrom:         -100,
 			EventsBlockRangeTo:           400,
+			ContractsVersion:             "v2",
+			BalanceMonitor: BalanceMonitorConfig{
+				Enabled:                   true,
+				PollingIntervalInSeconds:  300,
+				EstimatedDepositsPerBatch: 10,
+				MinBatchesThreshold:       20,
+			},
 		},
 		MultiversX: MultiversXConfig{
 			NetworkAddress:               "https://devnet-gateway.multiversx.com",
@@ -57,12 +64,19 @@ func TestConfigs(t *testing.T) {
 			MaxRetriesOnQuorumReached:       3,
 			MaxRetriesOnWasTransferProposed: 3,
 			ClientAvailabilityAllowDelta:    10,
+			ContractsVersion:                "v3",
 			Proxy: ProxyConfig{
 				CacherExpirationSeconds: 600,
 				RestAPIEntityType:       "observer",
 				MaxNoncesDelta:          7,
 				FinalityCheck:           true,
 			},
+			BalanceMonitor: BalanceMonitorConfig{
+				Enabled:                   true,
+				PollingIntervalInSeconds:  300,
+				EstimatedDepositsPerBatch: 10,
+				MinBatchesThreshold:       20,
+			},
 		},
 		P2P: ConfigP2P{
 			Port:            "10010",
@@ -197,6 +211,23 @@ func TestConfigs(t *testing.T) {
 					MaxOpenFiles:      10,
 				},
 			},
+			SignaturesHolder: SignaturesHolderConfig{
+				ExpiryInSeconds:           3600,
+				MaxMessageHashesPerSigner: 100,
+			},
+			FeeAccounting: FeeAccountingConfig{
+				Enabled:                  true,
+				PollingIntervalInSeconds: 60,
+				MaxTrackedTransactions:   1000,
+				MaxResolveAttempts:       20,
+				RetentionInDays:          90,
+			},
+			TransferLatency: TransferLatencyConfig{
+				Enabled:                   true,
+				PollingIntervalInSeconds:  30,
+				MaxTrackedBatches:         200,
+				HistogramBucketsInSeconds: []uint64{300, 600, 1200, 1800, 3600, 7200},
+			},
 		},
 		Logs: LogsConfig{
 			LogFileLifeSpanInSec: 86400,
@@ -214,6 +245,15 @@ func TestConfigs(t *testing.T) {
 			TopRatedCacheCapacity: 5000,
 			BadRatedCacheCapacity: 5000,
 		},
+		SelfCheck: SelfCheckConfig{
+			Enabled:                      true,
+			TimeoutInSeconds:             10,
+			EthChainIDs:                  []uint64{1337},
+			NTPHosts:                     []string{"time.google.com", "time.cloudflare.com", "time.apple.com", "time.windows.com"},
+			NTPPort:                      123,
+			NTPTimeoutInMilliseconds:     1000,
+			MaxClockOffsetInMilliseconds: 500,
+		},
 	}
 
 	testString := `
@@ -230,6 +270,8 @@ func TestConfigs(t *testing.T) {
     ClientAvailabilityAllowDelta = 10
     EventsBlockRangeFrom = -100
     EventsBlockRangeTo = 400
+    # ContractsVersion available options: "v2", "v3" or empty to detect the version from the deployed multisig contract
+    ContractsVersion = "v2"
     [Eth.GasStation]
         Enabled = true
         URL = "https://api.etherscan.io/api?module=gastracker&action=gasoracle" # gas station URL. Suggestion to provide the api-key here
@@ -241,6 +283,11 @@ func TestConfigs(t *testing.T) {
         MaximumAllowedGasPrice = 300 # maximum value allowed for the fetched gas price value
         # GasPriceSelector available options: "SafeGasPrice", "ProposeGasPrice", "FastGasPrice"
         GasPriceSelector = "SafeGasPrice" # selector used to provide the gas price
+    [Eth.BalanceMonitor]
+        Enabled = true
+        PollingIntervalInSeconds = 300 # number of seconds between relayer balance checks
+        EstimatedDepositsPerBatch = 10 # number of deposits used when estimating the fee of a batch
+        MinBatchesThreshold = 20 # a warning is raised when the relayer balance can pay for fewer batches than this value
 
 [MultiversX]
     NetworkAddress = "https://devnet-gateway.multiversx.com" # the network address
@@ -251,6 +298,8 @@ func TestConfigs(t *testing.T) {
     MaxRetriesOnQuorumReached = 3
     MaxRetriesOnWasTransferProposed = 3
     ClientAvailabilityAllowDelta = 10
+    # ContractsVersion available options: "v2", "v3" or empty for the latest version ("v3")
+    ContractsVersion = "v3"
     [MultiversX.Proxy]
         CacherExpirationSeconds = 600 # the caching time in seconds
 
@@ -259,6 +308,11 @@ func TestConfigs(t *testing.T) {
         RestAPIEntityType = "observer"
         FinalityCheck = true
         MaxNoncesDelta = 7 # the number of maximum blocks allowed to be "in front" of what the metachain has notarized
+    [MultiversX.BalanceMonitor]
+        Enabled = true
+        PollingIntervalInSeconds = 300 # number of seconds between relayer balance checks
+        EstimatedDepositsPerBatch = 10 # number of deposits used when estimating the fee of a batch
+        MinBatchesThreshold = 20 # a warning is raised when the relayer balance can pay for fewer batches than this value
     [MultiversX.GasMap]
         Sign = 8000000
         ProposeTransferBase = 11000000
@@ -361,6 +415,21 @@ func TestConfigs(t *testing.T) {
             BatchDelaySeconds = 2
             MaxBatchSize = 100
             MaxOpenFiles = 10
+    [Relayer.SignaturesHolder]
+        ExpiryInSeconds = 3600 # 1 hour, signatures received over P2P older than this are dropped
+        MaxMessageHashesPerSigner = 100 # maximum number of distinct message hashes stored for each signer
+    [Relayer.FeeAccounting]
+        Enabled = true
+        PollingIntervalInSeconds = 60 # interval used to fetch the gas used and the fee of the sent transactions
+        MaxTrackedTransactions = 1000 # maximum number of transactions kept in the report, the oldest final ones are dropped first
+        MaxResolveAttempts = 20 # the transaction is marked as unresolved if its cost can not be fetched after this many attempts
+        RetentionInDays = 90 # daily aggregates older than this are dropped
+    [Relayer.TransferLatency]
+        Enabled = true
+        PollingIntervalInSeconds = 30 # interval used to fetch the creation time of the tracked batches from the source chain
+        MaxTrackedBatches = 200 # maximum number of batch timelines kept, the oldest ones are dropped first
+        # upper bounds of the end-to-end latency histogram buckets, from the batch creation until the execution on the destination chain
+        HistogramBucketsInSeconds = [300, 600, 1200, 1800, 3600, 7200]
 
 [StateMachine]
     [StateMachine.EthereumToMultiversX]
@@ -391,6 +460,17 @@ func TestConfigs(t *testing.T) {
     TopRatedCacheCapacity = 5000
     BadRatedCacheCapacity = 5000
 
+[SelfCheck]
+    Enabled = true # run the self-check at startup, the relayer will not start if any of the checks failed
+    TimeoutInSeconds = 10 # timeout for each of the checks that query the chains
+    # EthChainIDs holds the chain IDs accepted for the configured Eth.Chain. If empty, the chain IDs of the
+    # public networks of the chain are accepted. Useful for private or local test chains
+    EthChainIDs = [1337]
+    NTPHosts = ["time.google.com", "time.cloudflare.com", "time.apple.com", "time.windows.com"]
+    NTPPort = 123
+    NTPTimeoutInMilliseconds = 1000
+    MaxClockOffsetInMilliseconds = 500 # a larger offset of the local clock is reported as a warning
+
 `
 
 	cfg := Config{}
@@ -416,11 +496,32 @@ func TestScCallsExecutorConfigs(t *testing.T) {
 		ProxyRestAPIEntityType:          "observer",
 		IntervalToResendTxsInSeconds:    60,
 		PrivateKeyFile:                  "keys/multiversx.pem",
-		PollingIntervalInMillis:         6000,
+		SenderWallets: SenderWalletsConfig{
+			PrivateKeyFiles:               []string{"keys/multiversx-2.pem", "keys/multiversx-3.pem"},
+			SameShardAsScProxy:            true,
+			MinBalance:                    "1000000000000000000",
+			BalanceCheckIntervalInSeconds: 60,
+		},
+		PollingIntervalInMillis: 6000,
+		NumWorkers:              4,
+		MaxTransactionsInFlight: 20,
 		Filter: PendingOperationsFilterConfig{
 			AllowedEthAddresses: []string{"*"},
 			AllowedMvxAddresses: []string{"*"},
 			AllowedTokens:       []string{"MEME-a43fa1"},
+			DeniedFunctions:     []string{"unsafeCall"},
+			MaxGasLimit:         100000000,
+			TokensAmountLimits: []TokenAmountLimitConfig{
+				{
+					Token:     "MEME-a43fa1",
+					MinAmount: "1000",
+					MaxAmount: "",
+				},
+			},
+		},
+		FilterRules: FilterRulesConfig{
+			RulesFile:              "config/filterRules.toml",
+			CheckIntervalInSeconds: 10,
 		},
 		Logs: LogsConfig{
 			LogFileLifeSpanInSec: 86400,
@@ -433,6 +534,50 @@ func TestScCallsExecutorConfigs(t *testing.T) {
 			CloseAppOnError:            false,
 			ExtraDelayInSecondsOnError: 120,
 		},
+		GasEstimation: GasEstimationConfig{
+			Enabled:                true,
+			SafetyMarginInPercent:  20,
+			DeviationWarningFactor: 10,
+		},
+		ExecutionJournal: ExecutionJournalConfig{
+			Storage: chainConfig.StorageConfig{
+				Cache: chainConfig.CacheConfig{
+					Name:     "ExecutionJournal",
+					Type:     "LRU",
+					Capacity: 100,
+				},
+				DB: chainConfig.DBConfig{
+					FilePath:          "ExecutionJournalDB",
+					Type:              "LvlDBSerial",
+					BatchDelaySeconds: 2,
+					MaxBatchSize:      100,
+					MaxOpenFiles:      10,
+				},
+			},
+			MaxFinalizedEntries:     10000,
+			MaxUnfinalizedEntries:   1000,
+			MaxAttempts:             5,
+			InitialBackoffInSeconds: 60,
+			MaxBackoffInSeconds:     3600,
+		},
+		Coordination: CoordinationConfig{
+			Mode:                   "partition",
+			InstanceIndex:          1,
+			NumInstances:           3,
+			TakeoverDelayInSeconds: 300,
+			TakeoverStateFile:      "executor.takeover",
+			LeaseFile:              "executor.lease",
+			InstanceID:             "instance-1",
+			LeaseDurationInSeconds: 30,
+		},
+		WebAntiflood: WebAntifloodConfig{
+			Enabled: true,
+			WebServer: WebServerAntifloodConfig{
+				SimultaneousRequests:         100,
+				SameSourceRequests:           10000,
+				SameSourceResetIntervalInSec: 1,
+			},
+		},
 	}
 
 	testString := `
@@ -448,11 +593,29 @@ ProxyRestAPIEntityType = "observer"
 IntervalToResendTxsInSeconds = 60
 PrivateKeyFile = "keys/multiversx.pem"
 PollingIntervalInMillis = 6000
+NumWorkers = 4 # the number of workers that concurrently check the results of the sent transactions
+MaxTransactionsInFlight = 20 # the maximum number of transactions sent in bulk before waiting for their results
+
+[SenderWallets]
+	PrivateKeyFiles = ["keys/multiversx-2.pem", "keys/multiversx-3.pem"]
+	SameShardAsScProxy = true
+	MinBalance = "1000000000000000000"
+	BalanceCheckIntervalInSeconds = 60
 
 [Filter]
 	AllowedEthAddresses = ["*"]		# execute SC calls from all ETH addresses
 	AllowedMvxAddresses = ["*"]     # execute SC calls to all MvX contracts
 	AllowedTokens = ["MEME-a43fa1"] # execute SC calls for this token only
+	DeniedFunctions = ["unsafeCall"]
+	MaxGasLimit = 100000000
+	[[Filter.TokensAmountLimits]]
+		Token = "MEME-a43fa1"
+		MinAmount = "1000"
+		MaxAmount = ""
+
+[FilterRules]
+	RulesFile = "config/filterRules.toml"
+	CheckIntervalInSeconds = 10
 
 [Logs]
 	LogFileLifeSpanInSec = 86400 # 24h
@@ -464,6 +627,46 @@ PollingIntervalInMillis = 6000
 	ExecutionTimeoutInSeconds  = 120   # the number of seconds after the transaction is considered failed if it was not seen by the blockchain 
 	CloseAppOnError            = false # enable or disable if the executor should automatically close on a transaction execution error  
 	ExtraDelayInSecondsOnError = 120   # extra delay in seconds if the transaction execution errored 
+
+[GasEstimation]
+	Enabled = true               # if enabled, the gas limit of each execution is obtained by simulating the transaction
+	SafetyMarginInPercent = 20   # the percent added on top of the simulated gas, the result being capped by MaxGasLimitToUse
+	DeviationWarningFactor = 10  # log a warning if the declared gas limit is this many times bigger or smaller than the simulated one
+
+[ExecutionJournal]
+	MaxFinalizedEntries = 10000   # the maximum number of executed operations kept in the journal
+	MaxUnfinalizedEntries = 1000  # the maximum number of operations kept for each of the pending, failed and quarantined statuses
+	MaxAttempts = 5               # after this many failed attempts, the operation is quarantined until an operator releases it
+	InitialBackoffInSeconds = 60  # the delay before retrying a failed operation, doubled on each new failure
+	MaxBackoffInSeconds = 3600    # the maximum delay before retrying a failed operation
+	[ExecutionJournal.Storage]
+		[ExecutionJournal.Storage.Cache]
+			Name = "ExecutionJournal"
+			Capacity = 100
+			Type = "LRU"
+		[ExecutionJournal.Storage.DB]
+			FilePath = "ExecutionJournalDB"
+			Type = "LvlDBSerial"
+			BatchDelaySeconds = 2
+			MaxBatchSize = 100
+			MaxOpenFiles = 10
+
+[Coordination]
+	Mode = "partition"
+	InstanceIndex = 1
+	NumInstances = 3
+	TakeoverDelayInSeconds = 300
+	TakeoverStateFile = "executor.takeover"
+	LeaseFile = "executor.lease"
+	InstanceID = "instance-1"
+	LeaseDurationInSeconds = 30
+
+[WebAntiflood]
+	Enabled = true
+	[WebAntiflood.WebServer]
+		SimultaneousRequests = 100
+		SameSourceRequests = 10000
+		SameSourceResetIntervalInSec = 1
 `
 
 	cfg := ScCallsModuleConfig{}
@@ -499,15 +702,29 @@ func TestMigrationToolConfig(t *testing.T) {
 			},
 		},
 		MultiversX: MultiversXConfig{
-			NetworkAddress:          "https://devnet-gateway.multiversx.com",
-			MultisigContractAddress: "erd1qqqqqqqqqqqqqpgqzyuaqg3dl7rqlkudrsnm5ek0j3a97qevd8sszj0glf",
-			SafeContractAddress:     "erd1qqqqqqqqqqqqqpgqtvnswnzxxz8susupesys0hvg7q2z5nawrcjq06qdus",
+			NetworkAddress:               "https://devnet-gateway.multiversx.com",
+			MultisigContractAddress:      "erd1qqqqqqqqqqqqqpgqzyuaqg3dl7rqlkudrsnm5ek0j3a97qevd8sszj0glf",
+			SafeContractAddress:          "erd1qqqqqqqqqqqqqpgqtvnswnzxxz8susupesys0hvg7q2z5nawrcjq06qdus",
+			PrivateKeyFile:               "keys/multiversx.pem",
+			IntervalToResendTxsInSeconds: 60,
+			ClientAvailabilityAllowDelta: 10,
 			Proxy: ProxyConfig{
 				CacherExpirationSeconds: 600,
 				RestAPIEntityType:       "observer",
 				MaxNoncesDelta:          7,
 				FinalityCheck:           true,
 			},
+			GasMap: MultiversXGasMapConfig{
+				Sign:                   8000000,
+				ProposeTransferBase:    11000000,
+				ProposeTransferForEach: 5500000,
+				ProposeStatusBase:      10000000,
+				ProposeStatusForEach:   7000000,
+				PerformActionBase:      40000000,
+				PerformActionForEach:   5500000,
+				ScCallPerByte:          100000,
+				ScCallPerformForEach:   10000000,
+			},
 		},
 		Logs: LogsConfig{
 			LogFileLifeSpanInSec: 86400,
@@ -539,6 +756,9 @@ func TestMigrationToolConfig(t *testing.T) {
     NetworkAddress = "https://devnet-gateway.multiversx.com" # the network address
     MultisigContractAddress = "erd1qqqqqqqqqqqqqpgqzyuaqg3dl7rqlkudrsnm5ek0j3a97qevd8sszj0glf" # the multiversx address for the bridge contract
     SafeContractAddress = "erd1qqqqqqqqqqqqqpgqtvnswnzxxz8susupesys0hvg7q2z5nawrcjq06qdus" # the multiversx address for the safe contract
+    PrivateKeyFile = "keys/multiversx.pem" # the path to the pem file containing the relayer multiversx wallet, used only by the multiversx migration side
+    IntervalToResendTxsInSeconds = 60 # the time in seconds between nonce reads
+    ClientAvailabilityAllowDelta = 10
     [MultiversX.Proxy]
         CacherExpirationSeconds = 600 # the caching time in seconds
 
@@ -547,6 +767,16 @@ func TestMigrationToolConfig(t *testing.T) {
         RestAPIEntityType = "observer"
         FinalityCheck = true
         MaxNoncesDelta = 7 # the number of maximum blocks allowed to be "in front" of what the metachain has notarized
+    [MultiversX.GasMap]
+        Sign = 8000000
+        ProposeTransferBase = 11000000
+        ProposeTransferForEach = 5500000
+        ProposeStatusBase = 10000000
+        ProposeStatusForEach = 7000000
+        PerformActionBase = 40000000
+        PerformActionForEach = 5500000
+        ScCallPerByte = 100000 # 1500 tx data field + the rest for the actual storage in the contract
+        ScCallPerformForEach = 10000000
 
 [Logs]
     LogFileLifeSpanInSec = 86400 # 24h
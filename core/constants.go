@@ -33,6 +33,15 @@ const (
 	WebServerOffString = "off"
 )
 
+const (
+	// ContractsVersionV2 represents the bridge contracts version that does not report the finality of the batches
+	// and does not support transfers with SC calls
+	ContractsVersionV2 ContractsVersion = "v2"
+
+	// ContractsVersionV3 represents the current bridge contracts version
+	ContractsVersionV3 ContractsVersion = "v3"
+)
+
 const (
 	// MetricNumBatches represents the metric used for counting the number of executed batches
 	MetricNumBatches = "num batches"
@@ -77,6 +86,68 @@ const (
 
 	// MetricLastBlockNonce represents the last block nonce queried
 	MetricLastBlockNonce = "last block nonce"
+
+	// MetricNumMisbehaviourEvidence represents the metric used to count the misbehaviour evidence gathered
+	MetricNumMisbehaviourEvidence = "num misbehaviour evidence"
+
+	// MetricLastMisbehaviour represents the metric used to store the last detected misbehaviour
+	MetricLastMisbehaviour = "last misbehaviour"
+
+	// MetricSignaturesProgress represents the metric used to store the number of collected signatures versus the quorum
+	// for the action or message hash currently handled
+	MetricSignaturesProgress = "signatures progress"
+
+	// MetricMissingSigners represents the metric used to store the relayers that did not sign the action or message
+	// hash currently handled
+	MetricMissingSigners = "missing signers"
+
+	// MetricNumPendingOperations represents the metric used to store the number of pending operations found in the
+	// SC proxy contract
+	MetricNumPendingOperations = "num pending operations"
+
+	// MetricNumAllowedOperations represents the metric used to store the number of pending operations that passed the
+	// filter and the retry policy
+	MetricNumAllowedOperations = "num allowed operations"
+
+	// MetricNumSentTransactions represents the metric used to count the number of transactions sent by the SC calls executor
+	MetricNumSentTransactions = "num sent transactions"
+
+	// MetricNumSuccessfulExecutions represents the metric used to count the SC calls executions that succeeded
+	MetricNumSuccessfulExecutions = "num successful executions"
+
+	// MetricNumFailedExecutions represents the metric used to count the SC calls executions that failed
+	MetricNumFailedExecutions = "num failed executions"
+
+	// MetricNumActiveSenders represents the metric used to store the number of wallets currently used by the SC calls
+	// executor to send transactions
+	MetricNumActiveSenders = "num active senders"
+
+	// MetricRelayerBalance represents the metric used to store the native balance of the relayer account
+	MetricRelayerBalance = "relayer balance"
+
+	// MetricRelayerEstimatedBatchFee represents the metric used to store the estimated fee the relayer pays for a batch
+	MetricRelayerEstimatedBatchFee = "relayer estimated batch fee"
+
+	// MetricRelayerRemainingBatches represents the metric used to store the estimated number of batches the relayer
+	// can still pay for
+	MetricRelayerRemainingBatches = "relayer remaining batches"
+
+	// MetricTransferLatencyBucket represents the metric used to count, for a direction, the transfers executed on the
+	// destination chain in at most the bucket's upper bound
+	MetricTransferLatencyBucket = "transfer latency bucket"
+
+	// MetricTransferLatencyCount represents the metric used to count, for a direction, the transfers whose latency was measured
+	MetricTransferLatencyCount = "transfer latency count"
+
+	// MetricTransferLatencySum represents the metric used to sum, for a direction, the measured transfer latencies
+	MetricTransferLatencySum = "transfer latency sum in seconds"
+
+	// MetricLastTransferLatency represents the metric used to store, for a direction, the last measured transfer latency
+	MetricLastTransferLatency = "last transfer latency in seconds"
+
+	// MetricLastStepDuration represents the metric used to store, for a direction and a transfer step, the time elapsed
+	// since the previous step, for the last batch
+	MetricLastStepDuration = "last step duration in seconds"
 )
 
 // PersistedMetrics represents the array of metrics that should be persisted
@@ -90,4 +161,13 @@ const (
 
 	// MultiversXClientStatusHandlerName is the MultiversX client status handler name
 	MultiversXClientStatusHandlerName = "multiversx-client"
+
+	// MisbehaviourStatusHandlerName is the misbehaviour detector status handler name
+	MisbehaviourStatusHandlerName = "misbehaviour"
+
+	// LatencyStatusHandlerName is the transfer latency tracker status handler name
+	LatencyStatusHandlerName = "latency"
+
+	// ScCallsExecutorStatusHandlerName is the SC calls executor status handler name
+	ScCallsExecutorStatusHandlerName = "sc-calls-executor"
 )
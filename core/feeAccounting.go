@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"io"
+	"math/big"
+)
+
+// RelayerTxAction defines the bridge action performed by a transaction sent by the relayer
+type RelayerTxAction string
+
+const (
+	// ProposeTransferAction is the action of proposing a transfer on MultiversX
+	ProposeTransferAction RelayerTxAction = "ProposeTransfer"
+	// ProposeSetStatusAction is the action of proposing the statuses of a batch on MultiversX
+	ProposeSetStatusAction RelayerTxAction = "ProposeSetStatus"
+	// SignAction is the action of signing a proposed action on MultiversX
+	SignAction RelayerTxAction = "Sign"
+	// PerformAction is the action of performing a signed action on MultiversX
+	PerformAction RelayerTxAction = "PerformAction"
+	// ExecuteTransferAction is the action of executing a transfer on the EVM compatible chain
+	ExecuteTransferAction RelayerTxAction = "ExecuteTransfer"
+)
+
+// RelayerTxStatus defines the accounting status of a transaction sent by the relayer
+type RelayerTxStatus string
+
+const (
+	// RelayerTxPending is the status of a transaction whose cost was not yet fetched
+	RelayerTxPending RelayerTxStatus = "pending"
+	// RelayerTxResolved is the status of a transaction whose gas used and fee were fetched
+	RelayerTxResolved RelayerTxStatus = "resolved"
+	// RelayerTxUnresolved is the status of a transaction whose cost could not be fetched after all the attempts
+	RelayerTxUnresolved RelayerTxStatus = "unresolved"
+)
+
+// RelayerTransaction holds the accounting information of a transaction sent by the relayer
+type RelayerTransaction struct {
+	TxHash          string          `json:"txHash"`
+	Chain           string          `json:"chain"`
+	BatchID         uint64          `json:"batchId"`
+	Direction       string          `json:"direction"`
+	Action          RelayerTxAction `json:"action"`
+	Timestamp       int64           `json:"timestamp"`
+	Status          RelayerTxStatus `json:"status"`
+	ResolveAttempts int             `json:"resolveAttempts"`
+	GasUsed         uint64          `json:"gasUsed"`
+	Fee             string          `json:"fee"`
+}
+
+// DailyFees holds the aggregated cost of the transactions sent by the relayer in a day, on a chain, for an action
+type DailyFees struct {
+	Day             string          `json:"day"`
+	Chain           string          `json:"chain"`
+	Action          RelayerTxAction `json:"action"`
+	NumTransactions uint64          `json:"numTransactions"`
+	GasUsed         uint64          `json:"gasUsed"`
+	Fee             string          `json:"fee"`
+}
+
+// FeeAccountingReport holds the daily aggregated fees and the tracked transactions
+type FeeAccountingReport struct {
+	Daily        []*DailyFees          `json:"daily"`
+	Transactions []*RelayerTransaction `json:"transactions"`
+}
+
+// TransactionsRecorder defines the operations of a component able to record the transactions sent by the relayer
+type TransactionsRecorder interface {
+	RecordTransaction(batchID uint64, direction string, action RelayerTxAction, txHash string)
+	IsInterfaceNil() bool
+}
+
+// TransactionCostResolver defines the operations of a chain client able to fetch the gas used and the fee paid
+// by a transaction
+type TransactionCostResolver interface {
+	GetTransactionCost(ctx context.Context, txHash string) (uint64, *big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// FeeAccountingProvider defines the operations of a component able to provide the fees paid by the relayer
+type FeeAccountingProvider interface {
+	GetFeeAccountingReport() *FeeAccountingReport
+	WriteDailyFeesCSV(writer io.Writer) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,52 @@
+package core
+
+import "context"
+
+// TransferStep defines a step of a cross-chain transfer, as observed by the relayer
+type TransferStep string
+
+const (
+	// BatchCreatedStep is the step in which the batch was created on the source chain
+	BatchCreatedStep TransferStep = "created"
+	// ProposedStep is the step in which the transfer was proposed (on MultiversX) or signed (for Ethereum)
+	ProposedStep TransferStep = "proposed"
+	// QuorumReachedStep is the step in which the quorum of signatures was reached for the transfer
+	QuorumReachedStep TransferStep = "quorumReached"
+	// ExecutedStep is the step in which the transfer was executed on the destination chain
+	ExecutedStep TransferStep = "executed"
+	// StatusSetStep is the step in which the final statuses of the batch were set on MultiversX
+	StatusSetStep TransferStep = "statusSet"
+)
+
+// BatchTimeline holds the timestamps, in seconds, at which a batch went through the transfer steps
+type BatchTimeline struct {
+	Direction        string `json:"direction"`
+	BatchID          uint64 `json:"batchId"`
+	BlockNumber      uint64 `json:"blockNumber"`
+	CreatedAt        int64  `json:"createdAt"`
+	ProposedAt       int64  `json:"proposedAt"`
+	QuorumReachedAt  int64  `json:"quorumReachedAt"`
+	ExecutedAt       int64  `json:"executedAt"`
+	StatusSetAt      int64  `json:"statusSetAt"`
+	LatencyInSeconds int64  `json:"latencyInSeconds"`
+}
+
+// LatencyRecorder defines the operations of a component able to record the moments a batch went through the
+// transfer steps
+type LatencyRecorder interface {
+	RecordBatchCreation(direction string, batchID uint64, blockNumber uint64)
+	RecordStep(direction string, batchID uint64, step TransferStep)
+	IsInterfaceNil() bool
+}
+
+// BlockTimestampResolver defines the operations of a chain client able to fetch the timestamp of a block
+type BlockTimestampResolver interface {
+	GetBlockTimestamp(ctx context.Context, blockNumber uint64) (uint64, error)
+	IsInterfaceNil() bool
+}
+
+// BatchTimelinesProvider defines the operations of a component able to provide the timelines of the recent batches
+type BatchTimelinesProvider interface {
+	GetBatchTimelines() []*BatchTimeline
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,32 @@
+package core
+
+// MisbehaviourType defines the kind of misbehaviour a relayer was caught doing
+type MisbehaviourType string
+
+const (
+	// ConflictingSignatures is the misbehaviour of a relayer that signed two different message hashes for the same batch
+	ConflictingSignatures MisbehaviourType = "conflicting signatures"
+
+	// UnknownMessageHash is the misbehaviour of a relayer that signed a message hash, for a batch, different from the
+	// one computed by the current relayer
+	UnknownMessageHash MisbehaviourType = "unknown message hash"
+)
+
+// MisbehaviourEvidence holds the proof that a relayer misbehaved. The signed messages are the original P2P messages,
+// so the relayer's signature over the payload (containing the message hash and the batch ID) can be independently
+// verified
+type MisbehaviourEvidence struct {
+	Type                MisbehaviourType `json:"type"`
+	BatchID             uint64           `json:"batchId"`
+	RelayerPublicKey    []byte           `json:"relayerPublicKey"`
+	ExpectedMessageHash []byte           `json:"expectedMessageHash,omitempty"`
+	SignedMessages      []*SignedMessage `json:"signedMessages"`
+	PeerIDs             []string         `json:"peerIds"`
+	Timestamp           int64            `json:"timestamp"`
+}
+
+// MisbehaviourEvidenceProvider defines the operations of a component able to provide the misbehaviour evidence
+type MisbehaviourEvidenceProvider interface {
+	GetAllEvidence() []*MisbehaviourEvidence
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,121 @@
+package core
+
+import "context"
+
+// ScCallFilterDecision defines the decision taken by the SC calls executor for a pending operation
+type ScCallFilterDecision string
+
+const (
+	// ScCallAllowed is the decision for a pending operation that will be executed
+	ScCallAllowed ScCallFilterDecision = "allowed"
+
+	// ScCallDeniedByFilter is the decision for a pending operation that is not allowed by the configured filter
+	ScCallDeniedByFilter ScCallFilterDecision = "denied by filter"
+
+	// ScCallPostponedByRetryPolicy is the decision for a pending operation that is either waiting for its backoff
+	// period to elapse, was already executed or is quarantined
+	ScCallPostponedByRetryPolicy ScCallFilterDecision = "postponed by retry policy"
+
+	// ScCallHandledByOtherInstance is the decision for a pending operation that is assigned to another executor instance
+	ScCallHandledByOtherInstance ScCallFilterDecision = "handled by other instance"
+)
+
+// ScCallExecutionStatus defines the status of a SC call execution
+type ScCallExecutionStatus string
+
+const (
+	// ScCallExecutionSent is the status of an execution whose transaction was sent and the result is not yet known
+	ScCallExecutionSent ScCallExecutionStatus = "sent"
+
+	// ScCallExecutionSuccess is the status of an execution whose transaction was successfully executed
+	ScCallExecutionSuccess ScCallExecutionStatus = "success"
+
+	// ScCallExecutionFailed is the status of an execution whose transaction failed
+	ScCallExecutionFailed ScCallExecutionStatus = "failed"
+)
+
+// ScCallPendingOperation holds a pending operation, as returned by the SC proxy contract, along with the decision
+// taken by the SC calls executor
+type ScCallPendingOperation struct {
+	ID             uint64               `json:"id"`
+	From           string               `json:"from"`
+	To             string               `json:"to"`
+	Token          string               `json:"token"`
+	Amount         string               `json:"amount"`
+	Nonce          uint64               `json:"nonce"`
+	FilterDecision ScCallFilterDecision `json:"filterDecision"`
+}
+
+// ScCallExecution holds the information about an execution transaction sent by the SC calls executor
+type ScCallExecution struct {
+	ID        uint64                `json:"id"`
+	TxHash    string                `json:"txHash"`
+	Sender    string                `json:"sender"`
+	Nonce     uint64                `json:"nonce"`
+	GasLimit  uint64                `json:"gasLimit"`
+	Status    ScCallExecutionStatus `json:"status"`
+	Error     string                `json:"error,omitempty"`
+	Timestamp int64                 `json:"timestamp"`
+}
+
+// ScCallDryRunOutcome defines what the SC calls executor would do with a pending operation, as reported in dry run mode
+type ScCallDryRunOutcome string
+
+const (
+	// ScCallDryRunWouldExecute is the outcome for an operation that would be executed with the reported gas limit
+	ScCallDryRunWouldExecute ScCallDryRunOutcome = "would execute"
+
+	// ScCallDryRunWouldExecuteOutOfGas is the outcome for an operation whose gas limit exceeds the contract maximum,
+	// so it would be executed with the GasLimitForOutOfGasTransactions value and refunded by the contract
+	ScCallDryRunWouldExecuteOutOfGas ScCallDryRunOutcome = "would execute with the out of gas limit"
+
+	// ScCallDryRunWouldSkip is the outcome for an operation whose gas limit exceeds the MaxGasLimitToUse value
+	ScCallDryRunWouldSkip ScCallDryRunOutcome = "would skip, the gas limit exceeds the maximum allowed"
+
+	// ScCallDryRunNotSelected is the outcome for an operation that did not pass the filter, the coordination or the
+	// retry policy
+	ScCallDryRunNotSelected ScCallDryRunOutcome = "not selected"
+
+	// ScCallDryRunError is the outcome for an operation whose transaction could not be built
+	ScCallDryRunError ScCallDryRunOutcome = "error"
+)
+
+// ScCallDryRunOperation holds what the SC calls executor would do with a pending operation
+type ScCallDryRunOperation struct {
+	ScCallPendingOperation
+	Outcome          ScCallDryRunOutcome `json:"outcome"`
+	Sender           string              `json:"sender,omitempty"`
+	DeclaredGasLimit uint64              `json:"declaredGasLimit,omitempty"`
+	GasLimit         uint64              `json:"gasLimit,omitempty"`
+	Error            string              `json:"error,omitempty"`
+}
+
+// ScCallDryRunReport holds the result of a SC calls executor step run in dry run mode
+type ScCallDryRunReport struct {
+	Timestamp  int64                    `json:"timestamp"`
+	Operations []*ScCallDryRunOperation `json:"operations"`
+}
+
+// ScCallsSenderStatus holds the state of an account used by the SC calls executor to send transactions
+type ScCallsSenderStatus struct {
+	Address string `json:"address"`
+	Nonce   uint64 `json:"nonce"`
+	Balance string `json:"balance"`
+	Active  bool   `json:"active"`
+}
+
+// ScCallsQuarantineRelease holds the quarantined operations an operator requested to be released
+type ScCallsQuarantineRelease struct {
+	IDs []uint64 `json:"ids"`
+	All bool     `json:"all"`
+}
+
+// ScCallsExecutorStatusProvider defines the operations of a component able to provide the SC calls executor status
+type ScCallsExecutorStatusProvider interface {
+	GetPendingOperations() []*ScCallPendingOperation
+	GetRecentExecutions() []*ScCallExecution
+	GetSendersStatus(ctx context.Context) ([]*ScCallsSenderStatus, error)
+	GetQuarantined() []uint64
+	ReleaseFromQuarantine(ids []uint64) []uint64
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,22 @@
+package core
+
+// SignaturesProgress holds the signatures collection progress of the action or message hash currently handled
+// by a half-bridge
+type SignaturesProgress struct {
+	BatchID        uint64   `json:"batchId"`
+	ActionID       uint64   `json:"actionId,omitempty"`
+	MessageHash    string   `json:"messageHash,omitempty"`
+	Quorum         int      `json:"quorum"`
+	NumSignatures  int      `json:"numSignatures"`
+	Signers        []string `json:"signers"`
+	MissingSigners []string `json:"missingSigners"`
+	Timestamp      int64    `json:"timestamp"`
+}
+
+// SignaturesProgressHandler defines the operations of a component able to hold the signatures collection
+// progress of each half-bridge
+type SignaturesProgressHandler interface {
+	SetSignaturesProgress(name string, progress *SignaturesProgress)
+	GetAllSignaturesProgress() map[string]*SignaturesProgress
+	IsInterfaceNil() bool
+}
@@ -21,6 +21,9 @@ type Step interface {
 // EthGasPriceSelector defines the ethereum gas price selector
 type EthGasPriceSelector string
 
+// ContractsVersion defines the version of the deployed bridge contracts
+type ContractsVersion string
+
 // Timer defines operations related to time
 type Timer interface {
 	NowUnix() int64
@@ -69,6 +72,7 @@ type MetricsHolder interface {
 type Storer interface {
 	Put(key, data []byte) error
 	Get(key []byte) ([]byte, error)
+	Remove(key []byte) error
 	Close() error
 	IsInterfaceNil() bool
 }
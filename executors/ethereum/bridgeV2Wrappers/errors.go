@@ -3,6 +3,7 @@ package bridgeV2Wrappers
 import "errors"
 
 var (
-	errNilBlockchainClient = errors.New("nil blockchain client")
-	errNilMultiSigContract = errors.New("nil multi sig contract")
+	errNilBlockchainClient          = errors.New("nil blockchain client")
+	errNilMultiSigContract          = errors.New("nil multi sig contract")
+	errEmptyMultiSigContractAddress = errors.New("empty multi sig contract address")
 )
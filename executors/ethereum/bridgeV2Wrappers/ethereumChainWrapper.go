@@ -4,6 +4,7 @@ import (
 	"context"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -13,17 +14,22 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 )
 
+const executeTransferFunction = "executeTransfer"
+
 // ArgsEthereumChainWrapper is the DTO used to construct a ethereumChainWrapper instance
 type ArgsEthereumChainWrapper struct {
 	StatusHandler    core.StatusHandler
 	MultiSigContract multiSigContract
-	BlockchainClient blockchainClient
+	// MultiSigContractAddress is the address of the multisig contract, required for simulating its calls
+	MultiSigContractAddress common.Address
+	BlockchainClient        blockchainClient
 }
 
 type ethereumChainWrapper struct {
 	core.StatusHandler
-	multiSigContract multiSigContract
-	blockchainClient blockchainClient
+	multiSigContract        multiSigContract
+	multiSigContractAddress common.Address
+	blockchainClient        blockchainClient
 }
 
 // NewEthereumChainWrapper creates a new instance of type ethereumChainWrapper
@@ -34,9 +40,10 @@ func NewEthereumChainWrapper(args ArgsEthereumChainWrapper) (*ethereumChainWrapp
 	}
 
 	return &ethereumChainWrapper{
-		StatusHandler:    args.StatusHandler,
-		multiSigContract: args.MultiSigContract,
-		blockchainClient: args.BlockchainClient,
+		StatusHandler:           args.StatusHandler,
+		multiSigContract:        args.MultiSigContract,
+		multiSigContractAddress: args.MultiSigContractAddress,
+		blockchainClient:        args.BlockchainClient,
 	}, nil
 }
 
@@ -47,6 +54,9 @@ func checkArgs(args ArgsEthereumChainWrapper) error {
 	if check.IfNilReflect(args.MultiSigContract) {
 		return errNilMultiSigContract
 	}
+	if args.MultiSigContractAddress == (common.Address{}) {
+		return errEmptyMultiSigContractAddress
+	}
 	if check.IfNilReflect(args.BlockchainClient) {
 		return errNilBlockchainClient
 	}
@@ -109,6 +119,29 @@ func (wrapper *ethereumChainWrapper) ExecuteTransfer(opts *bind.TransactOpts, to
 	return wrapper.multiSigContract.ExecuteTransfer(opts, tokens, recipients, amounts, nonces, batchNonce, signatures)
 }
 
+// SimulateExecuteTransfer runs an eth_call of the execute-transfer function against the pending state, using the
+// provided sender. A *clients.RevertError is returned if the execution reverts
+func (wrapper *ethereumChainWrapper) SimulateExecuteTransfer(ctx context.Context, from common.Address, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) error {
+	contractAbi, err := contract.BridgeMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+
+	input, err := contractAbi.Pack(executeTransferFunction, tokens, recipients, amounts, nonces, batchNonce, signatures)
+	if err != nil {
+		return err
+	}
+
+	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
+	_, err = wrapper.blockchainClient.PendingCallContract(ctx, ethereum.CallMsg{
+		From: from,
+		To:   &wrapper.multiSigContractAddress,
+		Data: input,
+	})
+
+	return clients.NewRevertError(err, contractAbi)
+}
+
 // Quorum returns the current set quorum value
 func (wrapper *ethereumChainWrapper) Quorum(ctx context.Context) (*big.Int, error) {
 	wrapper.AddIntMetric(core.MetricNumEthClientRequests, 1)
@@ -1,8 +1,10 @@
 package ethereum
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"os"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -37,6 +39,43 @@ type SignatureInfo struct {
 	Signature   string `json:"Signature"`
 }
 
+// LoadBatchInfo loads a previously generated batch from the provided .json file. The contract addresses and the
+// amounts are restored from their string representation
+func LoadBatchInfo(filename string) (*BatchInfo, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &BatchInfo{}
+	err = json.Unmarshal(buff, batch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while unmarshalling the batch from file %s", err, filename)
+	}
+
+	for _, deposit := range batch.DepositsInfo {
+		if !common.IsHexAddress(deposit.ContractAddressString) {
+			return nil, fmt.Errorf("%w for token %s: %s", errWrongERC20AddressResponse, deposit.Token, deposit.ContractAddressString)
+		}
+		deposit.ContractAddress = common.HexToAddress(deposit.ContractAddressString)
+
+		amount, ok := big.NewInt(0).SetString(deposit.AmountString, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w for token %s: %s", errInvalidDepositAmount, deposit.Token, deposit.AmountString)
+		}
+		deposit.Amount = amount
+
+		if len(deposit.DenominatedAmountString) > 0 {
+			deposit.DenominatedAmount, ok = big.NewFloat(0).SetString(deposit.DenominatedAmountString)
+			if !ok {
+				return nil, fmt.Errorf("%w for token %s: %s", errInvalidDepositAmount, deposit.Token, deposit.DenominatedAmountString)
+			}
+		}
+	}
+
+	return batch, nil
+}
+
 // TokensBalancesDisplayString will convert the deposit balances into a human-readable string
 func TokensBalancesDisplayString(batchInfo *BatchInfo) string {
 	maxTokenLen := 0
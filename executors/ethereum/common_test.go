@@ -4,9 +4,41 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+func TestLoadBatchInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch, err := LoadBatchInfo("testdata/missing.json")
+		assert.Nil(t, batch)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch, err := LoadBatchInfo("testdata/invalid-amount-migration.json")
+		assert.Nil(t, batch)
+		assert.ErrorIs(t, err, errInvalidDepositAmount)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		batch, err := LoadBatchInfo("testdata/migration-2024-09-05-15-34-44.json")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(3548), batch.BatchID)
+		assert.Equal(t, common.HexToHash("0xc5b805c73d01e35e10a27a4cab86f096c976f0910ae23f5c6b307a823f0c49fb"), batch.MessageHash)
+		require.Equal(t, 1, len(batch.DepositsInfo))
+		assert.Equal(t, common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"), batch.DepositsInfo[0].ContractAddress)
+		assert.Equal(t, big.NewInt(7086984513581), batch.DepositsInfo[0].Amount)
+	})
+}
+
 func TestTokensBalancesDisplayString(t *testing.T) {
 	t.Parallel()
 
@@ -3,16 +3,35 @@ package ethereum
 import "errors"
 
 var (
-	errEmptyTokensList               = errors.New("empty tokens list")
-	errNilMvxDataGetter              = errors.New("nil MultiversX data getter")
-	errNilErc20ContractsHolder       = errors.New("nil ERC20 contracts holder")
-	errWrongERC20AddressResponse     = errors.New("wrong ERC20 address response")
-	errNilLogger                     = errors.New("nil logger")
-	errNilCryptoHandler              = errors.New("nil crypto handler")
-	errNilEthereumChainWrapper       = errors.New("nil Ethereum chain wrapper")
-	errQuorumNotReached              = errors.New("quorum not reached")
-	errInvalidSignature              = errors.New("invalid signature")
-	errMultisigContractPaused        = errors.New("multisig contract paused")
-	errNilGasHandler                 = errors.New("nil gas handler")
-	errInvalidPartialMigrationString = errors.New("invalid partial migration string")
+	errEmptyTokensList                  = errors.New("empty tokens list")
+	errNilMvxDataGetter                 = errors.New("nil MultiversX data getter")
+	errNilErc20ContractsHolder          = errors.New("nil ERC20 contracts holder")
+	errWrongERC20AddressResponse        = errors.New("wrong ERC20 address response")
+	errNilLogger                        = errors.New("nil logger")
+	errNilCryptoHandler                 = errors.New("nil crypto handler")
+	errNilEthereumChainWrapper          = errors.New("nil Ethereum chain wrapper")
+	errQuorumNotReached                 = errors.New("quorum not reached")
+	errInvalidSignature                 = errors.New("invalid signature")
+	errMultisigContractPaused           = errors.New("multisig contract paused")
+	errNilGasHandler                    = errors.New("nil gas handler")
+	errInvalidPartialMigrationString    = errors.New("invalid partial migration string")
+	errNilBatchInfo                     = errors.New("nil batch info")
+	errEmptyDepositsList                = errors.New("empty deposits list")
+	errSafeAddressMismatch              = errors.New("safe contract address mismatch")
+	errInvalidNewSafeAddress            = errors.New("invalid new safe contract address")
+	errBatchAlreadyExecuted             = errors.New("batch already executed")
+	errInvalidDepositAmount             = errors.New("invalid deposit amount")
+	errTokenNotWhitelisted              = errors.New("token not whitelisted")
+	errInsufficientBalance              = errors.New("insufficient balance")
+	errMessageHashMismatch              = errors.New("message hash mismatch")
+	errEmptyRelayersList                = errors.New("empty relayers list")
+	errInvalidQuorum                    = errors.New("invalid quorum")
+	errInvalidRelayerAddress            = errors.New("invalid relayer address")
+	errRelayerNotWhitelisted            = errors.New("relayer not whitelisted")
+	errNilSignaturesCollector           = errors.New("nil signatures collector")
+	errSignaturesCollectorRequestFailed = errors.New("signatures collector request failed")
+	errNilMvxBalancesGetter             = errors.New("nil MultiversX balances getter")
+	errNilTransactionReceiptGetter      = errors.New("nil transaction receipt getter")
+	errNilOldSafeBalancesGetter         = errors.New("nil old safe balances getter")
+	errNilNewSafeBalancesGetter         = errors.New("nil new safe balances getter")
 )
@@ -40,6 +40,9 @@ type EthereumChainWrapper interface {
 	ExecuteTransfer(opts *bind.TransactOpts, tokens []common.Address,
 		recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int,
 		signatures [][]byte) (*types.Transaction, error)
+	SimulateExecuteTransfer(ctx context.Context, from common.Address, tokens []common.Address,
+		recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int,
+		signatures [][]byte) error
 	ChainID(ctx context.Context) (*big.Int, error)
 	BlockNumber(ctx context.Context) (uint64, error)
 	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
@@ -62,3 +65,35 @@ type GasHandler interface {
 	GetCurrentGasPrice() (*big.Int, error)
 	IsInterfaceNil() bool
 }
+
+// SignaturesCollector defines the operations of a component able to collect the relayers' signatures for a batch
+type SignaturesCollector interface {
+	AddSignature(sigInfo SignatureInfo) error
+	Signatures() []SignatureInfo
+	Status() SignaturesCollectionStatus
+	IsInterfaceNil() bool
+}
+
+// MvxBalancesGetter defines the operations for the component able to fetch the token balances from the MultiversX safe contract
+type MvxBalancesGetter interface {
+	IsMintBurnToken(ctx context.Context, token []byte) (bool, error)
+	IsNativeToken(ctx context.Context, token []byte) (bool, error)
+	GetTotalBalances(ctx context.Context, token []byte) (*big.Int, error)
+	GetMintBalances(ctx context.Context, token []byte) (*big.Int, error)
+	GetBurnBalances(ctx context.Context, token []byte) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// EthSafeBalancesGetter defines the operations for the component able to fetch the token balances from an Ethereum safe contract
+type EthSafeBalancesGetter interface {
+	TotalBalances(ctx context.Context, token common.Address) (*big.Int, error)
+	MintBalances(ctx context.Context, token common.Address) (*big.Int, error)
+	BurnBalances(ctx context.Context, token common.Address) (*big.Int, error)
+	MintBurnTokens(ctx context.Context, token common.Address) (bool, error)
+	NativeTokens(ctx context.Context, token common.Address) (bool, error)
+}
+
+// TransactionReceiptGetter defines the component able to fetch an Ethereum transaction receipt
+type TransactionReceiptGetter interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
@@ -314,3 +314,100 @@ func (creator *migrationBatchCreator) computeMessageHash(batch *BatchInfo) (comm
 
 	return ethereum.GenerateMessageHash(args, batch.BatchID)
 }
+
+// VerifyBatchInfo re-checks a previously generated batch against the current chain state. The batch ID should still be
+// usable, each token should be whitelisted in the MultiversX safe contract with the same ERC20 contract address and the
+// Ethereum safe contract should hold at least the amount to be migrated. The message hash is recomputed and should match
+// the one from the batch
+func (creator *migrationBatchCreator) VerifyBatchInfo(ctx context.Context, batch *BatchInfo) error {
+	if batch == nil {
+		return errNilBatchInfo
+	}
+	if len(batch.DepositsInfo) == 0 {
+		return errEmptyDepositsList
+	}
+	if common.HexToAddress(batch.OldSafeContractAddress) != creator.safeContractAddress {
+		return fmt.Errorf("%w: batch contains %s, configured %s",
+			errSafeAddressMismatch, batch.OldSafeContractAddress, creator.safeContractAddress.String())
+	}
+	if !common.IsHexAddress(batch.NewSafeContractAddress) {
+		return fmt.Errorf("%w: %s", errInvalidNewSafeAddress, batch.NewSafeContractAddress)
+	}
+
+	wasExecuted, err := creator.ethereumChainWrapper.WasBatchExecuted(ctx, big.NewInt(0).SetUint64(batch.BatchID))
+	if err != nil {
+		return err
+	}
+	if wasExecuted {
+		return fmt.Errorf("%w, batch ID %d", errBatchAlreadyExecuted, batch.BatchID)
+	}
+
+	knownTokens, err := creator.getKnownTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, deposit := range batch.DepositsInfo {
+		err = creator.verifyDeposit(ctx, deposit, knownTokens)
+		if err != nil {
+			return err
+		}
+	}
+
+	messageHash, err := creator.computeMessageHash(batch)
+	if err != nil {
+		return err
+	}
+	if messageHash != batch.MessageHash {
+		return fmt.Errorf("%w: batch contains %s, computed %s", errMessageHashMismatch, batch.MessageHash.String(), messageHash.String())
+	}
+
+	creator.logger.Info("verified the batch against the chain state", "batch ID", batch.BatchID, "message hash", messageHash.String())
+
+	return nil
+}
+
+func (creator *migrationBatchCreator) getKnownTokens(ctx context.Context) (map[string]struct{}, error) {
+	tokens, err := creator.mvxDataGetter.GetAllKnownTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	knownTokens := make(map[string]struct{}, len(tokens))
+	for _, token := range tokens {
+		knownTokens[string(token)] = struct{}{}
+	}
+
+	return knownTokens, nil
+}
+
+func (creator *migrationBatchCreator) verifyDeposit(ctx context.Context, deposit *DepositInfo, knownTokens map[string]struct{}) error {
+	if deposit.Amount == nil || deposit.Amount.Cmp(zero) <= 0 {
+		return fmt.Errorf("%w for token %s", errInvalidDepositAmount, deposit.Token)
+	}
+
+	_, isKnown := knownTokens[deposit.Token]
+	if !isKnown {
+		return fmt.Errorf("%w: %s", errTokenNotWhitelisted, deposit.Token)
+	}
+
+	response, err := creator.mvxDataGetter.GetERC20AddressForTokenId(ctx, []byte(deposit.Token))
+	if err != nil {
+		return err
+	}
+	if len(response) != 1 || common.BytesToAddress(response[0]) != deposit.ContractAddress {
+		return fmt.Errorf("%w when querying the safe contract for token %s, batch contains %s",
+			errWrongERC20AddressResponse, deposit.Token, deposit.ContractAddress.String())
+	}
+
+	balance, err := creator.erc20ContractsHolder.BalanceOf(ctx, deposit.ContractAddress, creator.safeContractAddress)
+	if err != nil {
+		return fmt.Errorf("%w for address %s in ERC20 contract %s", err, creator.safeContractAddress.String(), deposit.ContractAddress.String())
+	}
+	if balance.Cmp(deposit.Amount) < 0 {
+		return fmt.Errorf("%w for token %s: balance %s, required %s",
+			errInsufficientBalance, deposit.Token, balance.String(), deposit.Amount.String())
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var safeContractAddress = common.HexToAddress(strings.Repeat("9", 40))
@@ -574,3 +575,189 @@ func TestMigrationBatchCreator_CreateBatchInfo(t *testing.T) {
 	})
 
 }
+
+func TestMigrationBatchCreator_VerifyBatchInfo(t *testing.T) {
+	t.Parallel()
+
+	oldSafeAddress := common.HexToAddress("0x92A26975433A61CF1134802586aa669bAB8B69f3")
+	usdcErc20Address := common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	createArgs := func() ArgsMigrationBatchCreator {
+		args := createMockArgsForMigrationBatchCreator()
+		args.SafeContractAddress = oldSafeAddress
+		args.MvxDataGetter = &bridge.DataGetterStub{
+			GetAllKnownTokensCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{[]byte("ETHUSDC-220753"), []byte("tkn2")}, nil
+			},
+			GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+				return [][]byte{usdcErc20Address.Bytes()}, nil
+			},
+		}
+		args.Erc20ContractsHolder = &bridge.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				assert.Equal(t, oldSafeAddress, address)
+				assert.Equal(t, usdcErc20Address, erc20Address)
+
+				return big.NewInt(7086984513581), nil
+			},
+		}
+		args.EthereumChainWrapper = &bridge.EthereumClientWrapperStub{
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int) (bool, error) {
+				assert.Equal(t, uint64(3548), batchNonce.Uint64())
+				return false, nil
+			},
+		}
+
+		return args
+	}
+	loadBatch := func() *BatchInfo {
+		batch, err := LoadBatchInfo("testdata/verify-migration.json")
+		require.Nil(t, err)
+
+		return batch
+	}
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createArgs())
+		err := creator.VerifyBatchInfo(context.Background(), nil)
+		assert.Equal(t, errNilBatchInfo, err)
+	})
+	t.Run("empty deposits should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createArgs())
+		batch := loadBatch()
+		batch.DepositsInfo = nil
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.Equal(t, errEmptyDepositsList, err)
+	})
+	t.Run("different safe address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.SafeContractAddress = safeContractAddress
+		creator, _ := NewMigrationBatchCreator(args)
+		err := creator.VerifyBatchInfo(context.Background(), loadBatch())
+		assert.ErrorIs(t, err, errSafeAddressMismatch)
+	})
+	t.Run("invalid new safe address should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createArgs())
+		batch := loadBatch()
+		batch.NewSafeContractAddress = "invalid"
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.ErrorIs(t, err, errInvalidNewSafeAddress)
+	})
+	t.Run("WasBatchExecuted errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.EthereumChainWrapper = &bridge.EthereumClientWrapperStub{
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int) (bool, error) {
+				return false, expectedErr
+			},
+		}
+		creator, _ := NewMigrationBatchCreator(args)
+		err := creator.VerifyBatchInfo(context.Background(), loadBatch())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("already executed batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.EthereumChainWrapper = &bridge.EthereumClientWrapperStub{
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int) (bool, error) {
+				return true, nil
+			},
+		}
+		creator, _ := NewMigrationBatchCreator(args)
+		err := creator.VerifyBatchInfo(context.Background(), loadBatch())
+		assert.ErrorIs(t, err, errBatchAlreadyExecuted)
+	})
+	t.Run("GetAllKnownTokens errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.MvxDataGetter.(*bridge.DataGetterStub).GetAllKnownTokensCalled = func(ctx context.Context) ([][]byte, error) {
+			return nil, expectedErr
+		}
+		creator, _ := NewMigrationBatchCreator(args)
+		err := creator.VerifyBatchInfo(context.Background(), loadBatch())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("not whitelisted token should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.MvxDataGetter.(*bridge.DataGetterStub).GetAllKnownTokensCalled = func(ctx context.Context) ([][]byte, error) {
+			return [][]byte{[]byte("tkn2")}, nil
+		}
+		creator, _ := NewMigrationBatchCreator(args)
+		err := creator.VerifyBatchInfo(context.Background(), loadBatch())
+		assert.ErrorIs(t, err, errTokenNotWhitelisted)
+	})
+	t.Run("different ERC20 address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.MvxDataGetter.(*bridge.DataGetterStub).GetERC20AddressForTokenIdCalled = func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+			return [][]byte{tkn1Erc20Address}, nil
+		}
+		creator, _ := NewMigrationBatchCreator(args)
+		err := creator.VerifyBatchInfo(context.Background(), loadBatch())
+		assert.ErrorIs(t, err, errWrongERC20AddressResponse)
+	})
+	t.Run("invalid deposit amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createArgs())
+		batch := loadBatch()
+		batch.DepositsInfo[0].Amount = big.NewInt(0)
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.ErrorIs(t, err, errInvalidDepositAmount)
+	})
+	t.Run("BalanceOf errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Erc20ContractsHolder = &bridge.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		creator, _ := NewMigrationBatchCreator(args)
+		err := creator.VerifyBatchInfo(context.Background(), loadBatch())
+		assert.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("balance lower than the amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.Erc20ContractsHolder = &bridge.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				return big.NewInt(7086984513580), nil
+			},
+		}
+		creator, _ := NewMigrationBatchCreator(args)
+		err := creator.VerifyBatchInfo(context.Background(), loadBatch())
+		assert.ErrorIs(t, err, errInsufficientBalance)
+	})
+	t.Run("altered batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createArgs())
+		batch := loadBatch()
+		batch.DepositsInfo[0].Amount = big.NewInt(1)
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.ErrorIs(t, err, errMessageHashMismatch)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createArgs())
+		err := creator.VerifyBatchInfo(context.Background(), loadBatch())
+		assert.Nil(t, err)
+	})
+}
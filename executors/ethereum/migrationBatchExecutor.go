@@ -65,27 +65,15 @@ func NewMigrationBatchExecutor(args ArgsMigrationBatchExecutor) (*migrationBatch
 	}, nil
 }
 
-// ExecuteTransfer will try to execute the transfer
+// ExecuteTransfer will try to execute the transfer. The transfer is simulated first and the transaction is not sent
+// if the simulation reverts
 func (executor *migrationBatchExecutor) ExecuteTransfer(ctx context.Context) error {
-	isPaused, err := executor.ethereumChainWrapper.IsPaused(ctx)
-	if err != nil {
-		return fmt.Errorf("%w in executor.ExecuteTransfer", err)
-	}
-	if isPaused {
-		return fmt.Errorf("%w in executor.ExecuteTransfer", errMultisigContractPaused)
-	}
-
-	relayers, err := executor.ethereumChainWrapper.GetRelayers(ctx)
+	signatures, err := executor.checkAndGetSignatures(ctx)
 	if err != nil {
 		return err
 	}
 
-	quorum, err := executor.ethereumChainWrapper.Quorum(ctx)
-	if err != nil {
-		return err
-	}
-
-	signatures, err := executor.checkRelayersSigsAndQuorum(relayers, quorum)
+	err = executor.simulateTransfer(ctx, signatures)
 	if err != nil {
 		return err
 	}
@@ -129,6 +117,52 @@ func (executor *migrationBatchExecutor) ExecuteTransfer(ctx context.Context) err
 	return nil
 }
 
+// SimulateTransfer will run all the checks done when executing the transfer and will simulate the transfer
+// against the pending state, without sending any transaction
+func (executor *migrationBatchExecutor) SimulateTransfer(ctx context.Context) error {
+	signatures, err := executor.checkAndGetSignatures(ctx)
+	if err != nil {
+		return err
+	}
+
+	return executor.simulateTransfer(ctx, signatures)
+}
+
+func (executor *migrationBatchExecutor) checkAndGetSignatures(ctx context.Context) ([][]byte, error) {
+	isPaused, err := executor.ethereumChainWrapper.IsPaused(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w in executor.checkAndGetSignatures", err)
+	}
+	if isPaused {
+		return nil, fmt.Errorf("%w in executor.checkAndGetSignatures", errMultisigContractPaused)
+	}
+
+	relayers, err := executor.ethereumChainWrapper.GetRelayers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	quorum, err := executor.ethereumChainWrapper.Quorum(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return executor.checkRelayersSigsAndQuorum(relayers, quorum)
+}
+
+func (executor *migrationBatchExecutor) simulateTransfer(ctx context.Context, signatures [][]byte) error {
+	tokens, recipients, amounts, depositNonces, batchNonce := executor.extractArgumentsFromBatch()
+	err := executor.ethereumChainWrapper.SimulateExecuteTransfer(ctx, executor.cryptoHandler.GetAddress(),
+		tokens, recipients, amounts, depositNonces, batchNonce, signatures)
+	if err != nil {
+		return fmt.Errorf("%w while simulating the transfer", err)
+	}
+
+	executor.logger.Info("the transfer simulation was successful", "batchID", executor.batch.BatchID)
+
+	return nil
+}
+
 func (executor *migrationBatchExecutor) getNonce(ctx context.Context, fromAddress common.Address) (int64, error) {
 	blockNonce, err := executor.ethereumChainWrapper.BlockNumber(ctx)
 	if err != nil {
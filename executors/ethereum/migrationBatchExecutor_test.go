@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/multiversx/mx-bridge-eth-go/clients"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -473,6 +474,35 @@ func TestMigrationBatchExecutor_ExecuteTransfer(t *testing.T) {
 		err := executor.ExecuteTransfer(context.Background())
 		assert.ErrorIs(t, err, errQuorumNotReached)
 	})
+	t.Run("simulation reverts should not execute the transfer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.Batch = batchInfo
+		args.Signatures = signatures
+		revertErr := &clients.RevertError{Reason: "Batch already executed"}
+		args.EthereumChainWrapper = &bridge.EthereumClientWrapperStub{
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return whitelistedRelayers, nil
+			},
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(3), nil
+			},
+			SimulateExecuteTransferCalled: func(ctx context.Context, from common.Address, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) error {
+				return revertErr
+			},
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
+				assert.Fail(t, "should have not called execute transfer")
+
+				return nil, nil
+			},
+		}
+
+		executor, _ := NewMigrationBatchExecutor(args)
+		err := executor.ExecuteTransfer(context.Background())
+		assert.ErrorIs(t, err, clients.ErrTransactionReverted)
+		assert.Contains(t, err.Error(), "Batch already executed")
+	})
 	t.Run("get block errors should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -709,3 +739,99 @@ func TestMigrationBatchExecutor_ExecuteTransfer(t *testing.T) {
 		assert.True(t, executeWasCalled)
 	})
 }
+
+func TestMigrationBatchExecutor_SimulateTransfer(t *testing.T) {
+	t.Parallel()
+
+	testMsgHash := common.HexToHash(strings.Repeat("1", 64))
+	newSafeContractAddress := common.HexToAddress("A6504Cc508889bbDBd4B748aFf6EA6b5D0d2684c")
+	batchInfo := BatchInfo{
+		OldSafeContractAddress: "3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c",
+		NewSafeContractAddress: newSafeContractAddress.String(),
+		BatchID:                4432,
+		MessageHash:            testMsgHash,
+		DepositsInfo: []*DepositInfo{
+			{
+				DepositNonce:          37,
+				Token:                 "tkn1",
+				ContractAddressString: common.BytesToAddress(tkn1Erc20Address).String(),
+				ContractAddress:       common.BytesToAddress(tkn1Erc20Address),
+				Amount:                big.NewInt(112),
+				AmountString:          "112",
+			},
+		},
+	}
+	privateKeys := createPrivateKeys(t, 2)
+	signatures := make([]SignatureInfo, 0, len(privateKeys))
+	relayers := make([]common.Address, 0, len(privateKeys))
+	for _, privateKey := range privateKeys {
+		signatures = append(signatures, SignatureInfo{
+			Address:     ethCrypto.PubkeyToAddress(privateKey.PublicKey).String(),
+			MessageHash: testMsgHash.String(),
+			Signature:   hex.EncodeToString(sign(t, privateKey, testMsgHash)),
+		})
+		relayers = append(relayers, ethCrypto.PubkeyToAddress(privateKey.PublicKey))
+	}
+	sender := common.HexToAddress("0x5555555555555555555555555555555555555555")
+
+	createArgs := func(simulationErr error, simulateWasCalled *bool) ArgsMigrationBatchExecutor {
+		args := createMockArgsMigrationBatchExecutor()
+		args.Batch = batchInfo
+		args.Signatures = signatures
+		args.CryptoHandler = &bridge.CryptoHandlerStub{
+			GetAddressCalled: func() common.Address {
+				return sender
+			},
+		}
+		args.EthereumChainWrapper = &bridge.EthereumClientWrapperStub{
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return relayers, nil
+			},
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(2), nil
+			},
+			SimulateExecuteTransferCalled: func(ctx context.Context, from common.Address, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, sigs [][]byte) error {
+				*simulateWasCalled = true
+				assert.Equal(t, sender, from)
+				assert.Equal(t, []common.Address{common.BytesToAddress(tkn1Erc20Address)}, tokens)
+				assert.Equal(t, []common.Address{newSafeContractAddress}, recipients)
+				assert.Equal(t, []*big.Int{big.NewInt(112)}, amounts)
+				assert.Equal(t, []*big.Int{big.NewInt(37)}, nonces)
+				assert.Equal(t, big.NewInt(4432), batchNonce)
+				assert.Equal(t, 2, len(sigs))
+
+				return simulationErr
+			},
+			ExecuteTransferCalled: func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error) {
+				assert.Fail(t, "should have not called execute transfer")
+
+				return nil, nil
+			},
+		}
+
+		return args
+	}
+
+	t.Run("simulation reverts should error", func(t *testing.T) {
+		t.Parallel()
+
+		simulateWasCalled := false
+		args := createArgs(&clients.RevertError{Reason: "Not enough signatures"}, &simulateWasCalled)
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.SimulateTransfer(context.Background())
+		assert.ErrorIs(t, err, clients.ErrTransactionReverted)
+		assert.True(t, simulateWasCalled)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		simulateWasCalled := false
+		args := createArgs(nil, &simulateWasCalled)
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.SimulateTransfer(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, simulateWasCalled)
+	})
+}
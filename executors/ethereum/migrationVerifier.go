@@ -0,0 +1,343 @@
+package ethereum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/multiversx/mx-bridge-eth-go/clients/balanceValidator"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	// TotalsCheckMatch signals that the Ethereum and MultiversX totals for the token are equal
+	TotalsCheckMatch = "match"
+	// TotalsCheckMismatch signals that the Ethereum and MultiversX totals for the token differ
+	TotalsCheckMismatch = "mismatch"
+
+	erc20TransferTopicsLen = 3
+)
+
+var erc20TransferEventID = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// ArgsMigrationVerifier is the argument for the NewMigrationVerifier constructor
+type ArgsMigrationVerifier struct {
+	Erc20ContractsHolder     Erc20ContractsHolder
+	MvxBalancesGetter        MvxBalancesGetter
+	OldSafeBalancesGetter    EthSafeBalancesGetter
+	NewSafeBalancesGetter    EthSafeBalancesGetter
+	EthereumChainWrapper     EthereumChainWrapper
+	TransactionReceiptGetter TransactionReceiptGetter
+	Logger                   logger.Logger
+}
+
+// MigrationVerificationReport holds the results of the post-migration checks
+type MigrationVerificationReport struct {
+	BatchID                uint64                     `json:"BatchID"`
+	TxHash                 string                     `json:"TxHash"`
+	BlockNumber            uint64                     `json:"BlockNumber"`
+	TxSucceeded            bool                       `json:"TxSucceeded"`
+	BatchExecuted          bool                       `json:"BatchExecuted"`
+	OldSafeContractAddress string                     `json:"OldSafeContractAddress"`
+	NewSafeContractAddress string                     `json:"NewSafeContractAddress"`
+	Tokens                 []*TokenVerificationReport `json:"Tokens"`
+	Success                bool                       `json:"Success"`
+	Errors                 []string                   `json:"Errors"`
+}
+
+// TokenVerificationReport holds the results of the post-migration checks for one migrated token
+type TokenVerificationReport struct {
+	Token             string   `json:"Token"`
+	ContractAddress   string   `json:"ContractAddress"`
+	ExpectedAmount    string   `json:"ExpectedAmount"`
+	TransferredAmount string   `json:"TransferredAmount"`
+	OldSafeBalance    string   `json:"OldSafeBalance"`
+	NewSafeBalance    string   `json:"NewSafeBalance"`
+	EthAmount         string   `json:"EthAmount"`
+	MvxAmount         string   `json:"MvxAmount"`
+	TotalsCheck       string   `json:"TotalsCheck"`
+	Success           bool     `json:"Success"`
+	Errors            []string `json:"Errors"`
+}
+
+type migrationVerifier struct {
+	erc20ContractsHolder     Erc20ContractsHolder
+	mvxBalancesGetter        MvxBalancesGetter
+	oldSafeBalancesGetter    EthSafeBalancesGetter
+	newSafeBalancesGetter    EthSafeBalancesGetter
+	ethereumChainWrapper     EthereumChainWrapper
+	transactionReceiptGetter TransactionReceiptGetter
+	logger                   logger.Logger
+}
+
+// NewMigrationVerifier creates a new instance of type migrationVerifier that is able to check that an executed
+// migration batch moved the funds from the old safe to the new one
+func NewMigrationVerifier(args ArgsMigrationVerifier) (*migrationVerifier, error) {
+	if check.IfNil(args.Erc20ContractsHolder) {
+		return nil, errNilErc20ContractsHolder
+	}
+	if check.IfNil(args.MvxBalancesGetter) {
+		return nil, errNilMvxBalancesGetter
+	}
+	if check.IfNilReflect(args.OldSafeBalancesGetter) {
+		return nil, errNilOldSafeBalancesGetter
+	}
+	if check.IfNilReflect(args.NewSafeBalancesGetter) {
+		return nil, errNilNewSafeBalancesGetter
+	}
+	if check.IfNilReflect(args.EthereumChainWrapper) {
+		return nil, errNilEthereumChainWrapper
+	}
+	if check.IfNilReflect(args.TransactionReceiptGetter) {
+		return nil, errNilTransactionReceiptGetter
+	}
+	if check.IfNil(args.Logger) {
+		return nil, errNilLogger
+	}
+
+	return &migrationVerifier{
+		erc20ContractsHolder:     args.Erc20ContractsHolder,
+		mvxBalancesGetter:        args.MvxBalancesGetter,
+		oldSafeBalancesGetter:    args.OldSafeBalancesGetter,
+		newSafeBalancesGetter:    args.NewSafeBalancesGetter,
+		ethereumChainWrapper:     args.EthereumChainWrapper,
+		transactionReceiptGetter: args.TransactionReceiptGetter,
+		logger:                   args.Logger,
+	}, nil
+}
+
+// VerifyMigration checks the executed migration transaction against the batch. Each deposit should have a matching ERC20
+// transfer from the old safe to the new safe in the transaction logs and the new safe should hold at least the migrated
+// amount. The amount accounted by both safes should match the MultiversX side, both being computed as the balance
+// validator does. The bridge is expected to be paused so there are no pending batches to account for.
+// The returned error is not nil only if the chain state could not be fetched, the failed checks are found in the report
+func (verifier *migrationVerifier) VerifyMigration(ctx context.Context, batch *BatchInfo, txHash common.Hash) (*MigrationVerificationReport, error) {
+	if batch == nil {
+		return nil, errNilBatchInfo
+	}
+
+	report := &MigrationVerificationReport{
+		BatchID:                batch.BatchID,
+		TxHash:                 txHash.String(),
+		OldSafeContractAddress: batch.OldSafeContractAddress,
+		NewSafeContractAddress: batch.NewSafeContractAddress,
+		Tokens:                 make([]*TokenVerificationReport, 0, len(batch.DepositsInfo)),
+		Errors:                 make([]string, 0),
+	}
+
+	receipt, err := verifier.transactionReceiptGetter.TransactionReceipt(ctx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w while fetching the receipt of transaction %s", err, txHash.String())
+	}
+	report.TxSucceeded = receipt.Status == types.ReceiptStatusSuccessful
+	if receipt.BlockNumber != nil {
+		report.BlockNumber = receipt.BlockNumber.Uint64()
+	}
+	if !report.TxSucceeded {
+		report.Errors = append(report.Errors, "the migration transaction failed")
+	}
+
+	report.BatchExecuted, err = verifier.ethereumChainWrapper.WasBatchExecuted(ctx, big.NewInt(0).SetUint64(batch.BatchID))
+	if err != nil {
+		return nil, err
+	}
+	if !report.BatchExecuted {
+		report.Errors = append(report.Errors, fmt.Sprintf("batch ID %d was not executed", batch.BatchID))
+	}
+
+	oldSafeAddress := common.HexToAddress(batch.OldSafeContractAddress)
+	newSafeAddress := common.HexToAddress(batch.NewSafeContractAddress)
+	transfers := getERC20Transfers(receipt, oldSafeAddress, newSafeAddress)
+
+	report.Success = len(report.Errors) == 0
+	for _, deposit := range batch.DepositsInfo {
+		tokenReport, errVerify := verifier.verifyDeposit(ctx, deposit, transfers, oldSafeAddress, newSafeAddress)
+		if errVerify != nil {
+			return nil, errVerify
+		}
+
+		report.Tokens = append(report.Tokens, tokenReport)
+		report.Success = report.Success && tokenReport.Success
+	}
+
+	verifier.logger.Info("verified the migration", "batch ID", batch.BatchID, "tx hash", txHash.String(), "success", report.Success)
+
+	return report, nil
+}
+
+func getERC20Transfers(receipt *types.Receipt, from common.Address, to common.Address) map[common.Address]*big.Int {
+	transfers := make(map[common.Address]*big.Int)
+	for _, txLog := range receipt.Logs {
+		if len(txLog.Topics) != erc20TransferTopicsLen || txLog.Topics[0] != erc20TransferEventID {
+			continue
+		}
+		if common.BytesToAddress(txLog.Topics[1].Bytes()) != from || common.BytesToAddress(txLog.Topics[2].Bytes()) != to {
+			continue
+		}
+
+		amount, found := transfers[txLog.Address]
+		if !found {
+			amount = big.NewInt(0)
+			transfers[txLog.Address] = amount
+		}
+		amount.Add(amount, big.NewInt(0).SetBytes(txLog.Data))
+	}
+
+	return transfers
+}
+
+func (verifier *migrationVerifier) verifyDeposit(
+	ctx context.Context,
+	deposit *DepositInfo,
+	transfers map[common.Address]*big.Int,
+	oldSafeAddress common.Address,
+	newSafeAddress common.Address,
+) (*TokenVerificationReport, error) {
+	tokenReport := &TokenVerificationReport{
+		Token:             deposit.Token,
+		ContractAddress:   deposit.ContractAddress.String(),
+		ExpectedAmount:    deposit.Amount.String(),
+		TransferredAmount: "0",
+		Errors:            make([]string, 0),
+	}
+
+	transferred := transfers[deposit.ContractAddress]
+	if transferred == nil {
+		transferred = big.NewInt(0)
+	}
+	tokenReport.TransferredAmount = transferred.String()
+	if transferred.Cmp(deposit.Amount) != 0 {
+		tokenReport.Errors = append(tokenReport.Errors, fmt.Sprintf("transferred amount %s differs from the expected amount %s",
+			transferred.String(), deposit.Amount.String()))
+	}
+
+	oldSafeBalance, err := verifier.erc20ContractsHolder.BalanceOf(ctx, deposit.ContractAddress, oldSafeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s in ERC20 contract %s", err, oldSafeAddress.String(), deposit.ContractAddress.String())
+	}
+	tokenReport.OldSafeBalance = oldSafeBalance.String()
+
+	newSafeBalance, err := verifier.erc20ContractsHolder.BalanceOf(ctx, deposit.ContractAddress, newSafeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for address %s in ERC20 contract %s", err, newSafeAddress.String(), deposit.ContractAddress.String())
+	}
+	tokenReport.NewSafeBalance = newSafeBalance.String()
+	if newSafeBalance.Cmp(deposit.Amount) < 0 {
+		tokenReport.Errors = append(tokenReport.Errors, fmt.Sprintf("new safe balance %s is lower than the migrated amount %s",
+			newSafeBalance.String(), deposit.Amount.String()))
+	}
+
+	err = verifier.checkTotals(ctx, tokenReport, deposit.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenReport.Success = len(tokenReport.Errors) == 0
+
+	return tokenReport, nil
+}
+
+func (verifier *migrationVerifier) checkTotals(ctx context.Context, tokenReport *TokenVerificationReport, ethToken common.Address) error {
+	ethAmount, mvxAmount, err := verifier.computeAmounts(ctx, ethToken, []byte(tokenReport.Token))
+	if errors.Is(err, balanceValidator.ErrNegativeAmount) {
+		tokenReport.TotalsCheck = TotalsCheckMismatch
+		tokenReport.Errors = append(tokenReport.Errors, err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	tokenReport.EthAmount = ethAmount.String()
+	tokenReport.MvxAmount = mvxAmount.String()
+	tokenReport.TotalsCheck = TotalsCheckMatch
+	if ethAmount.Cmp(mvxAmount) != 0 {
+		tokenReport.TotalsCheck = TotalsCheckMismatch
+		tokenReport.Errors = append(tokenReport.Errors, fmt.Sprintf("Ethereum safes account for %s while the MultiversX side accounts for %s",
+			ethAmount.String(), mvxAmount.String()))
+	}
+
+	return nil
+}
+
+// computeAmounts computes the token amounts accounted on both chains. The token settings are read from the old safe
+// as the new safe is expected to be configured in the same way
+func (verifier *migrationVerifier) computeAmounts(ctx context.Context, ethToken common.Address, mvxToken []byte) (*big.Int, *big.Int, error) {
+	isMintBurnOnEthereum, err := verifier.oldSafeBalancesGetter.MintBurnTokens(ctx, ethToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	isNativeOnEthereum, err := verifier.oldSafeBalancesGetter.NativeTokens(ctx, ethToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	isMintBurnOnMultiversX, err := verifier.mvxBalancesGetter.IsMintBurnToken(ctx, mvxToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	isNativeOnMultiversX, err := verifier.mvxBalancesGetter.IsNativeToken(ctx, mvxToken)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ethAmount := big.NewInt(0)
+	for _, safeBalancesGetter := range []EthSafeBalancesGetter{verifier.oldSafeBalancesGetter, verifier.newSafeBalancesGetter} {
+		safeAmount, errCompute := balanceValidator.ComputeEthAmount(ctx, safeBalancesGetter, ethToken, isMintBurnOnEthereum, isNativeOnEthereum, big.NewInt(0))
+		if errCompute != nil {
+			return nil, nil, errCompute
+		}
+		ethAmount.Add(ethAmount, safeAmount)
+	}
+
+	mvxBalancesGetter := &mvxBalancesAdapter{mvxBalancesGetter: verifier.mvxBalancesGetter}
+	mvxAmount, err := balanceValidator.ComputeMvxAmount(ctx, mvxBalancesGetter, mvxToken, isMintBurnOnMultiversX, isNativeOnMultiversX, big.NewInt(0))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ethAmount, mvxAmount, nil
+}
+
+// VerificationReportDisplayString will convert the verification report into a human-readable string
+func VerificationReportDisplayString(report *MigrationVerificationReport) string {
+	lines := []string{
+		fmt.Sprintf("Migration verification for batch ID %d, transaction %s (block %d)", report.BatchID, report.TxHash, report.BlockNumber),
+		fmt.Sprintf(" old safe: %s, new safe: %s", report.OldSafeContractAddress, report.NewSafeContractAddress),
+		fmt.Sprintf(" transaction succeeded: %v, batch executed: %v", report.TxSucceeded, report.BatchExecuted),
+	}
+	for _, errMessage := range report.Errors {
+		lines = append(lines, "  ERROR: "+errMessage)
+	}
+
+	for _, tokenReport := range report.Tokens {
+		status := "OK"
+		if !tokenReport.Success {
+			status = "FAILED"
+		}
+		lines = append(lines, fmt.Sprintf(" [%s] %s (%s): expected %s, transferred %s, old safe %s, new safe %s, totals %s",
+			status, tokenReport.Token, tokenReport.ContractAddress, tokenReport.ExpectedAmount, tokenReport.TransferredAmount,
+			tokenReport.OldSafeBalance, tokenReport.NewSafeBalance, tokenReport.TotalsCheck))
+		for _, errMessage := range tokenReport.Errors {
+			lines = append(lines, "  ERROR: "+errMessage)
+		}
+	}
+
+	result := "SUCCESS"
+	if !report.Success {
+		result = "FAILED"
+	}
+	lines = append(lines, "Result: "+result)
+
+	return strings.Join(lines, "\n")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (verifier *migrationVerifier) IsInterfaceNil() bool {
+	return verifier == nil
+}
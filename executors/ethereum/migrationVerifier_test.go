@@ -0,0 +1,434 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/multiversx/mx-bridge-eth-go/clients/balanceValidator"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var newSafeContractAddress = common.HexToAddress(strings.Repeat("8", 40))
+var migrationTxHash = common.HexToHash(strings.Repeat("7", 64))
+
+func createMockArgsMigrationVerifier() ArgsMigrationVerifier {
+	return ArgsMigrationVerifier{
+		Erc20ContractsHolder:     &bridge.ERC20ContractsHolderStub{},
+		MvxBalancesGetter:        &bridge.DataGetterStub{},
+		OldSafeBalancesGetter:    &bridge.EthereumClientWrapperStub{},
+		NewSafeBalancesGetter:    &bridge.EthereumClientWrapperStub{},
+		EthereumChainWrapper:     &bridge.EthereumClientWrapperStub{},
+		TransactionReceiptGetter: &bridge.TransactionReceiptGetterStub{},
+		Logger:                   &testsCommon.LoggerStub{},
+	}
+}
+
+func createVerifierTestBatch() *BatchInfo {
+	return &BatchInfo{
+		OldSafeContractAddress: safeContractAddress.String(),
+		NewSafeContractAddress: newSafeContractAddress.String(),
+		BatchID:                2245,
+		DepositsInfo: []*DepositInfo{
+			{
+				DepositNonce:    1,
+				Token:           "tkn1",
+				ContractAddress: common.BytesToAddress(tkn1Erc20Address),
+				Amount:          big.NewInt(100),
+			},
+			{
+				DepositNonce:    2,
+				Token:           "tkn2",
+				ContractAddress: common.BytesToAddress(tkn2Erc20Address),
+				Amount:          big.NewInt(200),
+			},
+		},
+	}
+}
+
+func createTransferLog(token common.Address, from common.Address, to common.Address, amount int64) *types.Log {
+	return &types.Log{
+		Address: token,
+		Topics: []common.Hash{
+			erc20TransferEventID,
+			common.BytesToHash(from.Bytes()),
+			common.BytesToHash(to.Bytes()),
+		},
+		Data: common.LeftPadBytes(big.NewInt(amount).Bytes(), 32),
+	}
+}
+
+func createSafeBalancesGetter(totalBalances map[common.Address]*big.Int) *bridge.EthereumClientWrapperStub {
+	return &bridge.EthereumClientWrapperStub{
+		NativeTokensCalled: func(ctx context.Context, account common.Address) (bool, error) {
+			return true, nil
+		},
+		TotalBalancesCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+			return big.NewInt(0).Set(totalBalances[account]), nil
+		},
+	}
+}
+
+func createSuccessfulArgsMigrationVerifier() ArgsMigrationVerifier {
+	receiptGetter := &bridge.TransactionReceiptGetterStub{
+		TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+			return &types.Receipt{
+				Status:      types.ReceiptStatusSuccessful,
+				BlockNumber: big.NewInt(1234),
+				Logs: []*types.Log{
+					createTransferLog(common.BytesToAddress(tkn1Erc20Address), safeContractAddress, newSafeContractAddress, 100),
+					createTransferLog(common.BytesToAddress(tkn2Erc20Address), safeContractAddress, newSafeContractAddress, 150),
+					createTransferLog(common.BytesToAddress(tkn2Erc20Address), safeContractAddress, newSafeContractAddress, 50),
+					// transfer to another address, should be ignored
+					createTransferLog(common.BytesToAddress(tkn1Erc20Address), safeContractAddress, common.HexToAddress("0x01"), 1000),
+				},
+			}, nil
+		},
+	}
+	balances := map[common.Address]map[common.Address]*big.Int{
+		common.BytesToAddress(tkn1Erc20Address): {
+			safeContractAddress:    big.NewInt(0),
+			newSafeContractAddress: big.NewInt(100),
+		},
+		common.BytesToAddress(tkn2Erc20Address): {
+			safeContractAddress:    big.NewInt(10),
+			newSafeContractAddress: big.NewInt(200),
+		},
+	}
+	erc20ContractsHolder := &bridge.ERC20ContractsHolderStub{
+		BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+			return big.NewInt(0).Set(balances[erc20Address][address]), nil
+		},
+	}
+	dataGetter := &bridge.DataGetterStub{
+		IsMintBurnTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+			return string(token) == "tkn2", nil
+		},
+		GetTotalBalancesCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+			return big.NewInt(100), nil
+		},
+		GetMintBalancesCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+			return big.NewInt(260), nil
+		},
+		GetBurnBalancesCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+			return big.NewInt(50), nil
+		},
+	}
+	chainWrapper := &bridge.EthereumClientWrapperStub{
+		WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int) (bool, error) {
+			return batchNonce.Uint64() == 2245, nil
+		},
+	}
+	oldSafeBalancesGetter := createSafeBalancesGetter(map[common.Address]*big.Int{
+		common.BytesToAddress(tkn1Erc20Address): big.NewInt(0),
+		common.BytesToAddress(tkn2Erc20Address): big.NewInt(10),
+	})
+	newSafeBalancesGetter := createSafeBalancesGetter(map[common.Address]*big.Int{
+		common.BytesToAddress(tkn1Erc20Address): big.NewInt(100),
+		common.BytesToAddress(tkn2Erc20Address): big.NewInt(200),
+	})
+
+	return ArgsMigrationVerifier{
+		Erc20ContractsHolder:     erc20ContractsHolder,
+		MvxBalancesGetter:        dataGetter,
+		OldSafeBalancesGetter:    oldSafeBalancesGetter,
+		NewSafeBalancesGetter:    newSafeBalancesGetter,
+		EthereumChainWrapper:     chainWrapper,
+		TransactionReceiptGetter: receiptGetter,
+		Logger:                   &testsCommon.LoggerStub{},
+	}
+}
+
+func TestNewMigrationVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil ERC20 contracts holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationVerifier()
+		args.Erc20ContractsHolder = nil
+
+		verifier, err := NewMigrationVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, errNilErc20ContractsHolder, err)
+	})
+	t.Run("nil MultiversX balances getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationVerifier()
+		args.MvxBalancesGetter = nil
+
+		verifier, err := NewMigrationVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, errNilMvxBalancesGetter, err)
+	})
+	t.Run("nil old safe balances getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationVerifier()
+		args.OldSafeBalancesGetter = nil
+
+		verifier, err := NewMigrationVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, errNilOldSafeBalancesGetter, err)
+	})
+	t.Run("nil new safe balances getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationVerifier()
+		args.NewSafeBalancesGetter = nil
+
+		verifier, err := NewMigrationVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, errNilNewSafeBalancesGetter, err)
+	})
+	t.Run("nil Ethereum chain wrapper should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationVerifier()
+		args.EthereumChainWrapper = nil
+
+		verifier, err := NewMigrationVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, errNilEthereumChainWrapper, err)
+	})
+	t.Run("nil transaction receipt getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationVerifier()
+		args.TransactionReceiptGetter = nil
+
+		verifier, err := NewMigrationVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, errNilTransactionReceiptGetter, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationVerifier()
+		args.Logger = nil
+
+		verifier, err := NewMigrationVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, err := NewMigrationVerifier(createMockArgsMigrationVerifier())
+		assert.False(t, check.IfNil(verifier))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMigrationVerifier_VerifyMigration(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, _ := NewMigrationVerifier(createMockArgsMigrationVerifier())
+		report, err := verifier.VerifyMigration(context.Background(), nil, migrationTxHash)
+		assert.Nil(t, report)
+		assert.Equal(t, errNilBatchInfo, err)
+	})
+	t.Run("receipt fetching errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationVerifier()
+		args.TransactionReceiptGetter = &bridge.TransactionReceiptGetterStub{
+			TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+				return nil, expectedErr
+			},
+		}
+		verifier, _ := NewMigrationVerifier(args)
+
+		report, err := verifier.VerifyMigration(context.Background(), createVerifierTestBatch(), migrationTxHash)
+		assert.Nil(t, report)
+		assert.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("balance fetching errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSuccessfulArgsMigrationVerifier()
+		args.Erc20ContractsHolder = &bridge.ERC20ContractsHolderStub{
+			BalanceOfCalled: func(ctx context.Context, erc20Address common.Address, address common.Address) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		verifier, _ := NewMigrationVerifier(args)
+
+		report, err := verifier.VerifyMigration(context.Background(), createVerifierTestBatch(), migrationTxHash)
+		assert.Nil(t, report)
+		assert.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("safe balances fetching errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSuccessfulArgsMigrationVerifier()
+		args.NewSafeBalancesGetter = &bridge.EthereumClientWrapperStub{
+			TotalBalancesCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+				return nil, expectedErr
+			},
+		}
+		verifier, _ := NewMigrationVerifier(args)
+
+		report, err := verifier.VerifyMigration(context.Background(), createVerifierTestBatch(), migrationTxHash)
+		assert.Nil(t, report)
+		assert.ErrorIs(t, err, expectedErr)
+	})
+	t.Run("successful migration should report success", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, _ := NewMigrationVerifier(createSuccessfulArgsMigrationVerifier())
+
+		report, err := verifier.VerifyMigration(context.Background(), createVerifierTestBatch(), migrationTxHash)
+		require.Nil(t, err)
+		assert.True(t, report.Success)
+		assert.True(t, report.TxSucceeded)
+		assert.True(t, report.BatchExecuted)
+		assert.Equal(t, uint64(1234), report.BlockNumber)
+		assert.Empty(t, report.Errors)
+		require.Equal(t, 2, len(report.Tokens))
+
+		expectedTkn1 := &TokenVerificationReport{
+			Token:             "tkn1",
+			ContractAddress:   common.BytesToAddress(tkn1Erc20Address).String(),
+			ExpectedAmount:    "100",
+			TransferredAmount: "100",
+			OldSafeBalance:    "0",
+			NewSafeBalance:    "100",
+			EthAmount:         "100",
+			MvxAmount:         "100",
+			TotalsCheck:       TotalsCheckMatch,
+			Success:           true,
+			Errors:            make([]string, 0),
+		}
+		assert.Equal(t, expectedTkn1, report.Tokens[0])
+		assert.Equal(t, "200", report.Tokens[1].TransferredAmount)
+		assert.Equal(t, "210", report.Tokens[1].EthAmount)
+		assert.Equal(t, "210", report.Tokens[1].MvxAmount)
+		assert.True(t, report.Tokens[1].Success)
+
+		assert.Contains(t, VerificationReportDisplayString(report), "Result: SUCCESS")
+	})
+	t.Run("failed transaction and missing transfers should report failure", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSuccessfulArgsMigrationVerifier()
+		args.EthereumChainWrapper = &bridge.EthereumClientWrapperStub{
+			WasBatchExecutedCalled: func(ctx context.Context, batchNonce *big.Int) (bool, error) {
+				return false, nil
+			},
+		}
+		args.TransactionReceiptGetter = &bridge.TransactionReceiptGetterStub{
+			TransactionReceiptCalled: func(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+				return &types.Receipt{
+					Status: types.ReceiptStatusFailed,
+				}, nil
+			},
+		}
+		verifier, _ := NewMigrationVerifier(args)
+
+		report, err := verifier.VerifyMigration(context.Background(), createVerifierTestBatch(), migrationTxHash)
+		require.Nil(t, err)
+		assert.False(t, report.Success)
+		assert.False(t, report.TxSucceeded)
+		assert.False(t, report.BatchExecuted)
+		assert.Equal(t, 2, len(report.Errors))
+		assert.False(t, report.Tokens[0].Success)
+		assert.Equal(t, "0", report.Tokens[0].TransferredAmount)
+
+		assert.Contains(t, VerificationReportDisplayString(report), "Result: FAILED")
+	})
+	t.Run("totals mismatch should report failure", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSuccessfulArgsMigrationVerifier()
+		dataGetter := args.MvxBalancesGetter.(*bridge.DataGetterStub)
+		dataGetter.GetTotalBalancesCalled = func(ctx context.Context, token []byte) (*big.Int, error) {
+			return big.NewInt(99), nil
+		}
+		verifier, _ := NewMigrationVerifier(args)
+
+		report, err := verifier.VerifyMigration(context.Background(), createVerifierTestBatch(), migrationTxHash)
+		require.Nil(t, err)
+		assert.False(t, report.Success)
+		assert.Equal(t, TotalsCheckMismatch, report.Tokens[0].TotalsCheck)
+		assert.False(t, report.Tokens[0].Success)
+		assert.True(t, report.Tokens[1].Success)
+	})
+	t.Run("negative amount should report failure", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSuccessfulArgsMigrationVerifier()
+		dataGetter := args.MvxBalancesGetter.(*bridge.DataGetterStub)
+		dataGetter.GetBurnBalancesCalled = func(ctx context.Context, token []byte) (*big.Int, error) {
+			return big.NewInt(300), nil
+		}
+		verifier, _ := NewMigrationVerifier(args)
+
+		report, err := verifier.VerifyMigration(context.Background(), createVerifierTestBatch(), migrationTxHash)
+		require.Nil(t, err)
+		assert.False(t, report.Success)
+		assert.True(t, report.Tokens[0].Success)
+		assert.Equal(t, TotalsCheckMismatch, report.Tokens[1].TotalsCheck)
+		assert.False(t, report.Tokens[1].Success)
+		require.Equal(t, 1, len(report.Tokens[1].Errors))
+		assert.Contains(t, report.Tokens[1].Errors[0], balanceValidator.ErrNegativeAmount.Error())
+	})
+	t.Run("tokens native on MultiversX should use the mint and burn balances", func(t *testing.T) {
+		t.Parallel()
+
+		args := createSuccessfulArgsMigrationVerifier()
+		dataGetter := args.MvxBalancesGetter.(*bridge.DataGetterStub)
+		dataGetter.IsNativeTokenCalled = func(ctx context.Context, token []byte) (bool, error) {
+			return true, nil
+		}
+		dataGetter.IsMintBurnTokenCalled = func(ctx context.Context, token []byte) (bool, error) {
+			return true, nil
+		}
+		dataGetter.GetMintBalancesCalled = func(ctx context.Context, token []byte) (*big.Int, error) {
+			return big.NewInt(50), nil
+		}
+		dataGetter.GetBurnBalancesCalled = func(ctx context.Context, token []byte) (*big.Int, error) {
+			return big.NewInt(350), nil
+		}
+		args.OldSafeBalancesGetter = &bridge.EthereumClientWrapperStub{
+			MintBurnTokensCalled: func(ctx context.Context, account common.Address) (bool, error) {
+				return true, nil
+			},
+			MintBalancesCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+				return big.NewInt(400), nil
+			},
+			BurnBalancesCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+				return big.NewInt(150), nil
+			},
+		}
+		args.NewSafeBalancesGetter = &bridge.EthereumClientWrapperStub{
+			MintBalancesCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+				return big.NewInt(60), nil
+			},
+			BurnBalancesCalled: func(ctx context.Context, account common.Address) (*big.Int, error) {
+				return big.NewInt(10), nil
+			},
+		}
+		verifier, _ := NewMigrationVerifier(args)
+
+		report, err := verifier.VerifyMigration(context.Background(), createVerifierTestBatch(), migrationTxHash)
+		require.Nil(t, err)
+		assert.True(t, report.Success)
+		for _, tokenReport := range report.Tokens {
+			assert.Equal(t, TotalsCheckMatch, tokenReport.TotalsCheck)
+			assert.Equal(t, "300", tokenReport.EthAmount)
+			assert.Equal(t, "300", tokenReport.MvxAmount)
+		}
+	})
+}
@@ -0,0 +1,26 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+)
+
+// mvxBalancesAdapter exposes the MultiversX balances getter with the method set used by the balance validator
+type mvxBalancesAdapter struct {
+	mvxBalancesGetter MvxBalancesGetter
+}
+
+// TotalBalances returns the total balances of the provided token
+func (adapter *mvxBalancesAdapter) TotalBalances(ctx context.Context, token []byte) (*big.Int, error) {
+	return adapter.mvxBalancesGetter.GetTotalBalances(ctx, token)
+}
+
+// MintBalances returns the mint balances of the provided token
+func (adapter *mvxBalancesAdapter) MintBalances(ctx context.Context, token []byte) (*big.Int, error) {
+	return adapter.mvxBalancesGetter.GetMintBalances(ctx, token)
+}
+
+// BurnBalances returns the burn balances of the provided token
+func (adapter *mvxBalancesAdapter) BurnBalances(ctx context.Context, token []byte) (*big.Int, error) {
+	return adapter.mvxBalancesGetter.GetBurnBalances(ctx, token)
+}
@@ -0,0 +1,155 @@
+package ethereum
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsSignaturesCollector is the argument for the NewSignaturesCollector constructor
+type ArgsSignaturesCollector struct {
+	Batch    *BatchInfo
+	Relayers []common.Address
+	Quorum   uint64
+	Logger   logger.Logger
+}
+
+// SignaturesCollectionStatus holds the progress of the signatures collection toward the quorum
+type SignaturesCollectionStatus struct {
+	BatchID         uint64   `json:"BatchID"`
+	MessageHash     string   `json:"MessageHash"`
+	Quorum          uint64   `json:"Quorum"`
+	NumSignatures   uint64   `json:"NumSignatures"`
+	QuorumReached   bool     `json:"QuorumReached"`
+	SignedRelayers  []string `json:"SignedRelayers"`
+	MissingRelayers []string `json:"MissingRelayers"`
+}
+
+type signaturesCollector struct {
+	batch      *BatchInfo
+	relayers   []common.Address
+	quorum     uint64
+	logger     logger.Logger
+	mut        sync.RWMutex
+	signatures map[common.Address]SignatureInfo
+}
+
+// NewSignaturesCollector creates a new instance of type signaturesCollector that validates and stores the
+// signatures provided by the whitelisted relayers for the migration batch
+func NewSignaturesCollector(args ArgsSignaturesCollector) (*signaturesCollector, error) {
+	if args.Batch == nil {
+		return nil, errNilBatchInfo
+	}
+	if len(args.Relayers) == 0 {
+		return nil, errEmptyRelayersList
+	}
+	if args.Quorum == 0 {
+		return nil, errInvalidQuorum
+	}
+	if check.IfNil(args.Logger) {
+		return nil, errNilLogger
+	}
+
+	return &signaturesCollector{
+		batch:      args.Batch,
+		relayers:   args.Relayers,
+		quorum:     args.Quorum,
+		logger:     args.Logger,
+		signatures: make(map[common.Address]SignatureInfo),
+	}, nil
+}
+
+// AddSignature validates the provided signature info against the batch message hash and the whitelisted relayers
+// and stores it. A newer valid signature from the same relayer replaces the old one
+func (collector *signaturesCollector) AddSignature(sigInfo SignatureInfo) error {
+	expectedMessageHash := collector.batch.MessageHash.String()
+	if sigInfo.MessageHash != expectedMessageHash {
+		return fmt.Errorf("%w: expected %s, got %s", errMessageHashMismatch, expectedMessageHash, sigInfo.MessageHash)
+	}
+	if !common.IsHexAddress(sigInfo.Address) {
+		return fmt.Errorf("%w: %s", errInvalidRelayerAddress, sigInfo.Address)
+	}
+	if !isWhitelistedRelayer(sigInfo, collector.relayers) {
+		return fmt.Errorf("%w: %s", errRelayerNotWhitelisted, sigInfo.Address)
+	}
+
+	sig, err := hex.DecodeString(sigInfo.Signature)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errInvalidSignature, err.Error())
+	}
+
+	relayerAddress := common.HexToAddress(sigInfo.Address)
+	err = verifySignature(collector.batch.MessageHash, sig, relayerAddress)
+	if err != nil {
+		return fmt.Errorf("%w for relayer %s", err, sigInfo.Address)
+	}
+
+	collector.mut.Lock()
+	_, found := collector.signatures[relayerAddress]
+	collector.signatures[relayerAddress] = sigInfo
+	numSignatures := len(collector.signatures)
+	collector.mut.Unlock()
+
+	if found {
+		collector.logger.Info("replaced the signature of the relayer", "relayer", relayerAddress.String())
+	}
+	collector.logger.Info("collected signature", "relayer", relayerAddress.String(),
+		"num signatures", numSignatures, "quorum", collector.quorum)
+
+	return nil
+}
+
+// Signatures returns all the valid collected signatures, sorted by the relayers' addresses
+func (collector *signaturesCollector) Signatures() []SignatureInfo {
+	collector.mut.RLock()
+	defer collector.mut.RUnlock()
+
+	signatures := make([]SignatureInfo, 0, len(collector.signatures))
+	for _, sigInfo := range collector.signatures {
+		signatures = append(signatures, sigInfo)
+	}
+
+	sort.Slice(signatures, func(i, j int) bool {
+		return common.HexToAddress(signatures[i].Address).Hex() < common.HexToAddress(signatures[j].Address).Hex()
+	})
+
+	return signatures
+}
+
+// Status returns the progress of the signatures collection
+func (collector *signaturesCollector) Status() SignaturesCollectionStatus {
+	collector.mut.RLock()
+	defer collector.mut.RUnlock()
+
+	status := SignaturesCollectionStatus{
+		BatchID:         collector.batch.BatchID,
+		MessageHash:     collector.batch.MessageHash.String(),
+		Quorum:          collector.quorum,
+		NumSignatures:   uint64(len(collector.signatures)),
+		SignedRelayers:  make([]string, 0, len(collector.signatures)),
+		MissingRelayers: make([]string, 0, len(collector.relayers)),
+	}
+	status.QuorumReached = status.NumSignatures >= collector.quorum
+
+	for _, relayer := range collector.relayers {
+		_, found := collector.signatures[relayer]
+		if found {
+			status.SignedRelayers = append(status.SignedRelayers, relayer.String())
+			continue
+		}
+
+		status.MissingRelayers = append(status.MissingRelayers, relayer.String())
+	}
+
+	return status
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (collector *signaturesCollector) IsInterfaceNil() bool {
+	return collector == nil
+}
@@ -0,0 +1,80 @@
+package ethereum
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const signaturesCollectorRequestTimeout = time.Second * 30
+
+var signaturesCollectorHttpClient = &http.Client{
+	Timeout: signaturesCollectorRequestTimeout,
+}
+
+// SubmitSignature sends the signature info to the signatures collector server found at the provided URL and
+// returns the collection progress
+func SubmitSignature(ctx context.Context, serverURL string, sigInfo SignatureInfo) (*SignaturesCollectionStatus, error) {
+	buff, err := json.Marshal(sigInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &SignaturesCollectionStatus{}
+	err = doSignaturesCollectorRequest(ctx, http.MethodPost, strings.TrimSuffix(serverURL, "/")+SignaturesCollectorSignatureRoute, buff, status)
+	if err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// FetchSignatures pulls all the collected signatures from the signatures collector server found at the provided URL
+func FetchSignatures(ctx context.Context, serverURL string) ([]SignatureInfo, error) {
+	signatures := make([]SignatureInfo, 0)
+	err := doSignaturesCollectorRequest(ctx, http.MethodGet, strings.TrimSuffix(serverURL, "/")+SignaturesCollectorSignatureRoute, nil, &signatures)
+	if err != nil {
+		return nil, err
+	}
+
+	return signatures, nil
+}
+
+func doSignaturesCollectorRequest(ctx context.Context, method string, url string, body []byte, data interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := signaturesCollectorHttpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	buff, err := io.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+
+	collectorResponse := &signaturesCollectorResponse{
+		Data: data,
+	}
+	err = json.Unmarshal(buff, collectorResponse)
+	if err != nil {
+		return fmt.Errorf("%w while decoding the signatures collector response, HTTP status %d", err, response.StatusCode)
+	}
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: %s", errSignaturesCollectorRequestFailed, collectorResponse.Error)
+	}
+
+	return nil
+}
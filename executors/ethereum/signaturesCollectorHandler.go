@@ -0,0 +1,119 @@
+package ethereum
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	// SignaturesCollectorSignatureRoute is the route used to submit and fetch the signatures
+	SignaturesCollectorSignatureRoute = "/signatures"
+	// SignaturesCollectorStatusRoute is the route used to fetch the collection progress
+	SignaturesCollectorStatusRoute = "/status"
+
+	maxSignatureRequestSize = 1 << 16
+)
+
+// signaturesCollectorResponse is the generic response returned by the signatures collector endpoints
+type signaturesCollectorResponse struct {
+	Data  interface{} `json:"data"`
+	Error string      `json:"error"`
+}
+
+type signaturesCollectorHandler struct {
+	collector SignaturesCollector
+	logger    logger.Logger
+	mux       *http.ServeMux
+}
+
+// NewSignaturesCollectorHandler creates a new http handler that exposes the signatures collector. Operators POST
+// their signature info on the signatures route while the executing operator GETs the collected signatures
+func NewSignaturesCollectorHandler(collector SignaturesCollector, log logger.Logger) (*signaturesCollectorHandler, error) {
+	if check.IfNil(collector) {
+		return nil, errNilSignaturesCollector
+	}
+	if check.IfNil(log) {
+		return nil, errNilLogger
+	}
+
+	handler := &signaturesCollectorHandler{
+		collector: collector,
+		logger:    log,
+		mux:       http.NewServeMux(),
+	}
+	handler.mux.HandleFunc(SignaturesCollectorSignatureRoute, handler.handleSignatures)
+	handler.mux.HandleFunc(SignaturesCollectorStatusRoute, handler.handleStatus)
+
+	return handler, nil
+}
+
+// ServeHTTP dispatches the request to the matching route
+func (handler *signaturesCollectorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	handler.mux.ServeHTTP(w, r)
+}
+
+func (handler *signaturesCollectorHandler) handleSignatures(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		handler.writeResponse(w, http.StatusOK, handler.collector.Signatures(), "")
+	case http.MethodPost:
+		handler.addSignature(w, r)
+	default:
+		handler.writeResponse(w, http.StatusMethodNotAllowed, nil, "method not allowed")
+	}
+}
+
+func (handler *signaturesCollectorHandler) addSignature(w http.ResponseWriter, r *http.Request) {
+	buff, err := io.ReadAll(io.LimitReader(r.Body, maxSignatureRequestSize))
+	if err != nil {
+		handler.writeResponse(w, http.StatusBadRequest, nil, err.Error())
+		return
+	}
+
+	sigInfo := SignatureInfo{}
+	err = json.Unmarshal(buff, &sigInfo)
+	if err != nil {
+		handler.writeResponse(w, http.StatusBadRequest, nil, err.Error())
+		return
+	}
+
+	err = handler.collector.AddSignature(sigInfo)
+	if err != nil {
+		handler.logger.Warn("rejected signature", "address", sigInfo.Address, "remote", r.RemoteAddr, "error", err)
+		handler.writeResponse(w, http.StatusBadRequest, nil, err.Error())
+		return
+	}
+
+	handler.writeResponse(w, http.StatusOK, handler.collector.Status(), "")
+}
+
+func (handler *signaturesCollectorHandler) handleStatus(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		handler.writeResponse(w, http.StatusMethodNotAllowed, nil, "method not allowed")
+		return
+	}
+
+	handler.writeResponse(w, http.StatusOK, handler.collector.Status(), "")
+}
+
+func (handler *signaturesCollectorHandler) writeResponse(w http.ResponseWriter, code int, data interface{}, errMessage string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	err := json.NewEncoder(w).Encode(signaturesCollectorResponse{
+		Data:  data,
+		Error: errMessage,
+	})
+	if err != nil {
+		handler.logger.Warn("error writing the signatures collector response", "error", err)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (handler *signaturesCollectorHandler) IsInterfaceNil() bool {
+	return handler == nil
+}
@@ -0,0 +1,118 @@
+package ethereum
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSignaturesCollectorHandler(t *testing.T) {
+	t.Parallel()
+
+	privateKeys := createPrivateKeys(t, 1)
+	collector, _ := NewSignaturesCollector(createMockArgsSignaturesCollector(privateKeys))
+
+	t.Run("nil collector should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewSignaturesCollectorHandler(nil, &testsCommon.LoggerStub{})
+		assert.Nil(t, handler)
+		assert.Equal(t, errNilSignaturesCollector, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewSignaturesCollectorHandler(collector, nil)
+		assert.Nil(t, handler)
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		handler, err := NewSignaturesCollectorHandler(collector, &testsCommon.LoggerStub{})
+		assert.False(t, check.IfNil(handler))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSignaturesCollectorHandler_SubmitAndFetch(t *testing.T) {
+	t.Parallel()
+
+	privateKeys := createPrivateKeys(t, 3)
+	collector, _ := NewSignaturesCollector(createMockArgsSignaturesCollector(privateKeys))
+	handler, _ := NewSignaturesCollectorHandler(collector, &testsCommon.LoggerStub{})
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	ctx := context.Background()
+	sigInfo0 := createSignatureInfo(t, privateKeys[0], testCollectorMessageHash)
+	status, err := SubmitSignature(ctx, server.URL, sigInfo0)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(1), status.NumSignatures)
+	assert.False(t, status.QuorumReached)
+
+	otherKey := createPrivateKeys(t, 1)[0]
+	status, err = SubmitSignature(ctx, server.URL, createSignatureInfo(t, otherKey, testCollectorMessageHash))
+	assert.ErrorIs(t, err, errSignaturesCollectorRequestFailed)
+	assert.Contains(t, err.Error(), errRelayerNotWhitelisted.Error())
+	assert.Nil(t, status)
+
+	sigInfo1 := createSignatureInfo(t, privateKeys[1], testCollectorMessageHash)
+	status, err = SubmitSignature(ctx, server.URL+"/", sigInfo1)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(2), status.NumSignatures)
+	assert.True(t, status.QuorumReached)
+
+	signatures, err := FetchSignatures(ctx, server.URL)
+	require.Nil(t, err)
+	assert.Equal(t, collector.Signatures(), signatures)
+	assert.Equal(t, 2, len(signatures))
+}
+
+func TestSignaturesCollectorHandler_Routes(t *testing.T) {
+	t.Parallel()
+
+	privateKeys := createPrivateKeys(t, 1)
+	collector, _ := NewSignaturesCollector(createMockArgsSignaturesCollector(privateKeys))
+	handler, _ := NewSignaturesCollectorHandler(collector, &testsCommon.LoggerStub{})
+
+	t.Run("status should work", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest(http.MethodGet, SignaturesCollectorStatusRoute, nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Contains(t, resp.Body.String(), testCollectorMessageHash.String())
+	})
+	t.Run("invalid json should return bad request", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest(http.MethodPost, SignaturesCollectorSignatureRoute, strings.NewReader("not a json"))
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("unsupported method should error", func(t *testing.T) {
+		t.Parallel()
+
+		req, _ := http.NewRequest(http.MethodDelete, SignaturesCollectorSignatureRoute, nil)
+		resp := httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+
+		req, _ = http.NewRequest(http.MethodPost, SignaturesCollectorStatusRoute, nil)
+		resp = httptest.NewRecorder()
+		handler.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+	})
+}
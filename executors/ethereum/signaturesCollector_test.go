@@ -0,0 +1,195 @@
+package ethereum
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+var testCollectorMessageHash = common.HexToHash(strings.Repeat("2", 64))
+
+func createMockArgsSignaturesCollector(privateKeys []*ecdsa.PrivateKey) ArgsSignaturesCollector {
+	relayers := make([]common.Address, 0, len(privateKeys))
+	for _, privateKey := range privateKeys {
+		relayers = append(relayers, ethCrypto.PubkeyToAddress(privateKey.PublicKey))
+	}
+
+	return ArgsSignaturesCollector{
+		Batch: &BatchInfo{
+			BatchID:     4432,
+			MessageHash: testCollectorMessageHash,
+		},
+		Relayers: relayers,
+		Quorum:   2,
+		Logger:   &testsCommon.LoggerStub{},
+	}
+}
+
+func createSignatureInfo(tb testing.TB, privateKey *ecdsa.PrivateKey, msgHash common.Hash) SignatureInfo {
+	return SignatureInfo{
+		Address:     ethCrypto.PubkeyToAddress(privateKey.PublicKey).String(),
+		MessageHash: msgHash.String(),
+		Signature:   hex.EncodeToString(sign(tb, privateKey, msgHash)),
+	}
+}
+
+func TestNewSignaturesCollector(t *testing.T) {
+	t.Parallel()
+
+	privateKeys := createPrivateKeys(t, 3)
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesCollector(privateKeys)
+		args.Batch = nil
+
+		collector, err := NewSignaturesCollector(args)
+		assert.Nil(t, collector)
+		assert.Equal(t, errNilBatchInfo, err)
+	})
+	t.Run("empty relayers list should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesCollector(privateKeys)
+		args.Relayers = nil
+
+		collector, err := NewSignaturesCollector(args)
+		assert.Nil(t, collector)
+		assert.Equal(t, errEmptyRelayersList, err)
+	})
+	t.Run("invalid quorum should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesCollector(privateKeys)
+		args.Quorum = 0
+
+		collector, err := NewSignaturesCollector(args)
+		assert.Nil(t, collector)
+		assert.Equal(t, errInvalidQuorum, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesCollector(privateKeys)
+		args.Logger = nil
+
+		collector, err := NewSignaturesCollector(args)
+		assert.Nil(t, collector)
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSignaturesCollector(privateKeys)
+
+		collector, err := NewSignaturesCollector(args)
+		assert.False(t, check.IfNil(collector))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSignaturesCollector_AddSignature(t *testing.T) {
+	t.Parallel()
+
+	privateKeys := createPrivateKeys(t, 3)
+
+	t.Run("different message hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		collector, _ := NewSignaturesCollector(createMockArgsSignaturesCollector(privateKeys))
+		sigInfo := createSignatureInfo(t, privateKeys[0], common.HexToHash(strings.Repeat("3", 64)))
+
+		err := collector.AddSignature(sigInfo)
+		assert.ErrorIs(t, err, errMessageHashMismatch)
+		assert.Empty(t, collector.Signatures())
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		collector, _ := NewSignaturesCollector(createMockArgsSignaturesCollector(privateKeys))
+		sigInfo := createSignatureInfo(t, privateKeys[0], testCollectorMessageHash)
+		sigInfo.Address = "not an address"
+
+		err := collector.AddSignature(sigInfo)
+		assert.ErrorIs(t, err, errInvalidRelayerAddress)
+	})
+	t.Run("not whitelisted relayer should error", func(t *testing.T) {
+		t.Parallel()
+
+		collector, _ := NewSignaturesCollector(createMockArgsSignaturesCollector(privateKeys))
+		otherKey := createPrivateKeys(t, 1)[0]
+		sigInfo := createSignatureInfo(t, otherKey, testCollectorMessageHash)
+
+		err := collector.AddSignature(sigInfo)
+		assert.ErrorIs(t, err, errRelayerNotWhitelisted)
+	})
+	t.Run("not hex signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		collector, _ := NewSignaturesCollector(createMockArgsSignaturesCollector(privateKeys))
+		sigInfo := createSignatureInfo(t, privateKeys[0], testCollectorMessageHash)
+		sigInfo.Signature = "not hex"
+
+		err := collector.AddSignature(sigInfo)
+		assert.ErrorIs(t, err, errInvalidSignature)
+	})
+	t.Run("signature from another relayer should error", func(t *testing.T) {
+		t.Parallel()
+
+		collector, _ := NewSignaturesCollector(createMockArgsSignaturesCollector(privateKeys))
+		sigInfo := createSignatureInfo(t, privateKeys[0], testCollectorMessageHash)
+		sigInfo.Address = ethCrypto.PubkeyToAddress(privateKeys[1].PublicKey).String()
+
+		err := collector.AddSignature(sigInfo)
+		assert.True(t, errors.Is(err, errInvalidSignature))
+		assert.Empty(t, collector.Signatures())
+	})
+	t.Run("should work and replace the relayer's previous signature", func(t *testing.T) {
+		t.Parallel()
+
+		collector, _ := NewSignaturesCollector(createMockArgsSignaturesCollector(privateKeys))
+		sigInfo := createSignatureInfo(t, privateKeys[0], testCollectorMessageHash)
+
+		err := collector.AddSignature(sigInfo)
+		assert.Nil(t, err)
+		err = collector.AddSignature(sigInfo)
+		assert.Nil(t, err)
+		assert.Equal(t, []SignatureInfo{sigInfo}, collector.Signatures())
+	})
+}
+
+func TestSignaturesCollector_Status(t *testing.T) {
+	t.Parallel()
+
+	privateKeys := createPrivateKeys(t, 3)
+	args := createMockArgsSignaturesCollector(privateKeys)
+	collector, _ := NewSignaturesCollector(args)
+
+	status := collector.Status()
+	assert.Equal(t, uint64(4432), status.BatchID)
+	assert.Equal(t, testCollectorMessageHash.String(), status.MessageHash)
+	assert.Equal(t, uint64(2), status.Quorum)
+	assert.Equal(t, uint64(0), status.NumSignatures)
+	assert.False(t, status.QuorumReached)
+	assert.Empty(t, status.SignedRelayers)
+	assert.Equal(t, 3, len(status.MissingRelayers))
+
+	_ = collector.AddSignature(createSignatureInfo(t, privateKeys[0], testCollectorMessageHash))
+	_ = collector.AddSignature(createSignatureInfo(t, privateKeys[2], testCollectorMessageHash))
+
+	status = collector.Status()
+	assert.Equal(t, uint64(2), status.NumSignatures)
+	assert.True(t, status.QuorumReached)
+	assert.Equal(t, []string{args.Relayers[0].String(), args.Relayers[2].String()}, status.SignedRelayers)
+	assert.Equal(t, []string{args.Relayers[1].String()}, status.MissingRelayers)
+	assert.Equal(t, 2, len(collector.Signatures()))
+}
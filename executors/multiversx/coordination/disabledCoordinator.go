@@ -0,0 +1,25 @@
+package coordination
+
+type disabledCoordinator struct {
+}
+
+// NewDisabledCoordinator creates a coordinator to be used when a single executor instance is running. All the
+// pending operations are handled by this instance
+func NewDisabledCoordinator() *disabledCoordinator {
+	return &disabledCoordinator{}
+}
+
+// ShouldExecute returns true
+func (coordinator *disabledCoordinator) ShouldExecute(_ uint64) bool {
+	return true
+}
+
+// Close returns nil
+func (coordinator *disabledCoordinator) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (coordinator *disabledCoordinator) IsInterfaceNil() bool {
+	return coordinator == nil
+}
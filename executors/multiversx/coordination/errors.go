@@ -0,0 +1,18 @@
+package coordination
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrEmptyLeaseFile signals that an empty lease file path was provided
+var ErrEmptyLeaseFile = errors.New("empty lease file")
+
+// ErrFileLockNotSupported signals that the file locking is not supported on the current operating system
+var ErrFileLockNotSupported = errors.New("file locking is not supported on this operating system")
+
+// ErrEmptyTakeoverStateFile signals that an empty takeover state file path was provided while the takeover is enabled
+var ErrEmptyTakeoverStateFile = errors.New("empty takeover state file")
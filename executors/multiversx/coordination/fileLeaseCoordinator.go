@@ -0,0 +1,223 @@
+package coordination
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	minLeaseDuration    = time.Second
+	numRenewalsPerLease = 3
+)
+
+// ArgsFileLeaseCoordinator is the DTO used in the file lease coordinator constructor
+type ArgsFileLeaseCoordinator struct {
+	Log           logger.Logger
+	LeaseFile     string
+	InstanceID    string
+	LeaseDuration time.Duration
+}
+
+type lease struct {
+	Owner     string `json:"owner"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+type fileLeaseCoordinator struct {
+	log            logger.Logger
+	leaseFile      string
+	instanceID     string
+	leaseDuration  time.Duration
+	getTimeHandler func() time.Time
+	cancel         func()
+	wg             sync.WaitGroup
+
+	mut         sync.RWMutex
+	leaderUntil time.Time
+}
+
+// NewFileLeaseCoordinator creates a coordinator that elects a single leader between the instances sharing the same
+// lease file. The leader renews its lease periodically and handles all the pending operations while the other
+// instances stay on hot standby, ready to acquire the lease once it expires
+func NewFileLeaseCoordinator(args ArgsFileLeaseCoordinator) (*fileLeaseCoordinator, error) {
+	err := checkFileLeaseArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	coordinator := &fileLeaseCoordinator{
+		log:            args.Log,
+		leaseFile:      args.LeaseFile,
+		instanceID:     args.InstanceID,
+		leaseDuration:  args.LeaseDuration,
+		getTimeHandler: time.Now,
+	}
+
+	err = coordinator.renewLease()
+	if err != nil {
+		return nil, err
+	}
+
+	args.Log.Info("NewFileLeaseCoordinator",
+		"lease file", args.LeaseFile,
+		"instance ID", args.InstanceID,
+		"lease duration", args.LeaseDuration,
+		"is leader", coordinator.isLeader())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	coordinator.cancel = cancel
+	coordinator.wg.Add(1)
+	go coordinator.processLoop(ctx)
+
+	return coordinator, nil
+}
+
+func checkFileLeaseArgs(args ArgsFileLeaseCoordinator) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if len(args.LeaseFile) == 0 {
+		return ErrEmptyLeaseFile
+	}
+	if len(strings.TrimSpace(args.InstanceID)) == 0 {
+		return fmt.Errorf("%w for InstanceID, empty value", ErrInvalidValue)
+	}
+	if args.LeaseDuration < minLeaseDuration {
+		return fmt.Errorf("%w for LeaseDuration, got %v, minimum %v", ErrInvalidValue, args.LeaseDuration, minLeaseDuration)
+	}
+
+	return nil
+}
+
+func (coordinator *fileLeaseCoordinator) processLoop(ctx context.Context) {
+	defer coordinator.wg.Done()
+
+	ticker := time.NewTicker(coordinator.leaseDuration / numRenewalsPerLease)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			coordinator.log.Debug("fileLeaseCoordinator: closing the process loop")
+			return
+		case <-ticker.C:
+			err := coordinator.renewLease()
+			if err != nil {
+				coordinator.log.Error("fileLeaseCoordinator: error renewing the lease", "error", err)
+			}
+		}
+	}
+}
+
+// renewLease acquires or renews the lease if it is free, expired or already held by this instance
+func (coordinator *fileLeaseCoordinator) renewLease() error {
+	wasLeader := coordinator.isLeader()
+
+	now := coordinator.getTimeHandler()
+	newLease := &lease{
+		Owner:     coordinator.instanceID,
+		ExpiresAt: now.Add(coordinator.leaseDuration).UnixMilli(),
+	}
+
+	currentLease, err := coordinator.updateLeaseFile(func(currentLease *lease) *lease {
+		if currentLease.Owner != coordinator.instanceID && currentLease.ExpiresAt > now.UnixMilli() {
+			return nil
+		}
+
+		return newLease
+	})
+	if err != nil {
+		return err
+	}
+
+	leaderUntil := time.Time{}
+	if currentLease == newLease {
+		leaderUntil = time.UnixMilli(newLease.ExpiresAt)
+	}
+
+	coordinator.mut.Lock()
+	coordinator.leaderUntil = leaderUntil
+	coordinator.mut.Unlock()
+
+	isLeader := coordinator.isLeader()
+	if isLeader != wasLeader {
+		coordinator.log.Info("fileLeaseCoordinator: leadership changed", "is leader", isLeader,
+			"lease owner", currentLease.Owner)
+	}
+
+	return nil
+}
+
+// updateLeaseFile reads the lease file while holding the file lock and writes the lease returned by the handler,
+// if not nil. Returns the lease stored in the file after the update
+func (coordinator *fileLeaseCoordinator) updateLeaseFile(handler func(currentLease *lease) *lease) (*lease, error) {
+	var storedLease *lease
+	err := updateSharedFile(coordinator.leaseFile, func(buff []byte) ([]byte, error) {
+		currentLease := &lease{}
+		if len(buff) > 0 {
+			errUnmarshal := json.Unmarshal(buff, currentLease)
+			if errUnmarshal != nil {
+				coordinator.log.Warn("fileLeaseCoordinator: invalid lease file content, overwriting", "error", errUnmarshal)
+				currentLease = &lease{}
+			}
+		}
+
+		storedLease = currentLease
+		newLease := handler(currentLease)
+		if newLease == nil {
+			return nil, nil
+		}
+
+		storedLease = newLease
+		return json.Marshal(newLease)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return storedLease, nil
+}
+
+func (coordinator *fileLeaseCoordinator) isLeader() bool {
+	coordinator.mut.RLock()
+	defer coordinator.mut.RUnlock()
+
+	return coordinator.getTimeHandler().Before(coordinator.leaderUntil)
+}
+
+// ShouldExecute returns true if the current instance holds a valid lease
+func (coordinator *fileLeaseCoordinator) ShouldExecute(_ uint64) bool {
+	return coordinator.isLeader()
+}
+
+// Close stops the lease renewal and releases the lease, if held, so another instance can take over immediately
+func (coordinator *fileLeaseCoordinator) Close() error {
+	coordinator.cancel()
+	coordinator.wg.Wait()
+
+	coordinator.mut.Lock()
+	coordinator.leaderUntil = time.Time{}
+	coordinator.mut.Unlock()
+
+	_, err := coordinator.updateLeaseFile(func(currentLease *lease) *lease {
+		if currentLease.Owner != coordinator.instanceID {
+			return nil
+		}
+
+		return &lease{}
+	})
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (coordinator *fileLeaseCoordinator) IsInterfaceNil() bool {
+	return coordinator == nil
+}
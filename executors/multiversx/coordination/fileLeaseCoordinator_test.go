@@ -0,0 +1,169 @@
+package coordination
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFileLeaseCoordinator(t *testing.T) ArgsFileLeaseCoordinator {
+	return ArgsFileLeaseCoordinator{
+		Log:           testLog,
+		LeaseFile:     filepath.Join(t.TempDir(), "lease.json"),
+		InstanceID:    "instance-1",
+		LeaseDuration: time.Minute,
+	}
+}
+
+func readLease(t *testing.T, file string) *lease {
+	buff, err := os.ReadFile(file)
+	require.Nil(t, err)
+
+	result := &lease{}
+	err = json.Unmarshal(buff, result)
+	require.Nil(t, err)
+
+	return result
+}
+
+func TestNewFileLeaseCoordinator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileLeaseCoordinator(t)
+		args.Log = nil
+
+		coordinator, err := NewFileLeaseCoordinator(args)
+		assert.Equal(t, ErrNilLogger, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("empty lease file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileLeaseCoordinator(t)
+		args.LeaseFile = ""
+
+		coordinator, err := NewFileLeaseCoordinator(args)
+		assert.Equal(t, ErrEmptyLeaseFile, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("empty instance ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileLeaseCoordinator(t)
+		args.InstanceID = " "
+
+		coordinator, err := NewFileLeaseCoordinator(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "InstanceID")
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("lease duration too small should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileLeaseCoordinator(t)
+		args.LeaseDuration = time.Millisecond
+
+		coordinator, err := NewFileLeaseCoordinator(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "LeaseDuration")
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("lease file in a missing directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileLeaseCoordinator(t)
+		args.LeaseFile = filepath.Join(t.TempDir(), "missing", "lease.json")
+
+		coordinator, err := NewFileLeaseCoordinator(args)
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("should work and acquire the lease", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileLeaseCoordinator(t)
+		coordinator, err := NewFileLeaseCoordinator(args)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(coordinator))
+		assert.True(t, coordinator.ShouldExecute(0))
+		assert.Equal(t, args.InstanceID, readLease(t, args.LeaseFile).Owner)
+
+		assert.Nil(t, coordinator.Close())
+		assert.False(t, coordinator.ShouldExecute(0))
+		assert.Empty(t, readLease(t, args.LeaseFile).Owner)
+	})
+}
+
+func TestFileLeaseCoordinator_Takeover(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standby instance should take over after the leader closes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileLeaseCoordinator(t)
+		leader, err := NewFileLeaseCoordinator(args)
+		require.Nil(t, err)
+
+		args.InstanceID = "instance-2"
+		standby, err := NewFileLeaseCoordinator(args)
+		require.Nil(t, err)
+
+		assert.True(t, leader.ShouldExecute(1))
+		assert.False(t, standby.ShouldExecute(1))
+
+		assert.Nil(t, leader.Close())
+		assert.Nil(t, standby.renewLease())
+		assert.True(t, standby.ShouldExecute(1))
+		assert.Equal(t, "instance-2", readLease(t, args.LeaseFile).Owner)
+
+		assert.Nil(t, standby.Close())
+	})
+	t.Run("standby instance should take over an expired lease", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileLeaseCoordinator(t)
+		leaseBuff, _ := json.Marshal(&lease{
+			Owner:     "stopped-instance",
+			ExpiresAt: time.Now().Add(time.Minute).UnixMilli(),
+		})
+		err := os.WriteFile(args.LeaseFile, leaseBuff, sharedFilePermissions)
+		require.Nil(t, err)
+
+		coordinator, err := NewFileLeaseCoordinator(args)
+		require.Nil(t, err)
+		assert.False(t, coordinator.ShouldExecute(1))
+
+		currentTime := time.Now().Add(time.Minute * 2)
+		coordinator.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+		assert.Nil(t, coordinator.renewLease())
+		assert.True(t, coordinator.ShouldExecute(1))
+		assert.Equal(t, args.InstanceID, readLease(t, args.LeaseFile).Owner)
+
+		assert.Nil(t, coordinator.Close())
+	})
+	t.Run("invalid lease file content should be overwritten", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileLeaseCoordinator(t)
+		err := os.WriteFile(args.LeaseFile, []byte("not a json"), sharedFilePermissions)
+		require.Nil(t, err)
+
+		coordinator, err := NewFileLeaseCoordinator(args)
+		require.Nil(t, err)
+		assert.True(t, coordinator.ShouldExecute(1))
+
+		assert.Nil(t, coordinator.Close())
+	})
+}
@@ -0,0 +1,13 @@
+//go:build windows
+
+package coordination
+
+import "os"
+
+func lockFile(_ *os.File) error {
+	return ErrFileLockNotSupported
+}
+
+func unlockFile(_ *os.File) error {
+	return ErrFileLockNotSupported
+}
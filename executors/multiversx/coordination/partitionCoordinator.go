@@ -0,0 +1,239 @@
+package coordination
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	minNumInstances          = 1
+	numSyncsPerTakeoverDelay = 3
+)
+
+// ArgsPartitionCoordinator is the DTO used in the partition coordinator constructor
+type ArgsPartitionCoordinator struct {
+	Log               logger.Logger
+	InstanceIndex     int
+	NumInstances      int
+	TakeoverDelay     time.Duration
+	TakeoverStateFile string
+}
+
+// operationTimes holds the unix milliseconds when an operation was first and last seen as pending by any instance
+type operationTimes struct {
+	FirstSeen int64 `json:"firstSeen"`
+	LastSeen  int64 `json:"lastSeen"`
+}
+
+type cachedOperation struct {
+	firstSeen  time.Time
+	lastSeen   time.Time
+	lastSynced time.Time
+}
+
+type partitionCoordinator struct {
+	log               logger.Logger
+	instanceIndex     uint64
+	numInstances      uint64
+	takeoverDelay     time.Duration
+	takeoverStateFile string
+	getTimeHandler    func() time.Time
+
+	mut       sync.Mutex
+	seen      map[uint64]*cachedOperation
+	lastPrune time.Time
+}
+
+// NewPartitionCoordinator creates a coordinator that deterministically splits the pending operations between the
+// configured instances: an operation is owned by the instance with the index equal to ID modulo the number of
+// instances. If the takeover delay is not 0 and an operation is still pending after the delay, the ownership moves
+// to the next instance, so the other instances act as hot standby for a stopped one. The time an operation was first
+// seen is kept in the takeover state file shared by all the instances, so they agree on the takeover deadline and
+// a restarted instance does not reset it
+func NewPartitionCoordinator(args ArgsPartitionCoordinator) (*partitionCoordinator, error) {
+	err := checkPartitionArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	coordinator := &partitionCoordinator{
+		log:               args.Log,
+		instanceIndex:     uint64(args.InstanceIndex),
+		numInstances:      uint64(args.NumInstances),
+		takeoverDelay:     args.TakeoverDelay,
+		takeoverStateFile: args.TakeoverStateFile,
+		getTimeHandler:    time.Now,
+		seen:              make(map[uint64]*cachedOperation),
+	}
+
+	if coordinator.takeoverDelay > 0 {
+		// fail early if the shared state file can not be used
+		err = updateSharedFile(coordinator.takeoverStateFile, func(_ []byte) ([]byte, error) {
+			return nil, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w for the takeover state file %s", err, coordinator.takeoverStateFile)
+		}
+	}
+
+	args.Log.Info("NewPartitionCoordinator",
+		"instance index", args.InstanceIndex,
+		"num instances", args.NumInstances,
+		"takeover delay", args.TakeoverDelay,
+		"takeover state file", args.TakeoverStateFile)
+
+	return coordinator, nil
+}
+
+func checkPartitionArgs(args ArgsPartitionCoordinator) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if args.NumInstances < minNumInstances {
+		return fmt.Errorf("%w for NumInstances, got %d, minimum %d", ErrInvalidValue, args.NumInstances, minNumInstances)
+	}
+	if args.InstanceIndex < 0 || args.InstanceIndex >= args.NumInstances {
+		return fmt.Errorf("%w for InstanceIndex, got %d, expected a value in the [0, %d) interval",
+			ErrInvalidValue, args.InstanceIndex, args.NumInstances)
+	}
+	if args.TakeoverDelay < 0 {
+		return fmt.Errorf("%w for TakeoverDelay, got %v", ErrInvalidValue, args.TakeoverDelay)
+	}
+	if args.TakeoverDelay > 0 && len(args.TakeoverStateFile) == 0 {
+		return ErrEmptyTakeoverStateFile
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if the current instance owns the provided operation
+func (coordinator *partitionCoordinator) ShouldExecute(id uint64) bool {
+	owner := id % coordinator.numInstances
+	if coordinator.takeoverDelay == 0 {
+		return owner == coordinator.instanceIndex
+	}
+
+	coordinator.mut.Lock()
+	defer coordinator.mut.Unlock()
+
+	now := coordinator.getTimeHandler()
+	coordinator.pruneStaleOperations(now)
+
+	firstSeen, err := coordinator.getFirstSeen(id, now)
+	if err != nil {
+		coordinator.log.Warn("partitionCoordinator: error syncing the takeover state, the operation is not taken over",
+			"ID", id, "error", err)
+		return owner == coordinator.instanceIndex
+	}
+
+	elapsed := now.Sub(firstSeen)
+	if elapsed < 0 {
+		// the instance that first saw the operation has its clock ahead
+		elapsed = 0
+	}
+
+	numTakeovers := uint64(elapsed / coordinator.takeoverDelay)
+	owner = (owner + numTakeovers) % coordinator.numInstances
+	if numTakeovers > 0 && owner == coordinator.instanceIndex {
+		coordinator.log.Debug("partitionCoordinator: taking over the operation", "ID", id, "num takeovers", numTakeovers)
+	}
+
+	return owner == coordinator.instanceIndex
+}
+
+// getFirstSeen returns the time the operation was first seen by any instance. The shared state is synced at most
+// numSyncsPerTakeoverDelay times during a takeover delay for each operation
+func (coordinator *partitionCoordinator) getFirstSeen(id uint64, now time.Time) (time.Time, error) {
+	cached, found := coordinator.seen[id]
+	if found {
+		cached.lastSeen = now
+		if now.Sub(cached.lastSynced) < coordinator.takeoverDelay/numSyncsPerTakeoverDelay {
+			return cached.firstSeen, nil
+		}
+	}
+
+	firstSeen, err := coordinator.syncOperation(id, now)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	coordinator.seen[id] = &cachedOperation{
+		firstSeen:  firstSeen,
+		lastSeen:   now,
+		lastSynced: now,
+	}
+
+	return firstSeen, nil
+}
+
+// syncOperation marks the operation as seen in the shared takeover state file and returns the time it was first seen
+// by any instance. The operations not seen by any instance in the last takeover delay interval are removed
+func (coordinator *partitionCoordinator) syncOperation(id uint64, now time.Time) (time.Time, error) {
+	var firstSeen int64
+	err := updateSharedFile(coordinator.takeoverStateFile, func(buff []byte) ([]byte, error) {
+		operations := make(map[uint64]*operationTimes)
+		if len(buff) > 0 {
+			errUnmarshal := json.Unmarshal(buff, &operations)
+			if errUnmarshal != nil {
+				coordinator.log.Warn("partitionCoordinator: invalid takeover state file content, overwriting", "error", errUnmarshal)
+				operations = make(map[uint64]*operationTimes)
+			}
+		}
+
+		nowMilli := now.UnixMilli()
+		for operationID, times := range operations {
+			if times == nil || nowMilli-times.LastSeen >= coordinator.takeoverDelay.Milliseconds() {
+				delete(operations, operationID)
+			}
+		}
+
+		times, found := operations[id]
+		if !found {
+			times = &operationTimes{
+				FirstSeen: nowMilli,
+			}
+			operations[id] = times
+		}
+		if nowMilli > times.LastSeen {
+			times.LastSeen = nowMilli
+		}
+		firstSeen = times.FirstSeen
+
+		return json.Marshal(operations)
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.UnixMilli(firstSeen), nil
+}
+
+// pruneStaleOperations removes the cached operations that were not seen in the last takeover delay interval, meaning
+// that they are no longer pending. The pruning is done at most once per takeover delay
+func (coordinator *partitionCoordinator) pruneStaleOperations(now time.Time) {
+	if now.Sub(coordinator.lastPrune) < coordinator.takeoverDelay {
+		return
+	}
+	coordinator.lastPrune = now
+
+	for id, cached := range coordinator.seen {
+		if now.Sub(cached.lastSeen) >= coordinator.takeoverDelay {
+			delete(coordinator.seen, id)
+		}
+	}
+}
+
+// Close returns nil
+func (coordinator *partitionCoordinator) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (coordinator *partitionCoordinator) IsInterfaceNil() bool {
+	return coordinator == nil
+}
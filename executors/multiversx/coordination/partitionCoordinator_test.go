@@ -0,0 +1,280 @@
+package coordination
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var testLog = logger.GetOrCreate("coordination")
+
+func createMockArgsPartitionCoordinator() ArgsPartitionCoordinator {
+	return ArgsPartitionCoordinator{
+		Log:           testLog,
+		InstanceIndex: 1,
+		NumInstances:  3,
+	}
+}
+
+func createMockArgsPartitionCoordinatorWithTakeover(t *testing.T) ArgsPartitionCoordinator {
+	args := createMockArgsPartitionCoordinator()
+	args.TakeoverDelay = time.Minute
+	args.TakeoverStateFile = filepath.Join(t.TempDir(), "executor.takeover")
+
+	return args
+}
+
+func TestNewPartitionCoordinator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinator()
+		args.Log = nil
+
+		coordinator, err := NewPartitionCoordinator(args)
+		assert.Equal(t, ErrNilLogger, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("invalid number of instances should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinator()
+		args.NumInstances = 0
+
+		coordinator, err := NewPartitionCoordinator(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "NumInstances")
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("invalid instance index should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinator()
+		args.InstanceIndex = -1
+
+		coordinator, err := NewPartitionCoordinator(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "InstanceIndex")
+		assert.True(t, check.IfNil(coordinator))
+
+		args.InstanceIndex = args.NumInstances
+		coordinator, err = NewPartitionCoordinator(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "InstanceIndex")
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("negative takeover delay should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinator()
+		args.TakeoverDelay = -time.Second
+
+		coordinator, err := NewPartitionCoordinator(args)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+		assert.Contains(t, err.Error(), "TakeoverDelay")
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("empty takeover state file with takeover enabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinatorWithTakeover(t)
+		args.TakeoverStateFile = ""
+
+		coordinator, err := NewPartitionCoordinator(args)
+		assert.Equal(t, ErrEmptyTakeoverStateFile, err)
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("unusable takeover state file should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinatorWithTakeover(t)
+		args.TakeoverStateFile = filepath.Join(t.TempDir(), "missing directory", "executor.takeover")
+
+		coordinator, err := NewPartitionCoordinator(args)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "for the takeover state file")
+		assert.True(t, check.IfNil(coordinator))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator, err := NewPartitionCoordinator(createMockArgsPartitionCoordinator())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(coordinator))
+		assert.Nil(t, coordinator.Close())
+	})
+}
+
+func TestPartitionCoordinator_ShouldExecute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without takeover each operation should be handled by exactly one instance", func(t *testing.T) {
+		t.Parallel()
+
+		numInstances := 3
+		coordinators := make([]*partitionCoordinator, 0, numInstances)
+		for i := 0; i < numInstances; i++ {
+			args := createMockArgsPartitionCoordinator()
+			args.InstanceIndex = i
+			coordinator, _ := NewPartitionCoordinator(args)
+			coordinators = append(coordinators, coordinator)
+		}
+
+		for id := uint64(0); id < 100; id++ {
+			numExecutors := 0
+			for i, coordinator := range coordinators {
+				if coordinator.ShouldExecute(id) {
+					numExecutors++
+					assert.Equal(t, id%uint64(numInstances), uint64(i))
+				}
+			}
+			assert.Equal(t, 1, numExecutors)
+		}
+		for _, coordinator := range coordinators {
+			assert.Empty(t, coordinator.seen)
+		}
+	})
+	t.Run("with takeover the ownership should move to the next instance", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator, _ := NewPartitionCoordinator(createMockArgsPartitionCoordinatorWithTakeover(t))
+
+		currentTime := time.Unix(1000, 0)
+		coordinator.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		ownedID := uint64(4)
+		previousInstanceID := uint64(3)
+		// the operations are polled more often than the takeover delay
+		advanceTime := func(delay time.Duration) {
+			for elapsed := time.Duration(0); elapsed < delay; elapsed += time.Second * 10 {
+				currentTime = currentTime.Add(time.Second * 10)
+				coordinator.ShouldExecute(ownedID)
+				coordinator.ShouldExecute(previousInstanceID)
+			}
+		}
+
+		assert.True(t, coordinator.ShouldExecute(ownedID))
+		assert.False(t, coordinator.ShouldExecute(previousInstanceID))
+
+		advanceTime(time.Minute)
+		assert.False(t, coordinator.ShouldExecute(ownedID))
+		assert.True(t, coordinator.ShouldExecute(previousInstanceID))
+
+		advanceTime(time.Minute)
+		assert.False(t, coordinator.ShouldExecute(ownedID))
+		assert.False(t, coordinator.ShouldExecute(previousInstanceID))
+
+		advanceTime(time.Minute)
+		assert.True(t, coordinator.ShouldExecute(ownedID))
+		assert.False(t, coordinator.ShouldExecute(previousInstanceID))
+	})
+	t.Run("operations not seen for a takeover delay should be pruned", func(t *testing.T) {
+		t.Parallel()
+
+		coordinator, _ := NewPartitionCoordinator(createMockArgsPartitionCoordinatorWithTakeover(t))
+
+		currentTime := time.Unix(1000, 0)
+		coordinator.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+
+		assert.True(t, coordinator.ShouldExecute(1))
+		assert.False(t, coordinator.ShouldExecute(0))
+		assert.Len(t, coordinator.seen, 2)
+
+		currentTime = currentTime.Add(time.Second * 30)
+		assert.False(t, coordinator.ShouldExecute(0))
+		assert.Len(t, coordinator.seen, 2)
+
+		// operation 1 is no longer pending so it is pruned, operation 0 was taken over by this instance
+		currentTime = currentTime.Add(time.Second * 30)
+		assert.True(t, coordinator.ShouldExecute(0))
+		assert.Len(t, coordinator.seen, 1)
+		_, found := coordinator.seen[1]
+		assert.False(t, found)
+	})
+	t.Run("instances sharing the takeover state should agree on the takeover deadline", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinatorWithTakeover(t)
+		args.InstanceIndex = 0
+		firstCoordinator, _ := NewPartitionCoordinator(args)
+		args.InstanceIndex = 1
+		secondCoordinator, _ := NewPartitionCoordinator(args)
+
+		currentTime := time.Unix(1000, 0)
+		getTime := func() time.Time {
+			return currentTime
+		}
+		firstCoordinator.getTimeHandler = getTime
+		secondCoordinator.getTimeHandler = getTime
+
+		assert.True(t, firstCoordinator.ShouldExecute(0))
+
+		// the second instance sees the operation later, but the takeover deadline is the one of the first sighting
+		currentTime = currentTime.Add(time.Second * 50)
+		assert.False(t, secondCoordinator.ShouldExecute(0))
+
+		currentTime = currentTime.Add(time.Second * 10)
+		assert.True(t, secondCoordinator.ShouldExecute(0))
+		assert.False(t, firstCoordinator.ShouldExecute(0))
+	})
+	t.Run("a restarted instance should keep the takeover deadline", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinatorWithTakeover(t)
+		coordinator, _ := NewPartitionCoordinator(args)
+
+		currentTime := time.Unix(1000, 0)
+		getTime := func() time.Time {
+			return currentTime
+		}
+		coordinator.getTimeHandler = getTime
+		assert.False(t, coordinator.ShouldExecute(0))
+		_ = coordinator.Close()
+
+		currentTime = currentTime.Add(time.Second * 50)
+		restartedCoordinator, _ := NewPartitionCoordinator(args)
+		restartedCoordinator.getTimeHandler = getTime
+		assert.False(t, restartedCoordinator.ShouldExecute(0))
+
+		currentTime = currentTime.Add(time.Second * 10)
+		assert.True(t, restartedCoordinator.ShouldExecute(0))
+	})
+	t.Run("invalid takeover state file content should be overwritten", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinatorWithTakeover(t)
+		err := os.WriteFile(args.TakeoverStateFile, []byte("not a json"), sharedFilePermissions)
+		assert.Nil(t, err)
+
+		coordinator, err := NewPartitionCoordinator(args)
+		assert.Nil(t, err)
+		assert.True(t, coordinator.ShouldExecute(1))
+
+		buff, err := os.ReadFile(args.TakeoverStateFile)
+		assert.Nil(t, err)
+		assert.Contains(t, string(buff), `"1":{"firstSeen":`)
+	})
+	t.Run("error syncing the takeover state should fall back to the static owner", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPartitionCoordinatorWithTakeover(t)
+		coordinator, _ := NewPartitionCoordinator(args)
+		coordinator.takeoverStateFile = filepath.Join(t.TempDir(), "missing directory", "executor.takeover")
+
+		assert.True(t, coordinator.ShouldExecute(1))
+		assert.False(t, coordinator.ShouldExecute(0))
+		assert.Empty(t, coordinator.seen)
+	})
+}
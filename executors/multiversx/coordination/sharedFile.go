@@ -0,0 +1,49 @@
+package coordination
+
+import (
+	"io"
+	"os"
+)
+
+const sharedFilePermissions = 0600
+
+// updateSharedFile reads the provided file while holding the file lock and writes the content returned by the handler,
+// if not nil. The file is created if it does not exist
+func updateSharedFile(path string, handler func(buff []byte) ([]byte, error)) error {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, sharedFilePermissions)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	err = lockFile(file)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = unlockFile(file)
+	}()
+
+	buff, err := io.ReadAll(file)
+	if err != nil {
+		return err
+	}
+
+	newBuff, err := handler(buff)
+	if err != nil {
+		return err
+	}
+	if newBuff == nil {
+		return nil
+	}
+
+	err = file.Truncate(0)
+	if err != nil {
+		return err
+	}
+	_, err = file.WriteAt(newBuff, 0)
+
+	return err
+}
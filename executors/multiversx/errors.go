@@ -7,6 +7,8 @@ var (
 	errNilProxy                          = errors.New("nil proxy")
 	errNilCodec                          = errors.New("nil codec")
 	errNilFilter                         = errors.New("nil filter")
+	errNilExecutionJournal               = errors.New("nil execution journal")
+	errNilExecutionCoordinator           = errors.New("nil execution coordinator")
 	errNilLogger                         = errors.New("nil logger")
 	errNilNonceTxHandler                 = errors.New("nil nonce transaction handler")
 	errNilPrivateKey                     = errors.New("nil private key")
@@ -15,4 +17,9 @@ var (
 	errNilCloseAppChannel                = errors.New("nil close application channel")
 	errTransactionFailed                 = errors.New("transaction failed")
 	errGasLimitIsLessThanAbsoluteMinimum = errors.New("provided gas limit is less than absolute minimum required")
+	errNotAllTransactionsWereSent        = errors.New("not all transactions were sent")
+	errNilStatusHandler                  = errors.New("nil status handler")
+	errDuplicatedSender                  = errors.New("duplicated sender wallet")
+	errNoActiveSenders                   = errors.New("no active sender wallet")
+	errNilDryRunReportHandler            = errors.New("nil dry run report handler")
 )
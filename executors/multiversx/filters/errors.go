@@ -7,4 +7,10 @@ var (
 	errNoItemsAllowed    = errors.New("no items allowed")
 	errUnsupportedMarker = errors.New("unsupported marker")
 	errMissingEthPrefix  = errors.New("missing Ethereum address prefix")
+	errEmptyToken        = errors.New("empty token")
+	errDuplicatedToken   = errors.New("duplicated token")
+	errInvalidAmount     = errors.New("invalid amount")
+
+	errMinAmountGreaterThanMaxAmount = errors.New("minimum amount is greater than the maximum amount")
+	errEmptyRulesFile                = errors.New("empty rules file")
 )
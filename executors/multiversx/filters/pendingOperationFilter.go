@@ -2,6 +2,7 @@ package filters
 
 import (
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -16,6 +17,7 @@ const (
 	wildcardString   = "*"
 	emptyString      = ""
 	ethAddressPrefix = "0x"
+	noRuleMatched    = "no allow rule matched"
 )
 
 var ethWildcardString = ""
@@ -26,13 +28,24 @@ func init() {
 	ethWildcardString = ethAddressWildcard.String()
 }
 
+type amountLimit struct {
+	min *big.Int
+	max *big.Int
+}
+
 type pendingOperationFilter struct {
+	log                 logger.Logger
+	codec               parsers.MultiversxCodec
 	allowedEthAddresses []string
 	deniedEthAddresses  []string
 	allowedMvxAddresses []string
 	deniedMvxAddresses  []string
 	allowedTokens       []string
 	deniedTokens        []string
+	allowedFunctions    []string
+	deniedFunctions     []string
+	maxGasLimit         uint64
+	tokensAmountLimits  map[string]*amountLimit
 }
 
 // NewPendingOperationFilter creates a new instance of type pendingOperationFilter
@@ -40,11 +53,14 @@ func NewPendingOperationFilter(cfg config.PendingOperationsFilterConfig, log log
 	if check.IfNil(log) {
 		return nil, errNilLogger
 	}
-	if len(cfg.AllowedMvxAddresses)+len(cfg.AllowedEthAddresses)+len(cfg.AllowedTokens) == 0 {
+	if len(cfg.AllowedMvxAddresses)+len(cfg.AllowedEthAddresses)+len(cfg.AllowedTokens)+len(cfg.AllowedFunctions) == 0 {
 		return nil, errNoItemsAllowed
 	}
 
-	filter := &pendingOperationFilter{}
+	filter := &pendingOperationFilter{
+		log:         log,
+		maxGasLimit: cfg.MaxGasLimit,
+	}
 	err := filter.parseConfigs(cfg)
 	if err != nil {
 		return nil, err
@@ -62,6 +78,10 @@ func NewPendingOperationFilter(cfg config.PendingOperationsFilterConfig, log log
 		"AllowedEthAddresses", strings.Join(filter.allowedEthAddresses, ", "),
 		"AllowedMvxAddresses", strings.Join(filter.allowedMvxAddresses, ", "),
 		"AllowedTokens", strings.Join(filter.allowedTokens, ", "),
+		"DeniedFunctions", strings.Join(filter.deniedFunctions, ", "),
+		"AllowedFunctions", strings.Join(filter.allowedFunctions, ", "),
+		"MaxGasLimit", filter.maxGasLimit,
+		"TokensAmountLimits", len(filter.tokensAmountLimits),
 	)
 
 	return filter, nil
@@ -102,6 +122,22 @@ func (filter *pendingOperationFilter) parseConfigs(cfg config.PendingOperationsF
 		return fmt.Errorf("%w in list AllowedTokens", err)
 	}
 
+	// function names are case-sensitive
+	filter.deniedFunctions, err = parseFunctionsList(cfg.DeniedFunctions, wildcardString)
+	if err != nil {
+		return fmt.Errorf("%w in list DeniedFunctions", err)
+	}
+
+	filter.allowedFunctions, err = parseFunctionsList(cfg.AllowedFunctions, emptyString)
+	if err != nil {
+		return fmt.Errorf("%w in list AllowedFunctions", err)
+	}
+
+	filter.tokensAmountLimits, err = parseTokensAmountLimits(cfg.TokensAmountLimits)
+	if err != nil {
+		return fmt.Errorf("%w in list TokensAmountLimits", err)
+	}
+
 	return nil
 }
 
@@ -120,6 +156,67 @@ func parseList(list []string, unsupportedMarker string) ([]string, error) {
 	return newList, nil
 }
 
+func parseFunctionsList(list []string, unsupportedMarker string) ([]string, error) {
+	newList := make([]string, 0, len(list))
+	for index, item := range list {
+		item = strings.Trim(item, "\r\n \t")
+		if item == unsupportedMarker {
+			return nil, fmt.Errorf("%w %s on item at index %d", errUnsupportedMarker, unsupportedMarker, index)
+		}
+
+		newList = append(newList, item)
+	}
+
+	return newList, nil
+}
+
+func parseTokensAmountLimits(list []config.TokenAmountLimitConfig) (map[string]*amountLimit, error) {
+	limits := make(map[string]*amountLimit, len(list))
+	for index, item := range list {
+		token := strings.ToLower(strings.Trim(item.Token, "\r\n \t"))
+		if token == emptyString {
+			return nil, fmt.Errorf("%w on item at index %d", errEmptyToken, index)
+		}
+		_, exists := limits[token]
+		if exists {
+			return nil, fmt.Errorf("%w %s on item at index %d", errDuplicatedToken, token, index)
+		}
+
+		limit := &amountLimit{}
+		var err error
+		limit.min, err = parseAmount(item.MinAmount)
+		if err != nil {
+			return nil, fmt.Errorf("%w for MinAmount on item at index %d", err, index)
+		}
+		limit.max, err = parseAmount(item.MaxAmount)
+		if err != nil {
+			return nil, fmt.Errorf("%w for MaxAmount on item at index %d", err, index)
+		}
+		if limit.min != nil && limit.max != nil && limit.min.Cmp(limit.max) > 0 {
+			return nil, fmt.Errorf("%w on item at index %d", errMinAmountGreaterThanMaxAmount, index)
+		}
+
+		limits[token] = limit
+	}
+
+	return limits, nil
+}
+
+// parseAmount returns nil if the provided string is empty, meaning that the limit is not set
+func parseAmount(value string) (*big.Int, error) {
+	value = strings.Trim(value, "\r\n \t")
+	if value == emptyString {
+		return nil, nil
+	}
+
+	amount, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("%w %s", errInvalidAmount, value)
+	}
+
+	return amount, nil
+}
+
 func (filter *pendingOperationFilter) checkLists() error {
 	err := filter.checkList(filter.allowedEthAddresses, checkEthItemValid)
 	if err != nil {
@@ -172,46 +269,133 @@ func checkEthItemValid(item string) error {
 	return nil
 }
 
-// ShouldExecute returns true if the To, From or token are not denied and allowed
+// ShouldExecute returns true if the To, From, token or function are not denied, the amount and the gas limit are
+// within the configured limits and at least one of them is allowed. The decision and the matching rule are logged
 func (filter *pendingOperationFilter) ShouldExecute(callData parsers.ProxySCCompleteCallData) bool {
+	shouldExecute, rule := filter.evaluate(callData)
+
+	decision := "denied"
+	if shouldExecute {
+		decision = "allowed"
+	}
+	filter.log.Debug("pendingOperationFilter.ShouldExecute",
+		"decision", decision,
+		"rule", rule,
+		"call data", callData.String())
+
+	return shouldExecute
+}
+
+func (filter *pendingOperationFilter) evaluate(callData parsers.ProxySCCompleteCallData) (bool, string) {
 	if check.IfNil(callData.To) {
-		return false
+		return false, "missing MvX address"
 	}
 
 	toAddress, err := callData.To.AddressAsBech32String()
 	if err != nil {
-		return false
+		return false, "invalid MvX address"
 	}
 
-	isSpecificallyDenied := filter.stringExistsInList(callData.From.String(), filter.deniedEthAddresses, ethWildcardString) ||
-		filter.stringExistsInList(toAddress, filter.deniedMvxAddresses, wildcardString) ||
-		filter.stringExistsInList(callData.Token, filter.deniedTokens, wildcardString)
-	if isSpecificallyDenied {
-		return false
+	// call data that can not be decoded is treated as a call without function and gas limit
+	scCallData, err := filter.codec.ExtractCallDataFromRawCallData(callData.RawCallData)
+	if err != nil {
+		filter.log.Trace("pendingOperationFilter.evaluate: can not decode the call data", "error", err)
+	}
+
+	item, found := filter.findInList(callData.From.String(), filter.deniedEthAddresses, ethWildcardString)
+	if found {
+		return false, "DeniedEthAddresses: " + item
+	}
+	item, found = filter.findInList(toAddress, filter.deniedMvxAddresses, wildcardString)
+	if found {
+		return false, "DeniedMvxAddresses: " + item
+	}
+	item, found = filter.findInList(callData.Token, filter.deniedTokens, wildcardString)
+	if found {
+		return false, "DeniedTokens: " + item
+	}
+	item, found = findFunctionInList(scCallData.Function, filter.deniedFunctions)
+	if found {
+		return false, "DeniedFunctions: " + item
 	}
 
-	isAllowed := filter.stringExistsInList(callData.From.String(), filter.allowedEthAddresses, ethWildcardString) ||
-		filter.stringExistsInList(toAddress, filter.allowedMvxAddresses, wildcardString) ||
-		filter.stringExistsInList(callData.Token, filter.allowedTokens, wildcardString)
+	if filter.maxGasLimit > 0 && scCallData.GasLimit > filter.maxGasLimit {
+		return false, fmt.Sprintf("MaxGasLimit: %d", filter.maxGasLimit)
+	}
 
-	return isAllowed
+	rule, withinLimits := filter.checkAmount(callData.Token, callData.Amount)
+	if !withinLimits {
+		return false, rule
+	}
+
+	item, found = filter.findInList(callData.From.String(), filter.allowedEthAddresses, ethWildcardString)
+	if found {
+		return true, "AllowedEthAddresses: " + item
+	}
+	item, found = filter.findInList(toAddress, filter.allowedMvxAddresses, wildcardString)
+	if found {
+		return true, "AllowedMvxAddresses: " + item
+	}
+	item, found = filter.findInList(callData.Token, filter.allowedTokens, wildcardString)
+	if found {
+		return true, "AllowedTokens: " + item
+	}
+	item, found = findFunctionInList(scCallData.Function, filter.allowedFunctions)
+	if found {
+		return true, "AllowedFunctions: " + item
+	}
+
+	return false, noRuleMatched
 }
 
-func (filter *pendingOperationFilter) stringExistsInList(needle string, haystack []string, wildcardMarker string) bool {
+// checkAmount uses the limit defined for the token, if it exists, otherwise the wildcard limit
+func (filter *pendingOperationFilter) checkAmount(token string, amount *big.Int) (string, bool) {
+	limit, found := filter.tokensAmountLimits[strings.ToLower(token)]
+	if !found {
+		limit, found = filter.tokensAmountLimits[wildcardString]
+	}
+	if !found {
+		return "", true
+	}
+	if amount == nil {
+		amount = big.NewInt(0)
+	}
+
+	if limit.min != nil && amount.Cmp(limit.min) < 0 {
+		return fmt.Sprintf("TokensAmountLimits: %s below the minimum amount of %s", token, limit.min.String()), false
+	}
+	if limit.max != nil && amount.Cmp(limit.max) > 0 {
+		return fmt.Sprintf("TokensAmountLimits: %s above the maximum amount of %s", token, limit.max.String()), false
+	}
+
+	return "", true
+}
+
+func (filter *pendingOperationFilter) findInList(needle string, haystack []string, wildcardMarker string) (string, bool) {
 	needle = strings.ToLower(needle)
 	wildcardMarker = strings.ToLower(wildcardMarker)
 
 	for _, item := range haystack {
 		if item == wildcardMarker {
-			return true
+			return wildcardString, true
 		}
 
 		if item == needle {
-			return true
+			return item, true
+		}
+	}
+
+	return "", false
+}
+
+func findFunctionInList(function string, haystack []string) (string, bool) {
+	for _, item := range haystack {
+		if item == wildcardString || item == function {
+			return item, true
 		}
 	}
 
-	return false
+	return "", false
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,7 +1,9 @@
 package filters
 
 import (
+	"encoding/binary"
 	"encoding/hex"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -33,6 +35,18 @@ func createTestConfig() config.PendingOperationsFilterConfig {
 	}
 }
 
+func createTestRawCallData(function string, gasLimit uint64) []byte {
+	callData := make([]byte, 0)
+	callData = binary.BigEndian.AppendUint32(callData, uint32(len(function)))
+	callData = append(callData, function...)
+	callData = binary.BigEndian.AppendUint64(callData, gasLimit)
+
+	buff := []byte{1}
+	buff = binary.BigEndian.AppendUint32(buff, uint32(len(callData)))
+
+	return append(buff, callData...)
+}
+
 func TestNewPendingOperationFilter(t *testing.T) {
 	t.Parallel()
 
@@ -160,6 +174,71 @@ func TestNewPendingOperationFilter(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "on item at index 0 in list DeniedMvxAddresses")
 	})
+	t.Run("denied functions list contains wildcard should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.DeniedFunctions = []string{"*"}
+		filter, err := NewPendingOperationFilter(cfg, testLog)
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, errUnsupportedMarker)
+		assert.Contains(t, err.Error(), "in list DeniedFunctions")
+	})
+	t.Run("allowed functions list contains empty string should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.AllowedFunctions = []string{" "}
+		filter, err := NewPendingOperationFilter(cfg, testLog)
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, errUnsupportedMarker)
+		assert.Contains(t, err.Error(), "in list AllowedFunctions")
+	})
+	t.Run("tokens amount limits with empty token should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.TokensAmountLimits = []config.TokenAmountLimitConfig{{Token: ""}}
+		filter, err := NewPendingOperationFilter(cfg, testLog)
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, errEmptyToken)
+		assert.Contains(t, err.Error(), "on item at index 0 in list TokensAmountLimits")
+	})
+	t.Run("tokens amount limits with duplicated token should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.TokensAmountLimits = []config.TokenAmountLimitConfig{{Token: "tkn"}, {Token: "TKN"}}
+		filter, err := NewPendingOperationFilter(cfg, testLog)
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, errDuplicatedToken)
+		assert.Contains(t, err.Error(), "on item at index 1 in list TokensAmountLimits")
+	})
+	t.Run("tokens amount limits with invalid amounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.TokensAmountLimits = []config.TokenAmountLimitConfig{{Token: "tkn", MinAmount: "1a"}}
+		filter, err := NewPendingOperationFilter(cfg, testLog)
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, errInvalidAmount)
+		assert.Contains(t, err.Error(), "for MinAmount on item at index 0")
+
+		cfg.TokensAmountLimits = []config.TokenAmountLimitConfig{{Token: "tkn", MaxAmount: "-1"}}
+		filter, err = NewPendingOperationFilter(cfg, testLog)
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, errInvalidAmount)
+		assert.Contains(t, err.Error(), "for MaxAmount on item at index 0")
+	})
+	t.Run("tokens amount limits with minimum greater than maximum should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfig()
+		cfg.TokensAmountLimits = []config.TokenAmountLimitConfig{{Token: "tkn", MinAmount: "11", MaxAmount: "10"}}
+		filter, err := NewPendingOperationFilter(cfg, testLog)
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, errMinAmountGreaterThanMaxAmount)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -353,4 +432,173 @@ func TestPendingOperationFilter_ShouldExecute(t *testing.T) {
 			assert.False(t, filter.ShouldExecute(callData))
 		})
 	})
+	t.Run("functions", func(t *testing.T) {
+		t.Parallel()
+
+		callData := parsers.ProxySCCompleteCallData{
+			From:        common.BytesToAddress(ethTestAddress1Bytes),
+			RawCallData: createTestRawCallData("deposit", 5000000),
+		}
+		callData.To, _ = data.NewAddressFromBech32String(mvxTestAddress1)
+
+		t.Run("is denied should return false", func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig()
+			cfg.DeniedFunctions = []string{"deposit"}
+
+			filter, _ := NewPendingOperationFilter(cfg, testLog)
+			assert.False(t, filter.ShouldExecute(callData))
+		})
+		t.Run("matching is case-sensitive", func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig()
+			cfg.DeniedFunctions = []string{"Deposit"}
+
+			filter, _ := NewPendingOperationFilter(cfg, testLog)
+			assert.True(t, filter.ShouldExecute(callData))
+		})
+		t.Run("is not denied but allowed should return true", func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig()
+			cfg.AllowedEthAddresses = nil
+			cfg.AllowedMvxAddresses = nil
+			cfg.AllowedTokens = nil
+			cfg.AllowedFunctions = []string{"deposit"}
+
+			filter, _ := NewPendingOperationFilter(cfg, testLog)
+			assert.True(t, filter.ShouldExecute(callData))
+		})
+		t.Run("is not denied but not allowed should return false", func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig()
+			cfg.AllowedEthAddresses = nil
+			cfg.AllowedMvxAddresses = nil
+			cfg.AllowedTokens = nil
+			cfg.AllowedFunctions = []string{"withdraw"}
+
+			filter, _ := NewPendingOperationFilter(cfg, testLog)
+			assert.False(t, filter.ShouldExecute(callData))
+		})
+		t.Run("call data without function should not match the function lists", func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig()
+			cfg.DeniedFunctions = []string{"deposit"}
+
+			filter, _ := NewPendingOperationFilter(cfg, testLog)
+			callDataWithoutFunction := callData
+			callDataWithoutFunction.RawCallData = []byte{0}
+			assert.True(t, filter.ShouldExecute(callDataWithoutFunction))
+		})
+	})
+	t.Run("max gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		callData := parsers.ProxySCCompleteCallData{
+			From:        common.BytesToAddress(ethTestAddress1Bytes),
+			RawCallData: createTestRawCallData("deposit", 5000000),
+		}
+		callData.To, _ = data.NewAddressFromBech32String(mvxTestAddress1)
+
+		cfg := createTestConfig()
+		cfg.MaxGasLimit = 4999999
+		filter, _ := NewPendingOperationFilter(cfg, testLog)
+		assert.False(t, filter.ShouldExecute(callData))
+
+		cfg.MaxGasLimit = 5000000
+		filter, _ = NewPendingOperationFilter(cfg, testLog)
+		assert.True(t, filter.ShouldExecute(callData))
+	})
+	t.Run("tokens amount limits", func(t *testing.T) {
+		t.Parallel()
+
+		callData := parsers.ProxySCCompleteCallData{
+			From:   common.BytesToAddress(ethTestAddress1Bytes),
+			Token:  "TKN1",
+			Amount: big.NewInt(1000),
+		}
+		callData.To, _ = data.NewAddressFromBech32String(mvxTestAddress1)
+
+		t.Run("below the minimum amount should return false", func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig()
+			cfg.TokensAmountLimits = []config.TokenAmountLimitConfig{{Token: "tkn1", MinAmount: "1001"}}
+
+			filter, _ := NewPendingOperationFilter(cfg, testLog)
+			assert.False(t, filter.ShouldExecute(callData))
+		})
+		t.Run("above the maximum amount should return false", func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig()
+			cfg.TokensAmountLimits = []config.TokenAmountLimitConfig{{Token: "tkn1", MaxAmount: "999"}}
+
+			filter, _ := NewPendingOperationFilter(cfg, testLog)
+			assert.False(t, filter.ShouldExecute(callData))
+		})
+		t.Run("within the limits should return true", func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig()
+			cfg.TokensAmountLimits = []config.TokenAmountLimitConfig{{Token: "tkn1", MinAmount: "1000", MaxAmount: "1000"}}
+
+			filter, _ := NewPendingOperationFilter(cfg, testLog)
+			assert.True(t, filter.ShouldExecute(callData))
+		})
+		t.Run("specific token limit should take precedence over the wildcard one", func(t *testing.T) {
+			t.Parallel()
+
+			cfg := createTestConfig()
+			cfg.TokensAmountLimits = []config.TokenAmountLimitConfig{
+				{Token: "*", MaxAmount: "10"},
+				{Token: "tkn1", MaxAmount: "1000"},
+			}
+
+			filter, _ := NewPendingOperationFilter(cfg, testLog)
+			assert.True(t, filter.ShouldExecute(callData))
+
+			callDataOtherToken := callData
+			callDataOtherToken.Token = "tkn2"
+			assert.False(t, filter.ShouldExecute(callDataOtherToken))
+		})
+	})
+}
+
+func TestPendingOperationFilter_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	callData := parsers.ProxySCCompleteCallData{
+		From:        common.BytesToAddress(ethTestAddress1Bytes),
+		Token:       "tkn1",
+		RawCallData: createTestRawCallData("deposit", 5000000),
+	}
+	callData.To, _ = data.NewAddressFromBech32String(mvxTestAddress1)
+
+	cfg := createTestConfig()
+	cfg.AllowedEthAddresses = nil
+	cfg.DeniedMvxAddresses = []string{mvxTestAddress2}
+	filter, _ := NewPendingOperationFilter(cfg, testLog)
+
+	shouldExecute, rule := filter.evaluate(callData)
+	assert.True(t, shouldExecute)
+	assert.Equal(t, "AllowedMvxAddresses: *", rule)
+
+	callData.To, _ = data.NewAddressFromBech32String(mvxTestAddress2)
+	shouldExecute, rule = filter.evaluate(callData)
+	assert.False(t, shouldExecute)
+	assert.Equal(t, "DeniedMvxAddresses: "+mvxTestAddress2, rule)
+
+	cfg = createTestConfig()
+	cfg.AllowedEthAddresses = nil
+	cfg.AllowedMvxAddresses = nil
+	cfg.AllowedTokens = []string{"tkn2"}
+	filter, _ = NewPendingOperationFilter(cfg, testLog)
+	shouldExecute, rule = filter.evaluate(callData)
+	assert.False(t, shouldExecute)
+	assert.Equal(t, noRuleMatched, rule)
 }
@@ -0,0 +1,151 @@
+package filters
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsReloadableFilter is the DTO used in the NewReloadableFilter constructor function
+type ArgsReloadableFilter struct {
+	Log           logger.Logger
+	RulesFile     string
+	CheckInterval time.Duration
+}
+
+type reloadableFilter struct {
+	mut          sync.RWMutex
+	log          logger.Logger
+	rulesFile    string
+	filter       *pendingOperationFilter
+	lastModified time.Time
+	cancel       func()
+}
+
+// NewReloadableFilter creates a pending operations filter that loads its rules from the provided file. The rules are
+// reloaded each time the file is changed (if the check interval is not 0) or when Reload is called. If the new rules
+// are not valid, the previous ones are kept
+func NewReloadableFilter(args ArgsReloadableFilter) (*reloadableFilter, error) {
+	if check.IfNil(args.Log) {
+		return nil, errNilLogger
+	}
+	if len(args.RulesFile) == 0 {
+		return nil, errEmptyRulesFile
+	}
+
+	instance := &reloadableFilter{
+		log:       args.Log,
+		rulesFile: args.RulesFile,
+	}
+
+	err := instance.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	instance.cancel = cancel
+	if args.CheckInterval > 0 {
+		go instance.watchRulesFile(ctx, args.CheckInterval)
+	}
+
+	return instance, nil
+}
+
+// Reload will load the rules from the file and replace the current filter. If the new rules are not valid, the
+// previous ones are kept and the error is returned
+func (rf *reloadableFilter) Reload() error {
+	info, err := os.Stat(rf.rulesFile)
+	if err != nil {
+		return err
+	}
+
+	rf.mut.Lock()
+	rf.lastModified = info.ModTime()
+	rf.mut.Unlock()
+
+	cfg := config.PendingOperationsFilterConfig{}
+	err = chainCore.LoadTomlFile(&cfg, rf.rulesFile)
+	if err != nil {
+		return fmt.Errorf("%w while loading the filter rules from %s", err, rf.rulesFile)
+	}
+
+	filter, err := NewPendingOperationFilter(cfg, rf.log)
+	if err != nil {
+		return fmt.Errorf("%w while parsing the filter rules from %s", err, rf.rulesFile)
+	}
+
+	rf.mut.Lock()
+	rf.filter = filter
+	rf.mut.Unlock()
+
+	rf.log.Info("reloadableFilter: filter rules loaded", "file", rf.rulesFile)
+
+	return nil
+}
+
+func (rf *reloadableFilter) watchRulesFile(ctx context.Context, checkInterval time.Duration) {
+	timer := time.NewTimer(checkInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(checkInterval)
+
+		select {
+		case <-timer.C:
+			rf.reloadIfChanged()
+		case <-ctx.Done():
+			rf.log.Debug("closing reloadableFilter.watchRulesFile go routine")
+			return
+		}
+	}
+}
+
+func (rf *reloadableFilter) reloadIfChanged() {
+	info, err := os.Stat(rf.rulesFile)
+	if err != nil {
+		rf.log.Error("reloadableFilter: can not check the rules file", "file", rf.rulesFile, "error", err)
+		return
+	}
+
+	rf.mut.RLock()
+	changed := !info.ModTime().Equal(rf.lastModified)
+	rf.mut.RUnlock()
+	if !changed {
+		return
+	}
+
+	err = rf.Reload()
+	if err != nil {
+		rf.log.Error("reloadableFilter: can not reload the filter rules, keeping the previous ones", "error", err)
+	}
+}
+
+// ShouldExecute returns true if the current rules allow the execution of the provided call data
+func (rf *reloadableFilter) ShouldExecute(callData parsers.ProxySCCompleteCallData) bool {
+	rf.mut.RLock()
+	filter := rf.filter
+	rf.mut.RUnlock()
+
+	return filter.ShouldExecute(callData)
+}
+
+// Close stops watching the rules file
+func (rf *reloadableFilter) Close() error {
+	rf.cancel()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rf *reloadableFilter) IsInterfaceNil() bool {
+	return rf == nil
+}
@@ -0,0 +1,176 @@
+package filters
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const allowAllRules = `
+AllowedEthAddresses = ["*"]
+AllowedMvxAddresses = ["*"]
+AllowedTokens = ["*"]
+`
+
+const denyTokenRules = `
+AllowedEthAddresses = ["*"]
+AllowedMvxAddresses = ["*"]
+AllowedTokens = ["*"]
+DeniedTokens = ["tkn1"]
+`
+
+const invalidRules = `
+DeniedTokens = ["*"]
+`
+
+func writeRulesFile(t *testing.T, file string, content string) {
+	err := os.WriteFile(file, []byte(content), 0644)
+	require.Nil(t, err)
+}
+
+func createReloadableFilterTestCallData() parsers.ProxySCCompleteCallData {
+	callData := parsers.ProxySCCompleteCallData{
+		From:  common.BytesToAddress(ethTestAddress1Bytes),
+		Token: "tkn1",
+	}
+	callData.To, _ = data.NewAddressFromBech32String(mvxTestAddress1)
+
+	return callData
+}
+
+func TestNewReloadableFilter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := NewReloadableFilter(ArgsReloadableFilter{
+			RulesFile: "rules.toml",
+		})
+		assert.Nil(t, filter)
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("empty rules file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := NewReloadableFilter(ArgsReloadableFilter{
+			Log: testLog,
+		})
+		assert.Nil(t, filter)
+		assert.Equal(t, errEmptyRulesFile, err)
+	})
+	t.Run("missing rules file should error", func(t *testing.T) {
+		t.Parallel()
+
+		filter, err := NewReloadableFilter(ArgsReloadableFilter{
+			Log:       testLog,
+			RulesFile: filepath.Join(t.TempDir(), "missing.toml"),
+		})
+		assert.Nil(t, filter)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid rules should error", func(t *testing.T) {
+		t.Parallel()
+
+		rulesFile := filepath.Join(t.TempDir(), "rules.toml")
+		writeRulesFile(t, rulesFile, invalidRules)
+
+		filter, err := NewReloadableFilter(ArgsReloadableFilter{
+			Log:       testLog,
+			RulesFile: rulesFile,
+		})
+		assert.Nil(t, filter)
+		assert.ErrorIs(t, err, errNoItemsAllowed)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rulesFile := filepath.Join(t.TempDir(), "rules.toml")
+		writeRulesFile(t, rulesFile, allowAllRules)
+
+		filter, err := NewReloadableFilter(ArgsReloadableFilter{
+			Log:       testLog,
+			RulesFile: rulesFile,
+		})
+		assert.NotNil(t, filter)
+		assert.Nil(t, err)
+		assert.True(t, filter.ShouldExecute(createReloadableFilterTestCallData()))
+
+		assert.Nil(t, filter.Close())
+	})
+}
+
+func TestReloadableFilter_IsInterfaceNil(t *testing.T) {
+	t.Parallel()
+
+	var instance *reloadableFilter
+	assert.True(t, instance.IsInterfaceNil())
+
+	instance = &reloadableFilter{}
+	assert.False(t, instance.IsInterfaceNil())
+}
+
+func TestReloadableFilter_Reload(t *testing.T) {
+	t.Parallel()
+
+	rulesFile := filepath.Join(t.TempDir(), "rules.toml")
+	writeRulesFile(t, rulesFile, allowAllRules)
+
+	filter, _ := NewReloadableFilter(ArgsReloadableFilter{
+		Log:       testLog,
+		RulesFile: rulesFile,
+	})
+	defer func() {
+		_ = filter.Close()
+	}()
+
+	callData := createReloadableFilterTestCallData()
+	assert.True(t, filter.ShouldExecute(callData))
+
+	writeRulesFile(t, rulesFile, denyTokenRules)
+	err := filter.Reload()
+	assert.Nil(t, err)
+	assert.False(t, filter.ShouldExecute(callData))
+
+	// invalid rules are rejected, the previous ones being kept
+	writeRulesFile(t, rulesFile, invalidRules)
+	err = filter.Reload()
+	assert.ErrorIs(t, err, errNoItemsAllowed)
+	assert.Contains(t, err.Error(), "while parsing the filter rules")
+	assert.False(t, filter.ShouldExecute(callData))
+}
+
+func TestReloadableFilter_ReloadOnFileChange(t *testing.T) {
+	t.Parallel()
+
+	rulesFile := filepath.Join(t.TempDir(), "rules.toml")
+	writeRulesFile(t, rulesFile, allowAllRules)
+
+	filter, _ := NewReloadableFilter(ArgsReloadableFilter{
+		Log:           testLog,
+		RulesFile:     rulesFile,
+		CheckInterval: time.Millisecond * 10,
+	})
+	defer func() {
+		_ = filter.Close()
+	}()
+
+	callData := createReloadableFilterTestCallData()
+	assert.True(t, filter.ShouldExecute(callData))
+
+	writeRulesFile(t, rulesFile, denyTokenRules)
+	// make sure the modification time differs even on file systems with a coarse time resolution
+	err := os.Chtimes(rulesFile, time.Now().Add(time.Minute), time.Now().Add(time.Minute))
+	require.Nil(t, err)
+
+	assert.Eventually(t, func() bool {
+		return !filter.ShouldExecute(callData)
+	}, time.Second*5, time.Millisecond*10)
+}
@@ -3,6 +3,7 @@ package multiversx
 import (
 	"context"
 
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/parsers"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -22,13 +23,14 @@ type Proxy interface {
 	GetESDTTokenData(ctx context.Context, address core.AddressHandler, tokenIdentifier string, queryOptions api.AccountQueryOptions) (*data.ESDTFungibleTokenData, error)
 	GetTransactionInfoWithResults(ctx context.Context, hash string) (*data.TransactionInfo, error)
 	ProcessTransactionStatus(ctx context.Context, hexTxHash string) (transaction.TxStatus, error)
+	RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
 	IsInterfaceNil() bool
 }
 
 // NonceTransactionsHandler represents the interface able to handle the current nonce and the transactions resend mechanism
 type NonceTransactionsHandler interface {
 	ApplyNonceAndGasPrice(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error
-	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
+	DropTransactions(address core.AddressHandler) error
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -45,3 +47,26 @@ type Codec interface {
 	ExtractGasLimitFromRawCallData(buff []byte) (uint64, error)
 	IsInterfaceNil() bool
 }
+
+// ExecutionJournal defines the operations supported by the component that keeps the execution history of the
+// pending operations and applies the retry policy
+type ExecutionJournal interface {
+	ShouldExecute(id uint64) bool
+	RecordAttempt(id uint64, txHash string, checkResults bool)
+	RecordResult(id uint64, err error)
+	IsInterfaceNil() bool
+}
+
+// ExecutionCoordinator defines the operations supported by the component that decides which of the running executor
+// instances handles a pending operation
+type ExecutionCoordinator interface {
+	ShouldExecute(id uint64) bool
+	IsInterfaceNil() bool
+}
+
+// DryRunReportHandler defines the operations supported by the component that receives the reports produced by the
+// SC calls executor in dry run mode
+type DryRunReportHandler interface {
+	HandleDryRunReport(report *bridgeCore.ScCallDryRunReport)
+	IsInterfaceNil() bool
+}
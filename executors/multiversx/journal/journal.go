@@ -0,0 +1,444 @@
+package journal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	journalIndexStorerKey   = "sc calls execution journal index"
+	journalEntryKeyPrefix   = "sc calls execution journal entry "
+	minFinalizedEntries     = 1
+	minUnfinalizedEntries   = 1
+	minAttempts             = 1
+	minBackoff              = time.Second
+	maxBackoffShiftExponent = 32
+)
+
+// Status defines the status of a pending operation in the journal
+type Status string
+
+const (
+	// StatusPending is the status of an operation that was sent and waits for its result
+	StatusPending Status = "pending"
+	// StatusSent is the status of an operation that was sent while its result is not checked
+	StatusSent Status = "sent"
+	// StatusExecuted is the status of an operation that was successfully executed
+	StatusExecuted Status = "executed"
+	// StatusFailed is the status of an operation that failed and will be retried
+	StatusFailed Status = "failed"
+	// StatusQuarantined is the status of an operation that failed too many times and will not be retried
+	// until an operator releases it
+	StatusQuarantined Status = "quarantined"
+)
+
+// Entry holds the execution history of a pending operation
+type Entry struct {
+	ID                 uint64   `json:"id"`
+	Attempts           uint32   `json:"attempts"`
+	TxHashes           []string `json:"txHashes"`
+	Status             Status   `json:"status"`
+	LastError          string   `json:"lastError,omitempty"`
+	LastAttempt        int64    `json:"lastAttempt"`
+	NextAttemptAllowed int64    `json:"nextAttemptAllowed,omitempty"`
+}
+
+// ArgsExecutionJournal is the DTO used in the execution journal constructor
+type ArgsExecutionJournal struct {
+	Log                   logger.Logger
+	Storer                core.Storer
+	MaxFinalizedEntries   int
+	MaxUnfinalizedEntries int
+	MaxAttempts           uint32
+	InitialBackoff        time.Duration
+	MaxBackoff            time.Duration
+}
+
+type executionJournal struct {
+	log                   logger.Logger
+	storer                core.Storer
+	maxFinalizedEntries   int
+	maxUnfinalizedEntries int
+	maxAttempts           uint32
+	initialBackoff        time.Duration
+	maxBackoff            time.Duration
+	getTimeHandler        func() time.Time
+
+	mut          sync.RWMutex
+	entries      map[uint64]*Entry
+	isIndexDirty bool
+}
+
+// NewExecutionJournal creates a new execution journal instance. The journal keeps an entry for each pending operation
+// ID and applies the retry policy: failed operations are retried with an exponential backoff and are quarantined
+// after reaching the maximum number of attempts. Each entry is persisted under its own key
+func NewExecutionJournal(args ArgsExecutionJournal) (*executionJournal, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	journal := &executionJournal{
+		log:                   args.Log,
+		storer:                args.Storer,
+		maxFinalizedEntries:   args.MaxFinalizedEntries,
+		maxUnfinalizedEntries: args.MaxUnfinalizedEntries,
+		maxAttempts:           args.MaxAttempts,
+		initialBackoff:        args.InitialBackoff,
+		maxBackoff:            args.MaxBackoff,
+		getTimeHandler:        time.Now,
+		entries:               make(map[uint64]*Entry),
+	}
+	journal.tryLoadPersistedEntries()
+
+	return journal, nil
+}
+
+func checkArgs(args ArgsExecutionJournal) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if args.MaxFinalizedEntries < minFinalizedEntries {
+		return fmt.Errorf("%w for MaxFinalizedEntries, minimum %d, got %d", ErrInvalidValue, minFinalizedEntries, args.MaxFinalizedEntries)
+	}
+	if args.MaxUnfinalizedEntries < minUnfinalizedEntries {
+		return fmt.Errorf("%w for MaxUnfinalizedEntries, minimum %d, got %d", ErrInvalidValue, minUnfinalizedEntries, args.MaxUnfinalizedEntries)
+	}
+	if args.MaxAttempts < minAttempts {
+		return fmt.Errorf("%w for MaxAttempts, minimum %d, got %d", ErrInvalidValue, minAttempts, args.MaxAttempts)
+	}
+	if args.InitialBackoff < minBackoff {
+		return fmt.Errorf("%w for InitialBackoff, minimum %v, got %v", ErrInvalidValue, minBackoff, args.InitialBackoff)
+	}
+	if args.MaxBackoff < args.InitialBackoff {
+		return fmt.Errorf("%w for MaxBackoff, minimum %v, got %v", ErrInvalidValue, args.InitialBackoff, args.MaxBackoff)
+	}
+
+	return nil
+}
+
+// ShouldExecute returns true if the operation can be executed now: it was never attempted or it previously failed
+// and the backoff period elapsed
+func (journal *executionJournal) ShouldExecute(id uint64) bool {
+	journal.mut.RLock()
+	defer journal.mut.RUnlock()
+
+	entry, found := journal.entries[id]
+	if !found {
+		return true
+	}
+
+	switch entry.Status {
+	case StatusExecuted, StatusQuarantined:
+		return false
+	default:
+		return journal.getTimeHandler().Unix() >= entry.NextAttemptAllowed
+	}
+}
+
+// RecordAttempt will record a new execution attempt for the provided operation. The transaction hash can be empty
+// if the transaction could not be sent
+func (journal *executionJournal) RecordAttempt(id uint64, txHash string, checkResults bool) {
+	journal.mut.Lock()
+	defer journal.mut.Unlock()
+
+	now := journal.getTimeHandler()
+	entry, found := journal.entries[id]
+	if !found {
+		entry = &Entry{
+			ID:       id,
+			TxHashes: make([]string, 0, 1),
+		}
+		journal.entries[id] = entry
+		journal.isIndexDirty = true
+	}
+
+	entry.Attempts++
+	entry.LastAttempt = now.Unix()
+	entry.LastError = ""
+	entry.Status = StatusPending
+	if len(txHash) > 0 {
+		entry.TxHashes = append(entry.TxHashes, txHash)
+	}
+	if !checkResults {
+		// we can not tell the outcome so the operation is not resent until the backoff period elapses
+		entry.Status = StatusSent
+		entry.NextAttemptAllowed = now.Add(journal.initialBackoff).Unix()
+	}
+
+	journal.pruneEntries()
+	journal.persistEntry(entry)
+}
+
+// RecordResult will record the result of the last execution attempt of the provided operation
+func (journal *executionJournal) RecordResult(id uint64, err error) {
+	journal.mut.Lock()
+	defer journal.mut.Unlock()
+
+	entry, found := journal.entries[id]
+	if !found {
+		journal.log.Warn("executionJournal.RecordResult: result for an operation without attempts", "ID", id)
+		return
+	}
+
+	if err == nil {
+		entry.Status = StatusExecuted
+		entry.LastError = ""
+		entry.NextAttemptAllowed = 0
+		journal.pruneEntries()
+		journal.persistEntry(entry)
+		return
+	}
+
+	entry.LastError = err.Error()
+	if entry.Attempts >= journal.maxAttempts {
+		entry.Status = StatusQuarantined
+		entry.NextAttemptAllowed = 0
+		journal.log.Error("executionJournal: operation quarantined after reaching the maximum number of attempts",
+			"ID", id, "attempts", entry.Attempts, "tx hashes", entry.TxHashes, "last error", entry.LastError)
+		journal.pruneEntries()
+		journal.persistEntry(entry)
+		return
+	}
+
+	backoff := journal.computeBackoff(entry.Attempts)
+	entry.Status = StatusFailed
+	entry.NextAttemptAllowed = journal.getTimeHandler().Add(backoff).Unix()
+	journal.log.Warn("executionJournal: operation failed, will retry", "ID", id, "attempts", entry.Attempts,
+		"retry in", backoff, "error", entry.LastError)
+	journal.pruneEntries()
+	journal.persistEntry(entry)
+}
+
+// computeBackoff returns the initial backoff doubled for each failed attempt, capped by the maximum backoff
+func (journal *executionJournal) computeBackoff(attempts uint32) time.Duration {
+	exponent := attempts - 1
+	if exponent > maxBackoffShiftExponent {
+		return journal.maxBackoff
+	}
+
+	backoff := journal.initialBackoff * time.Duration(uint64(1)<<exponent)
+	if backoff > journal.maxBackoff || backoff < journal.initialBackoff {
+		return journal.maxBackoff
+	}
+
+	return backoff
+}
+
+// pruneEntries removes the oldest entries of each status so the journal does not grow indefinitely. The executed
+// and sent (if the results are not checked) operations share the finalized entries limit while each of the pending,
+// failed and quarantined statuses is bounded by the unfinalized entries limit
+func (journal *executionJournal) pruneEntries() {
+	idsByStatus := make(map[Status][]uint64)
+	for id, entry := range journal.entries {
+		status := entry.Status
+		if status == StatusSent {
+			status = StatusExecuted
+		}
+		idsByStatus[status] = append(idsByStatus[status], id)
+	}
+
+	for status, ids := range idsByStatus {
+		maxEntries := journal.maxUnfinalizedEntries
+		if status == StatusExecuted {
+			maxEntries = journal.maxFinalizedEntries
+		}
+		if len(ids) <= maxEntries {
+			continue
+		}
+
+		sort.Slice(ids, func(i, j int) bool {
+			return ids[i] < ids[j]
+		})
+		numToRemove := len(ids) - maxEntries
+		if status != StatusExecuted {
+			journal.log.Warn("executionJournal: too many entries, dropping the oldest ones",
+				"status", status, "num dropped", numToRemove, "IDs", ids[:numToRemove])
+		}
+		for _, id := range ids[:numToRemove] {
+			journal.removeEntry(id)
+		}
+	}
+}
+
+func (journal *executionJournal) removeEntry(id uint64) {
+	delete(journal.entries, id)
+	journal.isIndexDirty = true
+
+	err := journal.storer.Remove(entryKey(id))
+	if err != nil {
+		journal.log.Error("executionJournal.removeEntry removing from storer", "ID", id, "error", err)
+	}
+}
+
+// ReleaseFromQuarantine will allow the provided quarantined operations to be executed again. It returns the IDs of
+// the released operations
+func (journal *executionJournal) ReleaseFromQuarantine(ids []uint64) []uint64 {
+	journal.mut.Lock()
+	defer journal.mut.Unlock()
+
+	released := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		entry, found := journal.entries[id]
+		if !found || entry.Status != StatusQuarantined {
+			journal.log.Warn("executionJournal.ReleaseFromQuarantine: operation is not quarantined", "ID", id)
+			continue
+		}
+
+		// the attempts counter is reset so the operation gets the whole retry budget again
+		entry.Status = StatusFailed
+		entry.Attempts = 0
+		entry.NextAttemptAllowed = 0
+		released = append(released, id)
+		journal.persistEntry(entry)
+	}
+
+	if len(released) > 0 {
+		journal.log.Info("executionJournal: released operations from quarantine", "IDs", released)
+		journal.pruneEntries()
+		journal.persistIndex()
+	}
+
+	return released
+}
+
+// GetQuarantined returns the sorted IDs of the quarantined operations
+func (journal *executionJournal) GetQuarantined() []uint64 {
+	journal.mut.RLock()
+	defer journal.mut.RUnlock()
+
+	result := make([]uint64, 0)
+	for id, entry := range journal.entries {
+		if entry.Status == StatusQuarantined {
+			result = append(result, id)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+
+	return result
+}
+
+// GetAllEntries returns a copy of all the journal entries, sorted by ID
+func (journal *executionJournal) GetAllEntries() []*Entry {
+	journal.mut.RLock()
+	defer journal.mut.RUnlock()
+
+	result := make([]*Entry, 0, len(journal.entries))
+	for _, entry := range journal.entries {
+		entryCopy := *entry
+		entryCopy.TxHashes = make([]string, len(entry.TxHashes))
+		copy(entryCopy.TxHashes, entry.TxHashes)
+		result = append(result, &entryCopy)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
+
+func entryKey(id uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", journalEntryKeyPrefix, id))
+}
+
+// persistEntry writes the provided entry under its own key and, if entries were added or removed, the index
+// holding the IDs of all the entries
+func (journal *executionJournal) persistEntry(entry *Entry) {
+	_, exists := journal.entries[entry.ID]
+	if exists {
+		buff, err := json.Marshal(entry)
+		if err != nil {
+			journal.log.Error("executionJournal.persistEntry marshal", "ID", entry.ID, "error", err)
+			return
+		}
+
+		err = journal.storer.Put(entryKey(entry.ID), buff)
+		if err != nil {
+			journal.log.Error("executionJournal.persistEntry writing to storer", "ID", entry.ID, "error", err)
+		}
+	}
+
+	journal.persistIndex()
+}
+
+func (journal *executionJournal) persistIndex() {
+	if !journal.isIndexDirty {
+		return
+	}
+
+	ids := make([]uint64, 0, len(journal.entries))
+	for id := range journal.entries {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	buff, err := json.Marshal(ids)
+	if err != nil {
+		journal.log.Error("executionJournal.persistIndex marshal", "error", err)
+		return
+	}
+
+	err = journal.storer.Put([]byte(journalIndexStorerKey), buff)
+	if err != nil {
+		journal.log.Error("executionJournal.persistIndex writing to storer", "error", err)
+		return
+	}
+	journal.isIndexDirty = false
+}
+
+func (journal *executionJournal) tryLoadPersistedEntries() {
+	buff, err := journal.storer.Get([]byte(journalIndexStorerKey))
+	if err != nil {
+		journal.log.Debug("executionJournal.tryLoadPersistedEntries reading the index from storer", "error", err)
+		return
+	}
+
+	ids := make([]uint64, 0)
+	err = json.Unmarshal(buff, &ids)
+	if err != nil {
+		journal.log.Warn("executionJournal.tryLoadPersistedEntries unmarshal index", "error", err)
+		return
+	}
+
+	for _, id := range ids {
+		buff, err = journal.storer.Get(entryKey(id))
+		if err != nil {
+			journal.log.Warn("executionJournal.tryLoadPersistedEntries reading entry from storer", "ID", id, "error", err)
+			continue
+		}
+
+		entry := &Entry{}
+		err = json.Unmarshal(buff, entry)
+		if err != nil {
+			journal.log.Warn("executionJournal.tryLoadPersistedEntries unmarshal entry", "ID", id, "error", err)
+			continue
+		}
+
+		journal.entries[entry.ID] = entry
+	}
+	journal.log.Debug("executionJournal.tryLoadPersistedEntries loaded data", "num entries", len(journal.entries))
+}
+
+// Close will close the underlying storer
+func (journal *executionJournal) Close() error {
+	return journal.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *executionJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
@@ -0,0 +1,277 @@
+package journal
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var expectedErr = errors.New("expected error")
+
+func createMockArgsExecutionJournal() ArgsExecutionJournal {
+	return ArgsExecutionJournal{
+		Log:                   &testsCommon.LoggerStub{},
+		Storer:                testsCommon.NewStorerMock(),
+		MaxFinalizedEntries:   2,
+		MaxUnfinalizedEntries: 2,
+		MaxAttempts:           3,
+		InitialBackoff:        time.Second * 10,
+		MaxBackoff:            time.Second * 15,
+	}
+}
+
+func createJournalWithTime(args ArgsExecutionJournal, currentTime *time.Time) *executionJournal {
+	journal, _ := NewExecutionJournal(args)
+	journal.getTimeHandler = func() time.Time {
+		return *currentTime
+	}
+
+	return journal
+}
+
+func TestNewExecutionJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsExecutionJournal()
+		args.Log = nil
+
+		journal, err := NewExecutionJournal(args)
+		assert.True(t, check.IfNil(journal))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		args := createMockArgsExecutionJournal()
+		args.Storer = nil
+
+		journal, err := NewExecutionJournal(args)
+		assert.True(t, check.IfNil(journal))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("invalid max finalized entries should error", func(t *testing.T) {
+		args := createMockArgsExecutionJournal()
+		args.MaxFinalizedEntries = 0
+
+		journal, err := NewExecutionJournal(args)
+		assert.True(t, check.IfNil(journal))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "MaxFinalizedEntries")
+	})
+	t.Run("invalid max unfinalized entries should error", func(t *testing.T) {
+		args := createMockArgsExecutionJournal()
+		args.MaxUnfinalizedEntries = 0
+
+		journal, err := NewExecutionJournal(args)
+		assert.True(t, check.IfNil(journal))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "MaxUnfinalizedEntries")
+	})
+	t.Run("invalid max attempts should error", func(t *testing.T) {
+		args := createMockArgsExecutionJournal()
+		args.MaxAttempts = 0
+
+		journal, err := NewExecutionJournal(args)
+		assert.True(t, check.IfNil(journal))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "MaxAttempts")
+	})
+	t.Run("invalid initial backoff should error", func(t *testing.T) {
+		args := createMockArgsExecutionJournal()
+		args.InitialBackoff = time.Millisecond
+
+		journal, err := NewExecutionJournal(args)
+		assert.True(t, check.IfNil(journal))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "InitialBackoff")
+	})
+	t.Run("max backoff lower than the initial backoff should error", func(t *testing.T) {
+		args := createMockArgsExecutionJournal()
+		args.MaxBackoff = args.InitialBackoff - time.Second
+
+		journal, err := NewExecutionJournal(args)
+		assert.True(t, check.IfNil(journal))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "MaxBackoff")
+	})
+	t.Run("should work", func(t *testing.T) {
+		journal, err := NewExecutionJournal(createMockArgsExecutionJournal())
+		assert.False(t, check.IfNil(journal))
+		assert.Nil(t, err)
+		assert.Empty(t, journal.GetAllEntries())
+	})
+}
+
+func TestExecutionJournal_RetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	journal := createJournalWithTime(createMockArgsExecutionJournal(), &currentTime)
+
+	assert.True(t, journal.ShouldExecute(1))
+
+	// first failure: backoff of 10 seconds
+	journal.RecordAttempt(1, "hash 1", true)
+	journal.RecordResult(1, expectedErr)
+	assert.False(t, journal.ShouldExecute(1))
+	currentTime = currentTime.Add(time.Second * 9)
+	assert.False(t, journal.ShouldExecute(1))
+	currentTime = currentTime.Add(time.Second)
+	assert.True(t, journal.ShouldExecute(1))
+
+	// second failure: backoff of 20 seconds, capped at 15 seconds
+	journal.RecordAttempt(1, "hash 2", true)
+	journal.RecordResult(1, expectedErr)
+	currentTime = currentTime.Add(time.Second * 14)
+	assert.False(t, journal.ShouldExecute(1))
+	currentTime = currentTime.Add(time.Second)
+	assert.True(t, journal.ShouldExecute(1))
+
+	// third failure: quarantined
+	journal.RecordAttempt(1, "hash 3", true)
+	journal.RecordResult(1, expectedErr)
+	currentTime = currentTime.Add(time.Hour)
+	assert.False(t, journal.ShouldExecute(1))
+	assert.Equal(t, []uint64{1}, journal.GetQuarantined())
+
+	entries := journal.GetAllEntries()
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, &Entry{
+		ID:          1,
+		Attempts:    3,
+		TxHashes:    []string{"hash 1", "hash 2", "hash 3"},
+		Status:      StatusQuarantined,
+		LastError:   expectedErr.Error(),
+		LastAttempt: time.Unix(1025, 0).Unix(),
+	}, entries[0])
+
+	// release from quarantine
+	released := journal.ReleaseFromQuarantine([]uint64{1, 2})
+	assert.Equal(t, []uint64{1}, released)
+	assert.True(t, journal.ShouldExecute(1))
+	assert.Empty(t, journal.GetQuarantined())
+
+	journal.RecordAttempt(1, "hash 4", true)
+	journal.RecordResult(1, nil)
+	assert.False(t, journal.ShouldExecute(1))
+	entries = journal.GetAllEntries()
+	assert.Equal(t, StatusExecuted, entries[0].Status)
+	assert.Equal(t, uint32(1), entries[0].Attempts)
+	assert.Empty(t, entries[0].LastError)
+}
+
+func TestExecutionJournal_NotCheckedResults(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	journal := createJournalWithTime(createMockArgsExecutionJournal(), &currentTime)
+
+	journal.RecordAttempt(1, "hash", false)
+	assert.Equal(t, StatusSent, journal.GetAllEntries()[0].Status)
+	assert.False(t, journal.ShouldExecute(1))
+
+	currentTime = currentTime.Add(time.Second * 10)
+	assert.True(t, journal.ShouldExecute(1))
+}
+
+func TestExecutionJournal_ShouldPruneFinalizedEntries(t *testing.T) {
+	t.Parallel()
+
+	journal, _ := NewExecutionJournal(createMockArgsExecutionJournal())
+
+	journal.RecordAttempt(1, "hash", true)
+	journal.RecordResult(1, expectedErr)
+	for id := uint64(2); id <= 5; id++ {
+		journal.RecordAttempt(id, "hash", true)
+		journal.RecordResult(id, nil)
+	}
+
+	entries := journal.GetAllEntries()
+	require.Equal(t, 3, len(entries))
+	// the failed entry is kept
+	assert.Equal(t, uint64(1), entries[0].ID)
+	assert.Equal(t, uint64(4), entries[1].ID)
+	assert.Equal(t, uint64(5), entries[2].ID)
+}
+
+func TestExecutionJournal_ShouldPruneUnfinalizedEntries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsExecutionJournal()
+	args.MaxAttempts = 1
+	journal, _ := NewExecutionJournal(args)
+
+	for id := uint64(1); id <= 3; id++ {
+		journal.RecordAttempt(id, "hash", true)
+		journal.RecordResult(id, expectedErr)
+	}
+	for id := uint64(4); id <= 6; id++ {
+		journal.RecordAttempt(id, "hash", true)
+	}
+
+	entries := journal.GetAllEntries()
+	require.Equal(t, 4, len(entries))
+	assert.Equal(t, []uint64{2, 3}, journal.GetQuarantined())
+	assert.Equal(t, uint64(5), entries[2].ID)
+	assert.Equal(t, StatusPending, entries[2].Status)
+	assert.Equal(t, uint64(6), entries[3].ID)
+	assert.Equal(t, StatusPending, entries[3].Status)
+}
+
+func TestExecutionJournal_ShouldPersistEachEntryUnderItsOwnKey(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsExecutionJournal()
+	storer := testsCommon.NewStorerMock()
+	args.Storer = storer
+	journal, _ := NewExecutionJournal(args)
+
+	for id := uint64(1); id <= 3; id++ {
+		journal.RecordAttempt(id, "hash", true)
+		journal.RecordResult(id, nil)
+	}
+
+	_, err := storer.Get(entryKey(1))
+	assert.NotNil(t, err, "the pruned entry should be removed from the storer")
+	buff, err := storer.Get(entryKey(3))
+	require.Nil(t, err)
+	assert.Contains(t, string(buff), `"status":"executed"`)
+	buff, err = storer.Get([]byte(journalIndexStorerKey))
+	require.Nil(t, err)
+	assert.Equal(t, "[2,3]", string(buff))
+}
+
+func TestExecutionJournal_ShouldReloadPersistedEntries(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsExecutionJournal()
+	args.MaxAttempts = 1
+	journal, _ := NewExecutionJournal(args)
+
+	journal.RecordAttempt(1, "hash 1", true)
+	journal.RecordResult(1, nil)
+	journal.RecordAttempt(2, "hash 2", true)
+	journal.RecordResult(2, expectedErr)
+
+	reloadedJournal, err := NewExecutionJournal(args)
+	require.Nil(t, err)
+
+	assert.Equal(t, journal.GetAllEntries(), reloadedJournal.GetAllEntries())
+	assert.Equal(t, []uint64{2}, reloadedJournal.GetQuarantined())
+	assert.False(t, reloadedJournal.ShouldExecute(1))
+	assert.False(t, reloadedJournal.ShouldExecute(2))
+}
+
+func TestExecutionJournal_RecordResultWithoutAttemptShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	journal, _ := NewExecutionJournal(createMockArgsExecutionJournal())
+	journal.RecordResult(1, expectedErr)
+
+	assert.Empty(t, journal.GetAllEntries())
+	assert.True(t, journal.ShouldExecute(1))
+}
@@ -0,0 +1,116 @@
+package migration
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// DepositInfo is the MultiversX deposit info
+type DepositInfo struct {
+	DepositNonce          uint64         `json:"DepositNonce"`
+	Token                 string         `json:"Token"`
+	ContractAddressString string         `json:"ContractAddress"`
+	ContractAddress       common.Address `json:"-"`
+	Amount                *big.Int       `json:"-"`
+	AmountString          string         `json:"Amount"`
+}
+
+// BatchInfo is the MultiversX batch info that moves the tokens held by the old safe contract to the new one
+type BatchInfo struct {
+	OldSafeContractAddress string         `json:"OldSafeContractAddress"`
+	NewSafeContractAddress string         `json:"NewSafeContractAddress"`
+	RefundEthAddress       string         `json:"RefundEthAddress"`
+	BatchID                uint64         `json:"BatchID"`
+	DepositsInfo           []*DepositInfo `json:"DepositsInfo"`
+}
+
+// LoadBatchInfo loads a previously generated MultiversX batch from the provided .json file. The contract addresses and
+// the amounts are restored from their string representation
+func LoadBatchInfo(filename string) (*BatchInfo, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &BatchInfo{}
+	err = json.Unmarshal(buff, batch)
+	if err != nil {
+		return nil, fmt.Errorf("%w while unmarshalling the batch from file %s", err, filename)
+	}
+
+	for _, deposit := range batch.DepositsInfo {
+		if !common.IsHexAddress(deposit.ContractAddressString) {
+			return nil, fmt.Errorf("%w for token %s: %s", errWrongERC20AddressResponse, deposit.Token, deposit.ContractAddressString)
+		}
+		deposit.ContractAddress = common.HexToAddress(deposit.ContractAddressString)
+
+		amount, ok := big.NewInt(0).SetString(deposit.AmountString, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w for token %s: %s", errInvalidDepositAmount, deposit.Token, deposit.AmountString)
+		}
+		deposit.Amount = amount
+	}
+
+	return batch, nil
+}
+
+// ToTransferBatch converts the batch info into the transfer batch used by the multisig contract propose, sign and
+// perform actions. Each deposit sends the token amount to the new safe contract address
+func (batch *BatchInfo) ToTransferBatch() (*bridgeCore.TransferBatch, error) {
+	newSafeAddress, err := data.NewAddressFromBech32String(batch.NewSafeContractAddress)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the new safe contract address %s", err, batch.NewSafeContractAddress)
+	}
+	if !common.IsHexAddress(batch.RefundEthAddress) {
+		return nil, fmt.Errorf("%w: %s", errInvalidRefundAddress, batch.RefundEthAddress)
+	}
+	refundAddress := common.HexToAddress(batch.RefundEthAddress)
+
+	transferBatch := &bridgeCore.TransferBatch{
+		ID:       batch.BatchID,
+		Deposits: make([]*bridgeCore.DepositTransfer, 0, len(batch.DepositsInfo)),
+		Statuses: make([]byte, len(batch.DepositsInfo)),
+	}
+	for _, deposit := range batch.DepositsInfo {
+		transferBatch.Deposits = append(transferBatch.Deposits, &bridgeCore.DepositTransfer{
+			Nonce:                 deposit.DepositNonce,
+			ToBytes:               newSafeAddress.AddressBytes(),
+			DisplayableTo:         batch.NewSafeContractAddress,
+			FromBytes:             refundAddress.Bytes(),
+			DisplayableFrom:       refundAddress.String(),
+			SourceTokenBytes:      deposit.ContractAddress.Bytes(),
+			DestinationTokenBytes: []byte(deposit.Token),
+			DisplayableToken:      deposit.Token,
+			Amount:                big.NewInt(0).Set(deposit.Amount),
+			Data:                  []byte{bridgeCore.MissingDataProtocolMarker},
+			DisplayableData:       "",
+		})
+	}
+
+	return transferBatch, nil
+}
+
+// TokensBalancesDisplayString will convert the deposit balances into a human-readable string
+func TokensBalancesDisplayString(batch *BatchInfo) string {
+	maxTokenLen := 0
+	for _, deposit := range batch.DepositsInfo {
+		if len(deposit.Token) > maxTokenLen {
+			maxTokenLen = len(deposit.Token)
+		}
+	}
+
+	tokens := make([]string, 0, len(batch.DepositsInfo))
+	for _, deposit := range batch.DepositsInfo {
+		spaceRequired := strings.Repeat(" ", maxTokenLen-len(deposit.Token))
+		tokens = append(tokens, fmt.Sprintf(" %s: %s%s", deposit.Token, spaceRequired, deposit.AmountString))
+	}
+
+	return strings.Join(tokens, "\n")
+}
@@ -0,0 +1,92 @@
+package migration
+
+import (
+	"encoding/json"
+	"math/big"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadBatchInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch, err := LoadBatchInfo(path.Join(t.TempDir(), "missing.json"))
+		assert.Nil(t, batch)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBatch := createExpectedBatch()
+		expectedBatch.DepositsInfo[1].AmountString = "not a number"
+		filename := path.Join(t.TempDir(), "migration.json")
+		buff, _ := json.Marshal(expectedBatch)
+		require.Nil(t, os.WriteFile(filename, buff, os.ModePerm))
+
+		batch, err := LoadBatchInfo(filename)
+		assert.Nil(t, batch)
+		assert.ErrorIs(t, err, errInvalidDepositAmount)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedBatch := createExpectedBatch()
+		filename := path.Join(t.TempDir(), "migration.json")
+		buff, _ := json.Marshal(expectedBatch)
+		require.Nil(t, os.WriteFile(filename, buff, os.ModePerm))
+
+		batch, err := LoadBatchInfo(filename)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedBatch, batch)
+	})
+}
+
+func TestBatchInfo_ToTransferBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid new safe address should error", func(t *testing.T) {
+		t.Parallel()
+
+		batch := createExpectedBatch()
+		batch.NewSafeContractAddress = "invalid"
+
+		transferBatch, err := batch.ToTransferBatch()
+		assert.Nil(t, transferBatch)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		transferBatch, err := createExpectedBatch().ToTransferBatch()
+		require.Nil(t, err)
+		assert.Equal(t, uint64(4433), transferBatch.ID)
+		assert.Equal(t, 2, len(transferBatch.Statuses))
+		require.Equal(t, 2, len(transferBatch.Deposits))
+
+		deposit := transferBatch.Deposits[0]
+		assert.Equal(t, uint64(12001), deposit.Nonce)
+		assert.Equal(t, newSafeAddress.AddressBytes(), deposit.ToBytes)
+		assert.Equal(t, refundEthAddress.Bytes(), deposit.FromBytes)
+		assert.Equal(t, tkn1Erc20Address.Bytes(), deposit.SourceTokenBytes)
+		assert.Equal(t, []byte("tkn1"), deposit.DestinationTokenBytes)
+		assert.Equal(t, big.NewInt(37), deposit.Amount)
+		assert.Equal(t, []byte{core.MissingDataProtocolMarker}, deposit.Data)
+	})
+}
+
+func TestTokensBalancesDisplayString(t *testing.T) {
+	t.Parallel()
+
+	batch := createExpectedBatch()
+	batch.DepositsInfo[1].Token = "tkn3-long"
+
+	assert.Equal(t, " tkn1:      37\n tkn3-long: 112", TokensBalancesDisplayString(batch))
+}
@@ -0,0 +1,29 @@
+package migration
+
+import "errors"
+
+var (
+	errNilMvxDataGetter          = errors.New("nil MultiversX data getter")
+	errNilMultiversXClient       = errors.New("nil MultiversX client")
+	errNilLogger                 = errors.New("nil logger")
+	errNilAddressHandler         = errors.New("nil address handler")
+	errNilEthereumSafe           = errors.New("nil Ethereum safe contract")
+	errNilBatchInfo              = errors.New("nil batch info")
+	errEmptyTokensList           = errors.New("empty tokens list")
+	errEmptyDepositsList         = errors.New("empty deposits list")
+	errWrongERC20AddressResponse = errors.New("wrong ERC20 address response")
+	errInvalidDepositAmount      = errors.New("invalid deposit amount")
+	errInvalidRefundAddress      = errors.New("invalid refund Ethereum address")
+	errSafeAddressMismatch       = errors.New("safe contract address mismatch")
+	errInvalidBatchID            = errors.New("invalid batch ID")
+	errInvalidDepositNonce       = errors.New("invalid deposit nonce")
+	errTokenNotWhitelisted       = errors.New("token not whitelisted")
+	errMintBurnToken             = errors.New("mint/burn tokens are not held by the safe contract")
+	errInsufficientBalance       = errors.New("insufficient balance")
+	errTransferNotProposed       = errors.New("migration transfer not proposed")
+	errActionAlreadyExecuted     = errors.New("action already executed")
+	errQuorumNotReached          = errors.New("quorum not reached")
+	errMvxSafeNotPaused          = errors.New("MultiversX safe contract not paused")
+	errEthereumSafeNotPaused     = errors.New("Ethereum safe contract not paused")
+	errPendingEthereumBatches    = errors.New("pending Ethereum batches")
+)
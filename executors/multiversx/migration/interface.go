@@ -0,0 +1,41 @@
+package migration
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// MvxDataGetter defines the operations for the data getter operating on the MultiversX safe and multisig contracts
+type MvxDataGetter interface {
+	GetAllKnownTokens(ctx context.Context) ([][]byte, error)
+	GetERC20AddressForTokenId(ctx context.Context, tokenId []byte) ([][]byte, error)
+	IsMintBurnToken(ctx context.Context, token []byte) (bool, error)
+	GetTotalBalances(ctx context.Context, token []byte) (*big.Int, error)
+	GetLastExecutedEthBatchID(ctx context.Context) (uint64, error)
+	GetLastExecutedEthTxID(ctx context.Context) (uint64, error)
+	IsSafePaused(ctx context.Context) (bool, error)
+	IsInterfaceNil() bool
+}
+
+// EthereumSafeContract defines the Ethereum safe contract operations used to check that no Ethereum batch can collide
+// with the migration batch
+type EthereumSafeContract interface {
+	Paused(opts *bind.CallOpts) (bool, error)
+	BatchesCount(opts *bind.CallOpts) (uint64, error)
+}
+
+// MultiversXClient defines the multisig contract operations used to propose, sign and perform the migration transfer
+type MultiversXClient interface {
+	WasProposedTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (bool, error)
+	ProposeTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error)
+	GetActionIDForProposeTransfer(ctx context.Context, batch *bridgeCore.TransferBatch) (uint64, error)
+	WasSigned(ctx context.Context, actionID uint64) (bool, error)
+	Sign(ctx context.Context, actionID uint64) (string, error)
+	QuorumReached(ctx context.Context, actionID uint64) (bool, error)
+	WasExecuted(ctx context.Context, actionID uint64) (bool, error)
+	PerformAction(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,319 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+)
+
+// ArgsMigrationBatchCreator is the argument for the NewMigrationBatchCreator constructor
+type ArgsMigrationBatchCreator struct {
+	MvxDataGetter       MvxDataGetter
+	SafeContractAddress sdkCore.AddressHandler
+	EthereumSafe        EthereumSafeContract
+	RefundEthAddress    common.Address
+	Logger              logger.Logger
+}
+
+type migrationBatchCreator struct {
+	mvxDataGetter       MvxDataGetter
+	safeContractAddress string
+	ethereumSafe        EthereumSafeContract
+	refundEthAddress    common.Address
+	logger              logger.Logger
+}
+
+// NewMigrationBatchCreator creates a new instance of type migrationBatchCreator that is able to generate the MultiversX
+// migration batch. The batch is an Ethereum to MultiversX transfer batch so it can be proposed, signed and performed on
+// the multisig contract. The refund Ethereum address receives back the tokens if the transfer to the new safe fails.
+// Since the batch takes the next Ethereum batch ID, both safe contracts should be paused and all the Ethereum batches
+// should be already executed on MultiversX before the batch is created or verified
+func NewMigrationBatchCreator(args ArgsMigrationBatchCreator) (*migrationBatchCreator, error) {
+	if check.IfNil(args.MvxDataGetter) {
+		return nil, errNilMvxDataGetter
+	}
+	if check.IfNil(args.SafeContractAddress) {
+		return nil, errNilAddressHandler
+	}
+	if check.IfNilReflect(args.EthereumSafe) {
+		return nil, errNilEthereumSafe
+	}
+	if check.IfNil(args.Logger) {
+		return nil, errNilLogger
+	}
+
+	safeContractAddress, err := args.SafeContractAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	return &migrationBatchCreator{
+		mvxDataGetter:       args.MvxDataGetter,
+		safeContractAddress: safeContractAddress,
+		ethereumSafe:        args.EthereumSafe,
+		refundEthAddress:    args.RefundEthAddress,
+		logger:              args.Logger,
+	}, nil
+}
+
+// CreateBatchInfo creates the batch that moves all the tokens held by the safe contract to the new safe contract.
+// The batch ID and the deposit nonces continue the ones last executed by the multisig contract
+func (creator *migrationBatchCreator) CreateBatchInfo(ctx context.Context, newSafeAddress sdkCore.AddressHandler) (*BatchInfo, error) {
+	if check.IfNil(newSafeAddress) {
+		return nil, errNilAddressHandler
+	}
+	newSafeAddressString, err := newSafeAddress.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	creator.logger.Info("started the MultiversX batch creation process...")
+
+	lastBatchID, err := creator.mvxDataGetter.GetLastExecutedEthBatchID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = creator.checkContractsState(ctx, lastBatchID)
+	if err != nil {
+		return nil, err
+	}
+	lastDepositNonce, err := creator.mvxDataGetter.GetLastExecutedEthTxID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := creator.mvxDataGetter.GetAllKnownTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("%w when calling the getAllKnownTokens function on the safe contract", errEmptyTokensList)
+	}
+
+	batch := &BatchInfo{
+		OldSafeContractAddress: creator.safeContractAddress,
+		NewSafeContractAddress: newSafeAddressString,
+		RefundEthAddress:       creator.refundEthAddress.String(),
+		BatchID:                lastBatchID + 1,
+		DepositsInfo:           make([]*DepositInfo, 0, len(tokens)),
+	}
+
+	tokensNames := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		deposit, errCreate := creator.createDeposit(ctx, token)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+		if deposit == nil {
+			continue
+		}
+
+		deposit.DepositNonce = lastDepositNonce + uint64(1+len(batch.DepositsInfo))
+		batch.DepositsInfo = append(batch.DepositsInfo, deposit)
+		tokensNames = append(tokensNames, deposit.Token)
+	}
+
+	creator.logger.Info("created the MultiversX batch", "batch ID", batch.BatchID, "tokens", strings.Join(tokensNames, ", "))
+
+	return batch, nil
+}
+
+// createDeposit returns nil if the token has nothing to be migrated
+func (creator *migrationBatchCreator) createDeposit(ctx context.Context, token []byte) (*DepositInfo, error) {
+	isMintBurn, err := creator.mvxDataGetter.IsMintBurnToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if isMintBurn {
+		creator.logger.Info("skipping mint/burn token, the safe contract does not hold its balance", "token", string(token))
+		return nil, nil
+	}
+
+	balance, err := creator.mvxDataGetter.GetTotalBalances(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if balance.Sign() <= 0 {
+		return nil, nil
+	}
+
+	erc20Address, err := creator.getERC20Address(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DepositInfo{
+		Token:                 string(token),
+		ContractAddressString: erc20Address.String(),
+		ContractAddress:       erc20Address,
+		Amount:                balance,
+		AmountString:          balance.String(),
+	}, nil
+}
+
+func (creator *migrationBatchCreator) getERC20Address(ctx context.Context, token []byte) (common.Address, error) {
+	response, err := creator.mvxDataGetter.GetERC20AddressForTokenId(ctx, token)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(response) != 1 {
+		return common.Address{}, fmt.Errorf("%w when querying the multisig contract for token %s",
+			errWrongERC20AddressResponse, string(token))
+	}
+
+	return common.BytesToAddress(response[0]), nil
+}
+
+// VerifyBatchInfo re-checks a previously generated batch against the current chain state. The batch ID and the deposit
+// nonces should continue the ones last executed, each token should still be whitelisted with the same ERC20 address
+// and the safe contract should hold at least the amount to be migrated
+func (creator *migrationBatchCreator) VerifyBatchInfo(ctx context.Context, batch *BatchInfo) error {
+	if batch == nil {
+		return errNilBatchInfo
+	}
+	if len(batch.DepositsInfo) == 0 {
+		return errEmptyDepositsList
+	}
+	if batch.OldSafeContractAddress != creator.safeContractAddress {
+		return fmt.Errorf("%w: batch contains %s, configured %s",
+			errSafeAddressMismatch, batch.OldSafeContractAddress, creator.safeContractAddress)
+	}
+
+	lastBatchID, err := creator.mvxDataGetter.GetLastExecutedEthBatchID(ctx)
+	if err != nil {
+		return err
+	}
+	if batch.BatchID != lastBatchID+1 {
+		return fmt.Errorf("%w: batch contains %d, expected %d", errInvalidBatchID, batch.BatchID, lastBatchID+1)
+	}
+	err = creator.checkContractsState(ctx, lastBatchID)
+	if err != nil {
+		return err
+	}
+
+	lastDepositNonce, err := creator.mvxDataGetter.GetLastExecutedEthTxID(ctx)
+	if err != nil {
+		return err
+	}
+
+	knownTokens, err := creator.getKnownTokens(ctx)
+	if err != nil {
+		return err
+	}
+
+	for idx, deposit := range batch.DepositsInfo {
+		expectedNonce := lastDepositNonce + uint64(1+idx)
+		if deposit.DepositNonce != expectedNonce {
+			return fmt.Errorf("%w for token %s: batch contains %d, expected %d",
+				errInvalidDepositNonce, deposit.Token, deposit.DepositNonce, expectedNonce)
+		}
+
+		err = creator.verifyDeposit(ctx, deposit, knownTokens)
+		if err != nil {
+			return err
+		}
+	}
+
+	creator.logger.Info("verified the MultiversX batch against the chain state", "batch ID", batch.BatchID)
+
+	return nil
+}
+
+// checkContractsState ensures the migration batch can not take the ID of a real Ethereum batch: the Ethereum safe
+// should be paused so no new batches are created and all its batches should be already executed on MultiversX.
+// The MultiversX safe should be paused so no new deposits are made while its tokens are moved. The multisig contract
+// is not checked as it refuses to propose or perform actions while paused
+func (creator *migrationBatchCreator) checkContractsState(ctx context.Context, lastExecutedEthBatchID uint64) error {
+	isMvxSafePaused, err := creator.mvxDataGetter.IsSafePaused(ctx)
+	if err != nil {
+		return err
+	}
+	if !isMvxSafePaused {
+		return errMvxSafeNotPaused
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	isEthSafePaused, err := creator.ethereumSafe.Paused(opts)
+	if err != nil {
+		return err
+	}
+	if !isEthSafePaused {
+		return errEthereumSafeNotPaused
+	}
+
+	ethBatchesCount, err := creator.ethereumSafe.BatchesCount(opts)
+	if err != nil {
+		return err
+	}
+	if ethBatchesCount > lastExecutedEthBatchID {
+		return fmt.Errorf("%w: Ethereum safe contains %d batches, last executed on MultiversX is %d",
+			errPendingEthereumBatches, ethBatchesCount, lastExecutedEthBatchID)
+	}
+
+	return nil
+}
+
+func (creator *migrationBatchCreator) getKnownTokens(ctx context.Context) (map[string]struct{}, error) {
+	tokens, err := creator.mvxDataGetter.GetAllKnownTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	knownTokens := make(map[string]struct{}, len(tokens))
+	for _, token := range tokens {
+		knownTokens[string(token)] = struct{}{}
+	}
+
+	return knownTokens, nil
+}
+
+func (creator *migrationBatchCreator) verifyDeposit(ctx context.Context, deposit *DepositInfo, knownTokens map[string]struct{}) error {
+	if deposit.Amount == nil || deposit.Amount.Sign() <= 0 {
+		return fmt.Errorf("%w for token %s", errInvalidDepositAmount, deposit.Token)
+	}
+
+	_, isKnown := knownTokens[deposit.Token]
+	if !isKnown {
+		return fmt.Errorf("%w: %s", errTokenNotWhitelisted, deposit.Token)
+	}
+
+	token := []byte(deposit.Token)
+	erc20Address, err := creator.getERC20Address(ctx, token)
+	if err != nil {
+		return err
+	}
+	if erc20Address != deposit.ContractAddress {
+		return fmt.Errorf("%w when querying the multisig contract for token %s, batch contains %s",
+			errWrongERC20AddressResponse, deposit.Token, deposit.ContractAddress.String())
+	}
+
+	isMintBurn, err := creator.mvxDataGetter.IsMintBurnToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	if isMintBurn {
+		return fmt.Errorf("%w: %s", errMintBurnToken, deposit.Token)
+	}
+
+	balance, err := creator.mvxDataGetter.GetTotalBalances(ctx, token)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(deposit.Amount) < 0 {
+		return fmt.Errorf("%w for token %s: balance %s, required %s",
+			errInsufficientBalance, deposit.Token, balance.String(), deposit.Amount.String())
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (creator *migrationBatchCreator) IsInterfaceNil() bool {
+	return creator == nil
+}
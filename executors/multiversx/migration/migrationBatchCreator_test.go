@@ -0,0 +1,443 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	expectedErr        = errors.New("expected error")
+	oldSafeAddress     = data.NewAddressFromBytes(bytes.Repeat([]byte{1}, 32))
+	newSafeAddress     = data.NewAddressFromBytes(bytes.Repeat([]byte{2}, 32))
+	oldSafeBech32, _   = oldSafeAddress.AddressAsBech32String()
+	newSafeBech32, _   = newSafeAddress.AddressAsBech32String()
+	refundEthAddress   = common.HexToAddress("0x3009d97FfeD62E57d444e552A9eDF9Ee6Bc8644c")
+	tkn1Erc20Address   = common.BytesToAddress(bytes.Repeat([]byte("1"), 20))
+	tkn3Erc20Address   = common.BytesToAddress(bytes.Repeat([]byte("3"), 20))
+	erc20AddressByTkns = map[string]common.Address{
+		"tkn1": tkn1Erc20Address,
+		"tkn2": common.BytesToAddress(bytes.Repeat([]byte("2"), 20)),
+		"tkn3": tkn3Erc20Address,
+		"tkn4": common.BytesToAddress(bytes.Repeat([]byte("4"), 20)),
+	}
+)
+
+func createMockDataGetter() *bridge.DataGetterStub {
+	return &bridge.DataGetterStub{
+		GetAllKnownTokensCalled: func(ctx context.Context) ([][]byte, error) {
+			return [][]byte{[]byte("tkn1"), []byte("tkn2"), []byte("tkn3"), []byte("tkn4")}, nil
+		},
+		GetERC20AddressForTokenIdCalled: func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+			return [][]byte{erc20AddressByTkns[string(tokenId)].Bytes()}, nil
+		},
+		IsMintBurnTokenCalled: func(ctx context.Context, token []byte) (bool, error) {
+			return string(token) == "tkn2", nil
+		},
+		GetTotalBalancesCalled: func(ctx context.Context, token []byte) (*big.Int, error) {
+			switch string(token) {
+			case "tkn1":
+				return big.NewInt(37), nil
+			case "tkn3":
+				return big.NewInt(112), nil
+			default:
+				return big.NewInt(0), nil
+			}
+		},
+		GetLastExecutedEthBatchIDCalled: func(ctx context.Context) (uint64, error) {
+			return 4432, nil
+		},
+		GetLastExecutedEthTxIDCalled: func(ctx context.Context) (uint64, error) {
+			return 12000, nil
+		},
+		IsSafePausedCalled: func(ctx context.Context) (bool, error) {
+			return true, nil
+		},
+	}
+}
+
+func createMockEthereumSafe() *bridge.SafeContractWrapperStub {
+	return &bridge.SafeContractWrapperStub{
+		PausedCalled: func(opts *bind.CallOpts) (bool, error) {
+			return true, nil
+		},
+		BatchesCountCalled: func(opts *bind.CallOpts) (uint64, error) {
+			return 4432, nil
+		},
+	}
+}
+
+func createMockArgsMigrationBatchCreator() ArgsMigrationBatchCreator {
+	return ArgsMigrationBatchCreator{
+		MvxDataGetter:       createMockDataGetter(),
+		SafeContractAddress: oldSafeAddress,
+		EthereumSafe:        createMockEthereumSafe(),
+		RefundEthAddress:    refundEthAddress,
+		Logger:              &testsCommon.LoggerStub{},
+	}
+}
+
+func createExpectedBatch() *BatchInfo {
+	return &BatchInfo{
+		OldSafeContractAddress: oldSafeBech32,
+		NewSafeContractAddress: newSafeBech32,
+		RefundEthAddress:       refundEthAddress.String(),
+		BatchID:                4433,
+		DepositsInfo: []*DepositInfo{
+			{
+				DepositNonce:          12001,
+				Token:                 "tkn1",
+				ContractAddressString: tkn1Erc20Address.String(),
+				ContractAddress:       tkn1Erc20Address,
+				Amount:                big.NewInt(37),
+				AmountString:          "37",
+			},
+			{
+				DepositNonce:          12002,
+				Token:                 "tkn3",
+				ContractAddressString: tkn3Erc20Address.String(),
+				ContractAddress:       tkn3Erc20Address,
+				Amount:                big.NewInt(112),
+				AmountString:          "112",
+			},
+		},
+	}
+}
+
+func TestNewMigrationBatchCreator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		args.MvxDataGetter = nil
+
+		creator, err := NewMigrationBatchCreator(args)
+		assert.Nil(t, creator)
+		assert.Equal(t, errNilMvxDataGetter, err)
+	})
+	t.Run("nil safe contract address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		args.SafeContractAddress = nil
+
+		creator, err := NewMigrationBatchCreator(args)
+		assert.Nil(t, creator)
+		assert.Equal(t, errNilAddressHandler, err)
+	})
+	t.Run("nil Ethereum safe should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		args.EthereumSafe = nil
+
+		creator, err := NewMigrationBatchCreator(args)
+		assert.Nil(t, creator)
+		assert.Equal(t, errNilEthereumSafe, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		args.Logger = nil
+
+		creator, err := NewMigrationBatchCreator(args)
+		assert.Nil(t, creator)
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		creator, err := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		assert.False(t, check.IfNil(creator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMigrationBatchCreator_CreateBatchInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil new safe address should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		batch, err := creator.CreateBatchInfo(context.Background(), nil)
+		assert.Nil(t, batch)
+		assert.Equal(t, errNilAddressHandler, err)
+	})
+	t.Run("get last executed batch ID errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		dataGetter := createMockDataGetter()
+		dataGetter.GetLastExecutedEthBatchIDCalled = func(ctx context.Context) (uint64, error) {
+			return 0, expectedErr
+		}
+		args.MvxDataGetter = dataGetter
+		creator, _ := NewMigrationBatchCreator(args)
+
+		batch, err := creator.CreateBatchInfo(context.Background(), newSafeAddress)
+		assert.Nil(t, batch)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("MultiversX safe not paused should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		dataGetter := createMockDataGetter()
+		dataGetter.IsSafePausedCalled = func(ctx context.Context) (bool, error) {
+			return false, nil
+		}
+		args.MvxDataGetter = dataGetter
+		creator, _ := NewMigrationBatchCreator(args)
+
+		batch, err := creator.CreateBatchInfo(context.Background(), newSafeAddress)
+		assert.Nil(t, batch)
+		assert.Equal(t, errMvxSafeNotPaused, err)
+	})
+	t.Run("Ethereum safe not paused should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		ethereumSafe := createMockEthereumSafe()
+		ethereumSafe.PausedCalled = func(opts *bind.CallOpts) (bool, error) {
+			return false, nil
+		}
+		args.EthereumSafe = ethereumSafe
+		creator, _ := NewMigrationBatchCreator(args)
+
+		batch, err := creator.CreateBatchInfo(context.Background(), newSafeAddress)
+		assert.Nil(t, batch)
+		assert.Equal(t, errEthereumSafeNotPaused, err)
+	})
+	t.Run("Ethereum batches count errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		ethereumSafe := createMockEthereumSafe()
+		ethereumSafe.BatchesCountCalled = func(opts *bind.CallOpts) (uint64, error) {
+			return 0, expectedErr
+		}
+		args.EthereumSafe = ethereumSafe
+		creator, _ := NewMigrationBatchCreator(args)
+
+		batch, err := creator.CreateBatchInfo(context.Background(), newSafeAddress)
+		assert.Nil(t, batch)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("Ethereum batch not executed on MultiversX should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		ethereumSafe := createMockEthereumSafe()
+		ethereumSafe.BatchesCountCalled = func(opts *bind.CallOpts) (uint64, error) {
+			return 4433, nil
+		}
+		args.EthereumSafe = ethereumSafe
+		creator, _ := NewMigrationBatchCreator(args)
+
+		batch, err := creator.CreateBatchInfo(context.Background(), newSafeAddress)
+		assert.Nil(t, batch)
+		assert.ErrorIs(t, err, errPendingEthereumBatches)
+	})
+	t.Run("empty tokens list should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		dataGetter := createMockDataGetter()
+		dataGetter.GetAllKnownTokensCalled = func(ctx context.Context) ([][]byte, error) {
+			return make([][]byte, 0), nil
+		}
+		args.MvxDataGetter = dataGetter
+		creator, _ := NewMigrationBatchCreator(args)
+
+		batch, err := creator.CreateBatchInfo(context.Background(), newSafeAddress)
+		assert.Nil(t, batch)
+		assert.ErrorIs(t, err, errEmptyTokensList)
+	})
+	t.Run("wrong ERC20 address response should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		dataGetter := createMockDataGetter()
+		dataGetter.GetERC20AddressForTokenIdCalled = func(ctx context.Context, tokenId []byte) ([][]byte, error) {
+			return make([][]byte, 0), nil
+		}
+		args.MvxDataGetter = dataGetter
+		creator, _ := NewMigrationBatchCreator(args)
+
+		batch, err := creator.CreateBatchInfo(context.Background(), newSafeAddress)
+		assert.Nil(t, batch)
+		assert.ErrorIs(t, err, errWrongERC20AddressResponse)
+	})
+	t.Run("should work and skip the mint/burn and the empty tokens", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		batch, err := creator.CreateBatchInfo(context.Background(), newSafeAddress)
+		require.Nil(t, err)
+		assert.Equal(t, createExpectedBatch(), batch)
+	})
+}
+
+func TestMigrationBatchCreator_VerifyBatchInfo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		err := creator.VerifyBatchInfo(context.Background(), nil)
+		assert.Equal(t, errNilBatchInfo, err)
+	})
+	t.Run("empty deposits list should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		batch := createExpectedBatch()
+		batch.DepositsInfo = nil
+
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.Equal(t, errEmptyDepositsList, err)
+	})
+	t.Run("different safe address should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		batch := createExpectedBatch()
+		batch.OldSafeContractAddress = newSafeBech32
+
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.ErrorIs(t, err, errSafeAddressMismatch)
+	})
+	t.Run("outdated batch ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		dataGetter := createMockDataGetter()
+		dataGetter.GetLastExecutedEthBatchIDCalled = func(ctx context.Context) (uint64, error) {
+			return 4433, nil
+		}
+		args.MvxDataGetter = dataGetter
+		creator, _ := NewMigrationBatchCreator(args)
+
+		err := creator.VerifyBatchInfo(context.Background(), createExpectedBatch())
+		assert.ErrorIs(t, err, errInvalidBatchID)
+	})
+	t.Run("MultiversX safe unpaused after the batch creation should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		dataGetter := createMockDataGetter()
+		dataGetter.IsSafePausedCalled = func(ctx context.Context) (bool, error) {
+			return false, nil
+		}
+		args.MvxDataGetter = dataGetter
+		creator, _ := NewMigrationBatchCreator(args)
+
+		err := creator.VerifyBatchInfo(context.Background(), createExpectedBatch())
+		assert.Equal(t, errMvxSafeNotPaused, err)
+	})
+	t.Run("new Ethereum batch after the batch creation should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		ethereumSafe := createMockEthereumSafe()
+		ethereumSafe.BatchesCountCalled = func(opts *bind.CallOpts) (uint64, error) {
+			return 4433, nil
+		}
+		args.EthereumSafe = ethereumSafe
+		creator, _ := NewMigrationBatchCreator(args)
+
+		err := creator.VerifyBatchInfo(context.Background(), createExpectedBatch())
+		assert.ErrorIs(t, err, errPendingEthereumBatches)
+	})
+	t.Run("outdated deposit nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		dataGetter := createMockDataGetter()
+		dataGetter.GetLastExecutedEthTxIDCalled = func(ctx context.Context) (uint64, error) {
+			return 12001, nil
+		}
+		args.MvxDataGetter = dataGetter
+		creator, _ := NewMigrationBatchCreator(args)
+
+		err := creator.VerifyBatchInfo(context.Background(), createExpectedBatch())
+		assert.ErrorIs(t, err, errInvalidDepositNonce)
+	})
+	t.Run("invalid amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		batch := createExpectedBatch()
+		batch.DepositsInfo[0].Amount = big.NewInt(0)
+
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.ErrorIs(t, err, errInvalidDepositAmount)
+	})
+	t.Run("not whitelisted token should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		batch := createExpectedBatch()
+		batch.DepositsInfo[1].Token = "tkn5"
+
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.ErrorIs(t, err, errTokenNotWhitelisted)
+	})
+	t.Run("different ERC20 address should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		batch := createExpectedBatch()
+		batch.DepositsInfo[1].ContractAddress = tkn1Erc20Address
+
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.ErrorIs(t, err, errWrongERC20AddressResponse)
+	})
+	t.Run("mint/burn token should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchCreator()
+		dataGetter := createMockDataGetter()
+		dataGetter.IsMintBurnTokenCalled = func(ctx context.Context, token []byte) (bool, error) {
+			return true, nil
+		}
+		args.MvxDataGetter = dataGetter
+		creator, _ := NewMigrationBatchCreator(args)
+
+		err := creator.VerifyBatchInfo(context.Background(), createExpectedBatch())
+		assert.ErrorIs(t, err, errMintBurnToken)
+	})
+	t.Run("insufficient balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		batch := createExpectedBatch()
+		batch.DepositsInfo[1].Amount = big.NewInt(113)
+
+		err := creator.VerifyBatchInfo(context.Background(), batch)
+		assert.ErrorIs(t, err, errInsufficientBalance)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		creator, _ := NewMigrationBatchCreator(createMockArgsMigrationBatchCreator())
+		err := creator.VerifyBatchInfo(context.Background(), createExpectedBatch())
+		assert.Nil(t, err)
+	})
+}
@@ -0,0 +1,144 @@
+package migration
+
+import (
+	"context"
+	"fmt"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+// ArgsMigrationBatchExecutor is the argument for the NewMigrationBatchExecutor constructor
+type ArgsMigrationBatchExecutor struct {
+	MultiversXClient MultiversXClient
+	Batch            *BatchInfo
+	Logger           logger.Logger
+}
+
+type migrationBatchExecutor struct {
+	multiversXClient MultiversXClient
+	batch            *BatchInfo
+	transferBatch    *bridgeCore.TransferBatch
+	logger           logger.Logger
+}
+
+// NewMigrationBatchExecutor creates a new instance of type migrationBatchExecutor that is able to propose, sign and
+// perform the MultiversX migration batch on the multisig contract
+func NewMigrationBatchExecutor(args ArgsMigrationBatchExecutor) (*migrationBatchExecutor, error) {
+	if check.IfNil(args.MultiversXClient) {
+		return nil, errNilMultiversXClient
+	}
+	if args.Batch == nil {
+		return nil, errNilBatchInfo
+	}
+	if check.IfNil(args.Logger) {
+		return nil, errNilLogger
+	}
+
+	transferBatch, err := args.Batch.ToTransferBatch()
+	if err != nil {
+		return nil, err
+	}
+
+	return &migrationBatchExecutor{
+		multiversXClient: args.MultiversXClient,
+		batch:            args.Batch,
+		transferBatch:    transferBatch,
+		logger:           args.Logger,
+	}, nil
+}
+
+// ProposeOrSign proposes the migration transfer if it was not proposed yet (the proposer's signature is added by the
+// multisig contract) or signs the existing proposal if the current relayer did not sign it already
+func (executor *migrationBatchExecutor) ProposeOrSign(ctx context.Context) error {
+	wasProposed, err := executor.multiversXClient.WasProposedTransfer(ctx, executor.transferBatch)
+	if err != nil {
+		return err
+	}
+	if !wasProposed {
+		hash, errPropose := executor.multiversXClient.ProposeTransfer(ctx, executor.transferBatch)
+		if errPropose != nil {
+			return errPropose
+		}
+
+		executor.logger.Info("proposed the MultiversX migration transfer", "batch ID", executor.batch.BatchID, "transaction hash", hash)
+		return nil
+	}
+
+	actionID, err := executor.getActionID(ctx)
+	if err != nil {
+		return err
+	}
+
+	wasSigned, err := executor.multiversXClient.WasSigned(ctx, actionID)
+	if err != nil {
+		return err
+	}
+	if wasSigned {
+		executor.logger.Info("the MultiversX migration transfer was already signed by this relayer",
+			"batch ID", executor.batch.BatchID, "action ID", actionID)
+		return nil
+	}
+
+	hash, err := executor.multiversXClient.Sign(ctx, actionID)
+	if err != nil {
+		return err
+	}
+
+	executor.logger.Info("signed the MultiversX migration transfer", "batch ID", executor.batch.BatchID,
+		"action ID", actionID, "transaction hash", hash)
+
+	return nil
+}
+
+// ExecuteTransfer performs the migration transfer action after the quorum was reached
+func (executor *migrationBatchExecutor) ExecuteTransfer(ctx context.Context) error {
+	actionID, err := executor.getActionID(ctx)
+	if err != nil {
+		return err
+	}
+
+	wasExecuted, err := executor.multiversXClient.WasExecuted(ctx, actionID)
+	if err != nil {
+		return err
+	}
+	if wasExecuted {
+		return fmt.Errorf("%w, action ID %d", errActionAlreadyExecuted, actionID)
+	}
+
+	quorumReached, err := executor.multiversXClient.QuorumReached(ctx, actionID)
+	if err != nil {
+		return err
+	}
+	if !quorumReached {
+		return fmt.Errorf("%w for action ID %d", errQuorumNotReached, actionID)
+	}
+
+	hash, err := executor.multiversXClient.PerformAction(ctx, actionID, executor.transferBatch)
+	if err != nil {
+		return err
+	}
+
+	executor.logger.Info("performed the MultiversX migration transfer", "batch ID", executor.batch.BatchID,
+		"action ID", actionID, "transaction hash", hash)
+
+	return nil
+}
+
+func (executor *migrationBatchExecutor) getActionID(ctx context.Context) (uint64, error) {
+	wasProposed, err := executor.multiversXClient.WasProposedTransfer(ctx, executor.transferBatch)
+	if err != nil {
+		return 0, err
+	}
+	if !wasProposed {
+		return 0, fmt.Errorf("%w, batch ID %d", errTransferNotProposed, executor.batch.BatchID)
+	}
+
+	return executor.multiversXClient.GetActionIDForProposeTransfer(ctx, executor.transferBatch)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (executor *migrationBatchExecutor) IsInterfaceNil() bool {
+	return executor == nil
+}
@@ -0,0 +1,265 @@
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+const testActionID = uint64(227)
+
+func createMockArgsMigrationBatchExecutor() ArgsMigrationBatchExecutor {
+	return ArgsMigrationBatchExecutor{
+		MultiversXClient: &bridge.MultiversXClientStub{},
+		Batch:            createExpectedBatch(),
+		Logger:           &testsCommon.LoggerStub{},
+	}
+}
+
+func TestNewMigrationBatchExecutor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil MultiversX client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.MultiversXClient = nil
+
+		executor, err := NewMigrationBatchExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilMultiversXClient, err)
+	})
+	t.Run("nil batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.Batch = nil
+
+		executor, err := NewMigrationBatchExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilBatchInfo, err)
+	})
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.Logger = nil
+
+		executor, err := NewMigrationBatchExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilLogger, err)
+	})
+	t.Run("invalid batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.Batch.RefundEthAddress = "invalid"
+
+		executor, err := NewMigrationBatchExecutor(args)
+		assert.Nil(t, executor)
+		assert.ErrorIs(t, err, errInvalidRefundAddress)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		executor, err := NewMigrationBatchExecutor(createMockArgsMigrationBatchExecutor())
+		assert.False(t, check.IfNil(executor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestMigrationBatchExecutor_ProposeOrSign(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not proposed should propose", func(t *testing.T) {
+		t.Parallel()
+
+		proposeCalled := false
+		args := createMockArgsMigrationBatchExecutor()
+		args.MultiversXClient = &bridge.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (bool, error) {
+				return false, nil
+			},
+			ProposeTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (string, error) {
+				proposeCalled = true
+				assert.Equal(t, uint64(4433), batch.ID)
+				assert.Equal(t, 2, len(batch.Deposits))
+				assert.Equal(t, []byte("tkn3"), batch.Deposits[1].DestinationTokenBytes)
+
+				return "hash", nil
+			},
+			SignCalled: func(ctx context.Context, actionID uint64) (string, error) {
+				assert.Fail(t, "should have not called sign")
+				return "", nil
+			},
+		}
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.ProposeOrSign(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, proposeCalled)
+	})
+	t.Run("propose errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.MultiversXClient = &bridge.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (bool, error) {
+				return false, nil
+			},
+			ProposeTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (string, error) {
+				return "", expectedErr
+			},
+		}
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.ProposeOrSign(context.Background())
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("proposed and not signed should sign", func(t *testing.T) {
+		t.Parallel()
+
+		signCalled := false
+		args := createMockArgsMigrationBatchExecutor()
+		args.MultiversXClient = &bridge.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (bool, error) {
+				return true, nil
+			},
+			ProposeTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (string, error) {
+				assert.Fail(t, "should have not called propose")
+				return "", nil
+			},
+			GetActionIDForProposeTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (uint64, error) {
+				return testActionID, nil
+			},
+			WasSignedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return false, nil
+			},
+			SignCalled: func(ctx context.Context, actionID uint64) (string, error) {
+				signCalled = true
+				assert.Equal(t, testActionID, actionID)
+				return "hash", nil
+			},
+		}
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.ProposeOrSign(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, signCalled)
+	})
+	t.Run("already signed should not sign again", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.MultiversXClient = &bridge.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (bool, error) {
+				return true, nil
+			},
+			GetActionIDForProposeTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (uint64, error) {
+				return testActionID, nil
+			},
+			WasSignedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return true, nil
+			},
+			SignCalled: func(ctx context.Context, actionID uint64) (string, error) {
+				assert.Fail(t, "should have not called sign")
+				return "", nil
+			},
+		}
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.ProposeOrSign(context.Background())
+		assert.Nil(t, err)
+	})
+}
+
+func TestMigrationBatchExecutor_ExecuteTransfer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not proposed should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.MultiversXClient = &bridge.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (bool, error) {
+				return false, nil
+			},
+		}
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.ExecuteTransfer(context.Background())
+		assert.ErrorIs(t, err, errTransferNotProposed)
+	})
+	t.Run("already executed should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.MultiversXClient = &bridge.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (bool, error) {
+				return true, nil
+			},
+			WasExecutedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return true, nil
+			},
+		}
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.ExecuteTransfer(context.Background())
+		assert.ErrorIs(t, err, errActionAlreadyExecuted)
+	})
+	t.Run("quorum not reached should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMigrationBatchExecutor()
+		args.MultiversXClient = &bridge.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (bool, error) {
+				return true, nil
+			},
+			QuorumReachedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return false, nil
+			},
+			PerformActionCalled: func(ctx context.Context, actionID uint64, batch *core.TransferBatch) (string, error) {
+				assert.Fail(t, "should have not called perform action")
+				return "", nil
+			},
+		}
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.ExecuteTransfer(context.Background())
+		assert.ErrorIs(t, err, errQuorumNotReached)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		performCalled := false
+		args := createMockArgsMigrationBatchExecutor()
+		args.MultiversXClient = &bridge.MultiversXClientStub{
+			WasProposedTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (bool, error) {
+				return true, nil
+			},
+			GetActionIDForProposeTransferCalled: func(ctx context.Context, batch *core.TransferBatch) (uint64, error) {
+				return testActionID, nil
+			},
+			QuorumReachedCalled: func(ctx context.Context, actionID uint64) (bool, error) {
+				return true, nil
+			},
+			PerformActionCalled: func(ctx context.Context, actionID uint64, batch *core.TransferBatch) (string, error) {
+				performCalled = true
+				assert.Equal(t, testActionID, actionID)
+				assert.Equal(t, uint64(4433), batch.ID)
+
+				return "hash", nil
+			},
+		}
+		executor, _ := NewMigrationBatchExecutor(args)
+
+		err := executor.ExecuteTransfer(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, performCalled)
+	})
+}
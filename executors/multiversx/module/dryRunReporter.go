@@ -0,0 +1,68 @@
+package module
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const reportFilePermissions = 0644
+
+// dryRunReporter logs the dry run reports and optionally exports them in a json file. It can request the
+// application to close after the first report
+type dryRunReporter struct {
+	log                  logger.Logger
+	reportFile           string
+	exitAfterFirstReport bool
+	chCloseApp           chan struct{}
+}
+
+// HandleDryRunReport logs and exports the provided report
+func (reporter *dryRunReporter) HandleDryRunReport(report *core.ScCallDryRunReport) {
+	reporter.log.Info("dry run report", "num pending operations", len(report.Operations))
+	for _, operation := range report.Operations {
+		reporter.log.Info("dry run operation",
+			"ID", operation.ID,
+			"filter decision", operation.FilterDecision,
+			"outcome", operation.Outcome,
+			"declared gas limit", operation.DeclaredGasLimit,
+			"gas limit", operation.GasLimit,
+			"sender", operation.Sender,
+			"to", operation.To,
+			"token", operation.Token,
+			"amount", operation.Amount,
+			"error", operation.Error)
+	}
+
+	if len(reporter.reportFile) > 0 {
+		err := reporter.exportReport(report)
+		if err != nil {
+			reporter.log.Error("can not export the dry run report", "file", reporter.reportFile, "error", err)
+		} else {
+			reporter.log.Info("dry run report exported", "file", reporter.reportFile)
+		}
+	}
+
+	if reporter.exitAfterFirstReport {
+		select {
+		case reporter.chCloseApp <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (reporter *dryRunReporter) exportReport(report *core.ScCallDryRunReport) error {
+	buff, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(reporter.reportFile, buff, reportFilePermissions)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (reporter *dryRunReporter) IsInterfaceNil() bool {
+	return reporter == nil
+}
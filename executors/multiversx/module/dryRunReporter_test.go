@@ -0,0 +1,86 @@
+package module
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDryRunReport() *core.ScCallDryRunReport {
+	return &core.ScCallDryRunReport{
+		Timestamp: 1000,
+		Operations: []*core.ScCallDryRunOperation{
+			{
+				ScCallPendingOperation: core.ScCallPendingOperation{
+					ID:             1,
+					Token:          "tkn",
+					Amount:         "37",
+					FilterDecision: core.ScCallAllowed,
+				},
+				Outcome:          core.ScCallDryRunWouldExecute,
+				Sender:           "erd1sender",
+				DeclaredGasLimit: 5000000,
+				GasLimit:         11000000,
+			},
+			{
+				ScCallPendingOperation: core.ScCallPendingOperation{
+					ID: 2,
+				},
+				Outcome: core.ScCallDryRunNotSelected,
+			},
+		},
+	}
+}
+
+func TestDryRunReporter_HandleDryRunReport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should export the report and request the application close", func(t *testing.T) {
+		t.Parallel()
+
+		chCloseApp := make(chan struct{}, 1)
+		reporter := &dryRunReporter{
+			log:                  &testsCommon.LoggerStub{},
+			reportFile:           filepath.Join(t.TempDir(), "report.json"),
+			exitAfterFirstReport: true,
+			chCloseApp:           chCloseApp,
+		}
+
+		report := createTestDryRunReport()
+		reporter.HandleDryRunReport(report)
+		// the second report should not block even if the channel is full
+		reporter.HandleDryRunReport(report)
+
+		buff, err := os.ReadFile(reporter.reportFile)
+		require.Nil(t, err)
+		exported := &core.ScCallDryRunReport{}
+		err = json.Unmarshal(buff, exported)
+		require.Nil(t, err)
+		assert.Equal(t, report, exported)
+		assert.Equal(t, 1, len(chCloseApp))
+	})
+	t.Run("should not export or close without the options set", func(t *testing.T) {
+		t.Parallel()
+
+		chCloseApp := make(chan struct{}, 1)
+		numLogs := 0
+		reporter := &dryRunReporter{
+			log: &testsCommon.LoggerStub{
+				InfoCalled: func(message string, args ...interface{}) {
+					numLogs++
+				},
+			},
+			chCloseApp: chCloseApp,
+		}
+
+		reporter.HandleDryRunReport(createTestDryRunReport())
+		assert.Equal(t, 3, numLogs)
+		assert.Equal(t, 0, len(chCloseApp))
+	})
+}
@@ -0,0 +1,9 @@
+package module
+
+import "errors"
+
+var (
+	errFilterRulesFileNotConfigured = errors.New("the filter rules file is not configured")
+	errUnknownCoordinationMode      = errors.New("unknown coordination mode")
+	errNilCloseAppChannel           = errors.New("nil close application channel")
+)
@@ -3,6 +3,7 @@ package module
 import (
 	"context"
 
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-sdk-go/core"
 )
@@ -10,6 +11,7 @@ import (
 type nonceTransactionsHandler interface {
 	ApplyNonceAndGasPrice(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error
 	SendTransaction(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
+	DropTransactions(address core.AddressHandler) error
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -23,5 +25,30 @@ type pollingHandler interface {
 type executor interface {
 	Execute(ctx context.Context) error
 	GetNumSentTransaction() uint32
+	GetPendingOperations() []*bridgeCore.ScCallPendingOperation
+	GetRecentExecutions() []*bridgeCore.ScCallExecution
+	GetSendersStatus(ctx context.Context) ([]*bridgeCore.ScCallsSenderStatus, error)
+	IsInterfaceNil() bool
+}
+
+type executionJournal interface {
+	ShouldExecute(id uint64) bool
+	RecordAttempt(id uint64, txHash string, checkResults bool)
+	RecordResult(id uint64, err error)
+	ReleaseFromQuarantine(ids []uint64) []uint64
+	GetQuarantined() []uint64
+	Close() error
+	IsInterfaceNil() bool
+}
+
+type filterRulesReloader interface {
+	Reload() error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+type executionCoordinator interface {
+	ShouldExecute(id uint64) bool
+	Close() error
 	IsInterfaceNil() bool
 }
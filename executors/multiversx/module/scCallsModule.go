@@ -1,15 +1,26 @@
 package module
 
 import (
+	"context"
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx"
+	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/coordination"
 	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/filters"
+	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/journal"
+	"github.com/multiversx/mx-bridge-eth-go/factory"
 	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-bridge-eth-go/status"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519/singlesig"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-sdk-go/blockchain"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
@@ -18,6 +29,12 @@ import (
 	"github.com/multiversx/mx-sdk-go/interactors/nonceHandlerV2"
 )
 
+const (
+	coordinationModeNone      = "none"
+	coordinationModePartition = "partition"
+	coordinationModeLease     = "lease"
+)
+
 var suite = ed25519.NewEd25519()
 var keyGen = signing.NewKeyGenerator(suite)
 var singleSigner = &singlesig.Ed25519Signer{}
@@ -26,15 +43,42 @@ type scCallsModule struct {
 	nonceTxsHandler  nonceTransactionsHandler
 	pollingHandler   pollingHandler
 	executorInstance executor
+	journal          executionJournal
+	metricsHolder    core.MetricsHolder
+	filterReloader   filterRulesReloader
+	coordinator      executionCoordinator
 }
 
 // NewScCallsModule creates a starts a new scCallsModule instance
 func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chCloseApp chan struct{}) (*scCallsModule, error) {
-	filter, err := filters.NewPendingOperationFilter(cfg.Filter, log)
+	if cfg.DryRun.Enabled && cfg.DryRun.ExitAfterFirstReport && chCloseApp == nil {
+		return nil, errNilCloseAppChannel
+	}
+
+	module := &scCallsModule{}
+	var err error
+	defer func() {
+		if err != nil {
+			// the components already built should not outlive a failed construction
+			_ = module.Close()
+		}
+	}()
+
+	filter, err := module.createFilter(cfg, log)
 	if err != nil {
 		return nil, err
 	}
 
+	if cfg.DryRun.Enabled {
+		// a dry run instance should not take part in the coordination of the instances that send transactions
+		module.coordinator = coordination.NewDisabledCoordinator()
+	} else {
+		module.coordinator, err = createCoordinator(cfg.Coordination, log)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	argsProxy := blockchain.ArgsProxy{
 		ProxyURL:            cfg.NetworkAddress,
 		SameScState:         false,
@@ -50,8 +94,6 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 		return nil, err
 	}
 
-	module := &scCallsModule{}
-
 	argNonceHandler := nonceHandlerV2.ArgsNonceTransactionsHandlerV2{
 		Proxy:            proxy,
 		IntervalToResend: time.Second * time.Duration(cfg.IntervalToResendTxsInSeconds),
@@ -61,13 +103,40 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 		return nil, err
 	}
 
-	wallet := interactors.NewWallet()
-	multiversXPrivateKeyBytes, err := wallet.LoadPrivateKeyFromPemFile(cfg.PrivateKeyFile)
+	privateKeyFiles := append([]string{cfg.PrivateKeyFile}, cfg.SenderWallets.PrivateKeyFiles...)
+	privateKeys, err := loadPrivateKeys(privateKeyFiles)
 	if err != nil {
 		return nil, err
 	}
 
-	privateKey, err := keyGen.PrivateKeyFromByteArray(multiversXPrivateKeyBytes)
+	journalStorer, err := createJournalStorer(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	argsJournal := journal.ArgsExecutionJournal{
+		Log:                   log,
+		Storer:                journalStorer,
+		MaxFinalizedEntries:   cfg.ExecutionJournal.MaxFinalizedEntries,
+		MaxUnfinalizedEntries: cfg.ExecutionJournal.MaxUnfinalizedEntries,
+		MaxAttempts:           cfg.ExecutionJournal.MaxAttempts,
+		InitialBackoff:        time.Second * time.Duration(cfg.ExecutionJournal.InitialBackoffInSeconds),
+		MaxBackoff:            time.Second * time.Duration(cfg.ExecutionJournal.MaxBackoffInSeconds),
+	}
+	module.journal, err = journal.NewExecutionJournal(argsJournal)
+	if err != nil {
+		_ = journalStorer.Close()
+		return nil, err
+	}
+	module.releaseQuarantinedOnStart(cfg.ReleaseQuarantined, log)
+
+	// the executor metrics are persisted in the same storer as the journal entries, under the status handler's name
+	statusHandler, err := status.NewStatusHandler(core.ScCallsExecutorStatusHandlerName, journalStorer)
+	if err != nil {
+		return nil, err
+	}
+	module.metricsHolder = status.NewMetricsHolder()
+	err = module.metricsHolder.AddStatusHandler(statusHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -82,10 +151,27 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 		MaxGasLimitToUse:                cfg.MaxGasLimitToUse,
 		GasLimitForOutOfGasTransactions: cfg.GasLimitForOutOfGasTransactions,
 		NonceTxHandler:                  module.nonceTxsHandler,
-		PrivateKey:                      privateKey,
+		PrivateKeys:                     privateKeys,
+		SenderWallets:                   cfg.SenderWallets,
 		SingleSigner:                    singleSigner,
 		CloseAppChan:                    chCloseApp,
 		TransactionChecks:               cfg.TransactionChecks,
+		GasEstimation:                   cfg.GasEstimation,
+		NumWorkers:                      cfg.NumWorkers,
+		MaxTransactionsInFlight:         cfg.MaxTransactionsInFlight,
+		Journal:                         module.journal,
+		Coordinator:                     module.coordinator,
+		StatusHandler:                   statusHandler,
+		DryRun:                          cfg.DryRun.Enabled,
+	}
+	if cfg.DryRun.Enabled {
+		log.Warn("running in dry run mode, no transactions will be sent")
+		argsExecutor.DryRunReportHandler = &dryRunReporter{
+			log:                  log,
+			reportFile:           cfg.DryRun.ReportFile,
+			exitAfterFirstReport: cfg.DryRun.ExitAfterFirstReport,
+			chCloseApp:           chCloseApp,
+		}
 	}
 	module.executorInstance, err = multiversx.NewScCallExecutor(argsExecutor)
 	if err != nil {
@@ -113,18 +199,189 @@ func NewScCallsModule(cfg config.ScCallsModuleConfig, log logger.Logger, chClose
 	return module, nil
 }
 
+func (module *scCallsModule) createFilter(cfg config.ScCallsModuleConfig, log logger.Logger) (multiversx.ScCallsExecuteFilter, error) {
+	if len(cfg.FilterRules.RulesFile) == 0 {
+		return filters.NewPendingOperationFilter(cfg.Filter, log)
+	}
+
+	argsFilter := filters.ArgsReloadableFilter{
+		Log:           log,
+		RulesFile:     cfg.FilterRules.RulesFile,
+		CheckInterval: time.Second * time.Duration(cfg.FilterRules.CheckIntervalInSeconds),
+	}
+	filter, err := filters.NewReloadableFilter(argsFilter)
+	if err != nil {
+		return nil, err
+	}
+	module.filterReloader = filter
+
+	return filter, nil
+}
+
+// releaseQuarantinedOnStart releases the requested quarantined operations before the first execution round
+func (module *scCallsModule) releaseQuarantinedOnStart(cfg config.QuarantineReleaseConfig, log logger.Logger) {
+	ids := cfg.IDs
+	if cfg.All {
+		ids = module.journal.GetQuarantined()
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	released := module.journal.ReleaseFromQuarantine(ids)
+	log.Info("released operations from quarantine", "requested", ids, "released", released)
+}
+
+func loadPrivateKeys(privateKeyFiles []string) ([]crypto.PrivateKey, error) {
+	wallet := interactors.NewWallet()
+	privateKeys := make([]crypto.PrivateKey, 0, len(privateKeyFiles))
+	for _, privateKeyFile := range privateKeyFiles {
+		multiversXPrivateKeyBytes, err := wallet.LoadPrivateKeyFromPemFile(privateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("%w while loading the private key file %s", err, privateKeyFile)
+		}
+
+		privateKey, err := keyGen.PrivateKeyFromByteArray(multiversXPrivateKeyBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		privateKeys = append(privateKeys, privateKey)
+	}
+
+	return privateKeys, nil
+}
+
+func createCoordinator(cfg config.CoordinationConfig, log logger.Logger) (executionCoordinator, error) {
+	switch cfg.Mode {
+	case "", coordinationModeNone:
+		return coordination.NewDisabledCoordinator(), nil
+	case coordinationModePartition:
+		argsCoordinator := coordination.ArgsPartitionCoordinator{
+			Log:               log,
+			InstanceIndex:     cfg.InstanceIndex,
+			NumInstances:      cfg.NumInstances,
+			TakeoverDelay:     time.Second * time.Duration(cfg.TakeoverDelayInSeconds),
+			TakeoverStateFile: cfg.TakeoverStateFile,
+		}
+
+		return coordination.NewPartitionCoordinator(argsCoordinator)
+	case coordinationModeLease:
+		instanceID := cfg.InstanceID
+		if len(instanceID) == 0 {
+			instanceID = createDefaultInstanceID()
+		}
+
+		argsCoordinator := coordination.ArgsFileLeaseCoordinator{
+			Log:           log,
+			LeaseFile:     cfg.LeaseFile,
+			InstanceID:    instanceID,
+			LeaseDuration: time.Second * time.Duration(cfg.LeaseDurationInSeconds),
+		}
+
+		return coordination.NewFileLeaseCoordinator(argsCoordinator)
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownCoordinationMode, cfg.Mode)
+	}
+}
+
+// createJournalStorer creates a memory only storer in dry run mode, so the journal and the metrics of an instance
+// sending transactions from the same working directory are not altered
+func createJournalStorer(cfg config.ScCallsModuleConfig) (core.Storer, error) {
+	storageConfig := cfg.ExecutionJournal.Storage
+	if cfg.DryRun.Enabled {
+		storageConfig.DB.Type = string(storageunit.MemoryDB)
+	}
+
+	return factory.CreateUnitStorer(storageConfig, "")
+}
+
+func createDefaultInstanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	return fmt.Sprintf("%s-%d", hostname, os.Getpid())
+}
+
 // GetNumSentTransaction returns the total sent transactions
 func (module *scCallsModule) GetNumSentTransaction() uint32 {
 	return module.executorInstance.GetNumSentTransaction()
 }
 
+// GetMetricsHolder returns the metrics holder containing the executor metrics
+func (module *scCallsModule) GetMetricsHolder() core.MetricsHolder {
+	return module.metricsHolder
+}
+
+// GetPendingOperations returns the pending operations, as seen on the last execution, along with the filter decision
+func (module *scCallsModule) GetPendingOperations() []*core.ScCallPendingOperation {
+	return module.executorInstance.GetPendingOperations()
+}
+
+// GetRecentExecutions returns the most recent executions with their hashes and outcomes
+func (module *scCallsModule) GetRecentExecutions() []*core.ScCallExecution {
+	return module.executorInstance.GetRecentExecutions()
+}
+
+// GetSendersStatus returns the balance, the nonce and the state of the accounts used to send the execution transactions
+func (module *scCallsModule) GetSendersStatus(ctx context.Context) ([]*core.ScCallsSenderStatus, error) {
+	return module.executorInstance.GetSendersStatus(ctx)
+}
+
+// ReleaseFromQuarantine will allow the provided quarantined operations to be executed again. It returns the IDs
+// of the released operations
+func (module *scCallsModule) ReleaseFromQuarantine(ids []uint64) []uint64 {
+	return module.journal.ReleaseFromQuarantine(ids)
+}
+
+// GetQuarantined returns the IDs of the quarantined operations
+func (module *scCallsModule) GetQuarantined() []uint64 {
+	return module.journal.GetQuarantined()
+}
+
+// ReloadFilterRules will reload the filter rules from the configured rules file
+func (module *scCallsModule) ReloadFilterRules() error {
+	if check.IfNil(module.filterReloader) {
+		return errFilterRulesFileNotConfigured
+	}
+
+	return module.filterReloader.Reload()
+}
+
 // Close closes any components started
 func (module *scCallsModule) Close() error {
-	errPollingHandler := module.pollingHandler.Close()
-	errNonceTxsHandler := module.nonceTxsHandler.Close()
-
-	if errPollingHandler != nil {
-		return errPollingHandler
+	var lastErr error
+	if !check.IfNil(module.pollingHandler) {
+		err := module.pollingHandler.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+	if !check.IfNil(module.nonceTxsHandler) {
+		err := module.nonceTxsHandler.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+	if !check.IfNil(module.journal) {
+		err := module.journal.Close()
+		if err != nil {
+			lastErr = err
+		}
 	}
-	return errNonceTxsHandler
+	if !check.IfNil(module.filterReloader) {
+		_ = module.filterReloader.Close()
+	}
+	if !check.IfNil(module.coordinator) {
+		_ = module.coordinator.Close()
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (module *scCallsModule) IsInterfaceNil() bool {
+	return module == nil
 }
@@ -1,10 +1,18 @@
 package module
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/coordination"
+	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/journal"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	chainConfig "github.com/multiversx/mx-chain-go/config"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/stretchr/testify/assert"
 )
@@ -23,6 +31,8 @@ func createTestConfigs() config.ScCallsModuleConfig {
 		IntervalToResendTxsInSeconds:    1,
 		PrivateKeyFile:                  "testdata/grace.pem",
 		PollingIntervalInMillis:         10000,
+		NumWorkers:                      4,
+		MaxTransactionsInFlight:         20,
 		Filter: config.PendingOperationsFilterConfig{
 			DeniedEthAddresses:  nil,
 			AllowedEthAddresses: []string{"*"},
@@ -31,12 +41,41 @@ func createTestConfigs() config.ScCallsModuleConfig {
 			DeniedTokens:        nil,
 			AllowedTokens:       []string{"*"},
 		},
+		ExecutionJournal: config.ExecutionJournalConfig{
+			Storage: chainConfig.StorageConfig{
+				Cache: chainConfig.CacheConfig{
+					Name:     "ExecutionJournal",
+					Type:     "LRU",
+					Capacity: 100,
+				},
+				DB: chainConfig.DBConfig{
+					FilePath: "ExecutionJournalDB",
+					Type:     "MemoryDB",
+				},
+			},
+			MaxFinalizedEntries:     100,
+			MaxUnfinalizedEntries:   100,
+			MaxAttempts:             3,
+			InitialBackoffInSeconds: 1,
+			MaxBackoffInSeconds:     10,
+		},
 	}
 }
 
 func TestNewScCallsModule(t *testing.T) {
 	t.Parallel()
 
+	t.Run("dry run exiting after the first report with nil close app chan should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.DryRun.Enabled = true
+		cfg.DryRun.ExitAfterFirstReport = true
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.Equal(t, errNilCloseAppChannel, err)
+		assert.Nil(t, module)
+	})
 	t.Run("invalid filter config should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -48,6 +87,40 @@ func TestNewScCallsModule(t *testing.T) {
 		assert.Contains(t, err.Error(), "unsupported marker * on item at index 0 in list DeniedTokens")
 		assert.Nil(t, module)
 	})
+	t.Run("missing filter rules file should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.FilterRules.RulesFile = "testdata/missing.toml"
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.NotNil(t, err)
+		assert.Nil(t, module)
+	})
+	t.Run("unknown coordination mode should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Coordination.Mode = "unknown"
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.True(t, errors.Is(err, errUnknownCoordinationMode))
+		assert.Contains(t, err.Error(), "unknown")
+		assert.Nil(t, module)
+	})
+	t.Run("invalid partition coordination config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Coordination.Mode = coordinationModePartition
+		cfg.Coordination.NumInstances = 2
+		cfg.Coordination.InstanceIndex = 2
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid value for InstanceIndex")
+		assert.Nil(t, module)
+	})
 	t.Run("invalid proxy cacher interval expiration should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -80,6 +153,39 @@ func TestNewScCallsModule(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, module)
 	})
+	t.Run("invalid sender wallet file should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.SenderWallets.PrivateKeyFiles = []string{"testdata/missing.pem"}
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "testdata/missing.pem")
+		assert.Nil(t, module)
+	})
+	t.Run("duplicated sender wallet should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.SenderWallets.PrivateKeyFiles = []string{cfg.PrivateKeyFile}
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "duplicated sender wallet")
+		assert.Nil(t, module)
+	})
+	t.Run("invalid execution journal config should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.ExecutionJournal.MaxAttempts = 0
+
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid value for MaxAttempts")
+		assert.Nil(t, module)
+	})
 	t.Run("invalid polling interval should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -117,8 +223,171 @@ func TestNewScCallsModule(t *testing.T) {
 		assert.NotNil(t, module)
 
 		assert.Zero(t, module.GetNumSentTransaction())
+		assert.Empty(t, module.GetPendingOperations())
+		assert.Empty(t, module.GetRecentExecutions())
+		assert.Equal(t, []string{core.ScCallsExecutorStatusHandlerName}, module.GetMetricsHolder().GetAvailableStatusHandlers())
+		assert.Empty(t, module.GetQuarantined())
+		assert.Empty(t, module.ReleaseFromQuarantine([]uint64{1}))
+		assert.Equal(t, errFilterRulesFileNotConfigured, module.ReloadFilterRules())
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("should work with filter rules file", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.FilterRules.RulesFile = "testdata/filterRules.toml"
+		cfg.FilterRules.CheckIntervalInSeconds = 1
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, module)
+
+		assert.Nil(t, module.ReloadFilterRules())
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("should work with multiple sender wallets", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.SenderWallets.PrivateKeyFiles = []string{"testdata/executor2.pem"}
+		cfg.SenderWallets.MinBalance = "1000"
+		cfg.SenderWallets.BalanceCheckIntervalInSeconds = 60
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, module)
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("should work with lease coordination", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.Coordination.Mode = coordinationModeLease
+		cfg.Coordination.LeaseFile = filepath.Join(t.TempDir(), "executor.lease")
+		cfg.Coordination.LeaseDurationInSeconds = 30
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, module)
+		assert.True(t, module.coordinator.ShouldExecute(1))
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("failing after the coordinator was created should release the lease", func(t *testing.T) {
+		t.Parallel()
+
+		leaseFile := filepath.Join(t.TempDir(), "executor.lease")
+		cfg := createTestConfigs()
+		cfg.Coordination.Mode = coordinationModeLease
+		cfg.Coordination.LeaseFile = leaseFile
+		cfg.Coordination.LeaseDurationInSeconds = 30
+		cfg.Coordination.InstanceID = "instance 1"
+		cfg.PollingIntervalInMillis = 0
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.NotNil(t, err)
+		assert.Nil(t, module)
+
+		cfg = createTestConfigs()
+		cfg.Coordination.Mode = coordinationModeLease
+		cfg.Coordination.LeaseFile = leaseFile
+		cfg.Coordination.LeaseDurationInSeconds = 30
+		cfg.Coordination.InstanceID = "instance 2"
+		module, err = NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.Nil(t, err)
+		assert.True(t, module.coordinator.ShouldExecute(1))
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("should work in dry run mode", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createTestConfigs()
+		cfg.DryRun.Enabled = true
+		cfg.DryRun.ReportFile = filepath.Join(t.TempDir(), "report.json")
+		cfg.DryRun.ExitAfterFirstReport = true
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, make(chan struct{}, 1))
+		assert.Nil(t, err)
+		assert.NotNil(t, module)
+
+		err = module.Close()
+		assert.Nil(t, err)
+	})
+	t.Run("dry run should not use the coordination and the persistent journal", func(t *testing.T) {
+		t.Parallel()
+
+		workingDir := t.TempDir()
+		leaseFile := filepath.Join(workingDir, "executor.lease")
+		journalPath := filepath.Join(workingDir, "ExecutionJournalDB")
+		cfg := createTestConfigs()
+		cfg.DryRun.Enabled = true
+		cfg.Coordination.Mode = coordinationModeLease
+		cfg.Coordination.LeaseFile = leaseFile
+		cfg.Coordination.LeaseDurationInSeconds = 30
+		cfg.ExecutionJournal.Storage.DB.Type = "LvlDBSerial"
+		cfg.ExecutionJournal.Storage.DB.FilePath = journalPath
+		module, err := NewScCallsModule(cfg, &testsCommon.LoggerStub{}, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, module)
+		assert.IsType(t, coordination.NewDisabledCoordinator(), module.coordinator)
+
+		_, err = os.Stat(leaseFile)
+		assert.True(t, os.IsNotExist(err))
+		_, err = os.Stat(journalPath)
+		assert.True(t, os.IsNotExist(err))
 
 		err = module.Close()
 		assert.Nil(t, err)
 	})
 }
+
+func TestScCallsModule_ReleaseQuarantinedOnStart(t *testing.T) {
+	t.Parallel()
+
+	createModuleWithQuarantined := func(ids ...uint64) *scCallsModule {
+		argsJournal := journal.ArgsExecutionJournal{
+			Log:                   &testsCommon.LoggerStub{},
+			Storer:                testsCommon.NewStorerMock(),
+			MaxFinalizedEntries:   10,
+			MaxUnfinalizedEntries: 10,
+			MaxAttempts:           1,
+			InitialBackoff:        time.Second,
+			MaxBackoff:            time.Second,
+		}
+		executionJournal, _ := journal.NewExecutionJournal(argsJournal)
+		for _, id := range ids {
+			executionJournal.RecordAttempt(id, "hash", true)
+			executionJournal.RecordResult(id, errors.New("failed"))
+		}
+
+		return &scCallsModule{
+			journal: executionJournal,
+		}
+	}
+
+	t.Run("should release the provided IDs", func(t *testing.T) {
+		t.Parallel()
+
+		module := createModuleWithQuarantined(1, 2, 3)
+		module.releaseQuarantinedOnStart(config.QuarantineReleaseConfig{IDs: []uint64{2, 4}}, &testsCommon.LoggerStub{})
+		assert.Equal(t, []uint64{1, 3}, module.GetQuarantined())
+	})
+	t.Run("should release all", func(t *testing.T) {
+		t.Parallel()
+
+		module := createModuleWithQuarantined(1, 2, 3)
+		module.releaseQuarantinedOnStart(config.QuarantineReleaseConfig{All: true}, &testsCommon.LoggerStub{})
+		assert.Empty(t, module.GetQuarantined())
+	})
+	t.Run("nothing requested should not release", func(t *testing.T) {
+		t.Parallel()
+
+		module := createModuleWithQuarantined(1)
+		module.releaseQuarantinedOnStart(config.QuarantineReleaseConfig{}, &testsCommon.LoggerStub{})
+		assert.Equal(t, []uint64{1}, module.GetQuarantined())
+	})
+}
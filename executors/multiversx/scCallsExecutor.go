@@ -6,18 +6,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/errors"
 	"github.com/multiversx/mx-bridge-eth-go/parsers"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
 	"github.com/multiversx/mx-sdk-go/builders"
-	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 )
 
@@ -26,9 +29,14 @@ const (
 	okCodeAfterExecution           = "ok"
 	scProxyCallFunction            = "execute"
 	minCheckValues                 = 1
+	minNumWorkers                  = 1
+	minTransactionsInFlight        = 1
+	minGasDeviationWarningFactor   = 2
+	percentDivider                 = 100
 	transactionNotFoundErrString   = "transaction not found"
 	minGasToExecuteSCCalls         = 2010000 // the absolut minimum gas limit to do a SC call
 	contractMaxGasLimit            = 249999999
+	maxRecentExecutions            = 100
 )
 
 // ArgsScCallExecutor represents the DTO struct for creating a new instance of type scCallExecutor
@@ -37,15 +45,44 @@ type ArgsScCallExecutor struct {
 	Proxy                           Proxy
 	Codec                           Codec
 	Filter                          ScCallsExecuteFilter
+	Journal                         ExecutionJournal
+	Coordinator                     ExecutionCoordinator
+	StatusHandler                   bridgeCore.StatusHandler
 	Log                             logger.Logger
 	ExtraGasToExecute               uint64
 	MaxGasLimitToUse                uint64
 	GasLimitForOutOfGasTransactions uint64
 	NonceTxHandler                  NonceTransactionsHandler
-	PrivateKey                      crypto.PrivateKey
+	PrivateKeys                     []crypto.PrivateKey
+	SenderWallets                   config.SenderWalletsConfig
 	SingleSigner                    crypto.SingleSigner
 	TransactionChecks               config.TransactionChecksConfig
+	GasEstimation                   config.GasEstimationConfig
 	CloseAppChan                    chan struct{}
+	NumWorkers                      int
+	MaxTransactionsInFlight         int
+	DryRun                          bool
+	DryRunReportHandler             DryRunReportHandler
+}
+
+// executionOutcome defines what the executor does with a built execution transaction
+type executionOutcome int
+
+const (
+	outcomeExecute executionOutcome = iota
+	outcomeExecuteOutOfGas
+	outcomeSkip
+)
+
+type transactionHashComputer interface {
+	ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error)
+}
+
+type pendingTransaction struct {
+	id       uint64
+	callData parsers.ProxySCCompleteCallData
+	tx       *transaction.FrontendTransaction
+	hash     string
 }
 
 type scCallExecutor struct {
@@ -53,14 +90,16 @@ type scCallExecutor struct {
 	proxy                           Proxy
 	codec                           Codec
 	filter                          ScCallsExecuteFilter
+	journal                         ExecutionJournal
+	coordinator                     ExecutionCoordinator
 	log                             logger.Logger
 	extraGasToExecute               uint64
 	maxGasLimitToUse                uint64
 	gasLimitForOutOfGasTransactions uint64
 	nonceTxHandler                  NonceTransactionsHandler
-	privateKey                      crypto.PrivateKey
 	singleSigner                    crypto.SingleSigner
-	senderAddress                   core.AddressHandler
+	txHashComputer                  transactionHashComputer
+	senders                         *sendersPool
 	numSentTransactions             uint32
 	checkTransactionResults         bool
 	timeBetweenChecks               time.Duration
@@ -68,6 +107,12 @@ type scCallExecutor struct {
 	closeAppOnError                 bool
 	extraDelayOnError               time.Duration
 	closeAppChan                    chan struct{}
+	numWorkers                      int
+	maxTransactionsInFlight         int
+	gasEstimation                   config.GasEstimationConfig
+	statusTracker                   *statusTracker
+	dryRun                          bool
+	dryRunReportHandler             DryRunReportHandler
 }
 
 // NewScCallExecutor creates a new instance of type scCallExecutor
@@ -77,32 +122,52 @@ func NewScCallExecutor(args ArgsScCallExecutor) (*scCallExecutor, error) {
 		return nil, err
 	}
 
-	publicKey := args.PrivateKey.GeneratePublic()
-	publicKeyBytes, err := publicKey.ToByteArray()
+	argsSenders := argsSendersPool{
+		proxy:                args.Proxy,
+		log:                  args.Log,
+		statusHandler:        args.StatusHandler,
+		privateKeys:          args.PrivateKeys,
+		scProxyBech32Address: args.ScProxyBech32Address,
+		cfg:                  args.SenderWallets,
+	}
+	senders, err := newSendersPool(argsSenders)
+	if err != nil {
+		return nil, err
+	}
+
+	// the transactions hashes are computed locally, the signer is not used for that
+	txHashComputer, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
 	if err != nil {
 		return nil, err
 	}
-	senderAddress := data.NewAddressFromBytes(publicKeyBytes)
 
 	return &scCallExecutor{
 		scProxyBech32Address:            args.ScProxyBech32Address,
 		proxy:                           args.Proxy,
 		codec:                           args.Codec,
 		filter:                          args.Filter,
+		journal:                         args.Journal,
+		coordinator:                     args.Coordinator,
 		log:                             args.Log,
 		extraGasToExecute:               args.ExtraGasToExecute,
 		maxGasLimitToUse:                args.MaxGasLimitToUse,
 		gasLimitForOutOfGasTransactions: args.GasLimitForOutOfGasTransactions,
 		nonceTxHandler:                  args.NonceTxHandler,
-		privateKey:                      args.PrivateKey,
 		singleSigner:                    args.SingleSigner,
-		senderAddress:                   senderAddress,
+		txHashComputer:                  txHashComputer,
+		senders:                         senders,
 		checkTransactionResults:         args.TransactionChecks.CheckTransactionResults,
 		timeBetweenChecks:               time.Second * time.Duration(args.TransactionChecks.TimeInSecondsBetweenChecks),
 		executionTimeout:                time.Second * time.Duration(args.TransactionChecks.ExecutionTimeoutInSeconds),
 		closeAppOnError:                 args.TransactionChecks.CloseAppOnError,
 		extraDelayOnError:               time.Second * time.Duration(args.TransactionChecks.ExtraDelayInSecondsOnError),
 		closeAppChan:                    args.CloseAppChan,
+		numWorkers:                      args.NumWorkers,
+		maxTransactionsInFlight:         args.MaxTransactionsInFlight,
+		gasEstimation:                   args.GasEstimation,
+		statusTracker:                   newStatusTracker(args.StatusHandler, maxRecentExecutions),
+		dryRun:                          args.DryRun,
+		dryRunReportHandler:             args.DryRunReportHandler,
 	}, nil
 }
 
@@ -116,18 +181,34 @@ func checkArgs(args ArgsScCallExecutor) error {
 	if check.IfNil(args.Filter) {
 		return errNilFilter
 	}
+	if check.IfNil(args.Journal) {
+		return errNilExecutionJournal
+	}
+	if check.IfNil(args.Coordinator) {
+		return errNilExecutionCoordinator
+	}
+	if check.IfNil(args.StatusHandler) {
+		return errNilStatusHandler
+	}
 	if check.IfNil(args.Log) {
 		return errNilLogger
 	}
 	if check.IfNil(args.NonceTxHandler) {
 		return errNilNonceTxHandler
 	}
-	if check.IfNil(args.PrivateKey) {
-		return errNilPrivateKey
-	}
 	if check.IfNil(args.SingleSigner) {
 		return errNilSingleSigner
 	}
+	if args.DryRun && check.IfNil(args.DryRunReportHandler) {
+		return errNilDryRunReportHandler
+	}
+	if args.NumWorkers < minNumWorkers {
+		return fmt.Errorf("%w for NumWorkers: provided: %d, minimum: %d", errInvalidValue, args.NumWorkers, minNumWorkers)
+	}
+	if args.MaxTransactionsInFlight < minTransactionsInFlight {
+		return fmt.Errorf("%w for MaxTransactionsInFlight: provided: %d, minimum: %d",
+			errInvalidValue, args.MaxTransactionsInFlight, minTransactionsInFlight)
+	}
 	if args.MaxGasLimitToUse < minGasToExecuteSCCalls {
 		return fmt.Errorf("%w for MaxGasLimitToUse: provided: %d, absolute minimum required: %d", errGasLimitIsLessThanAbsoluteMinimum, args.MaxGasLimitToUse, minGasToExecuteSCCalls)
 	}
@@ -138,6 +219,10 @@ func checkArgs(args ArgsScCallExecutor) error {
 	if err != nil {
 		return err
 	}
+	err = checkGasEstimationConfig(args.GasEstimation)
+	if err != nil {
+		return err
+	}
 
 	_, err = data.NewAddressFromBech32String(args.ScProxyBech32Address)
 
@@ -165,6 +250,19 @@ func checkTransactionChecksConfig(args ArgsScCallExecutor) error {
 	return nil
 }
 
+func checkGasEstimationConfig(cfg config.GasEstimationConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.DeviationWarningFactor < minGasDeviationWarningFactor {
+		return fmt.Errorf("%w for GasEstimation.DeviationWarningFactor, minimum: %d, got: %d",
+			errInvalidValue, minGasDeviationWarningFactor, cfg.DeviationWarningFactor)
+	}
+
+	return nil
+}
+
 // Execute will execute one step: get all pending operations, call the filter and send execution transactions
 func (executor *scCallExecutor) Execute(ctx context.Context) error {
 	pendingOperations, err := executor.getPendingOperations(ctx)
@@ -173,6 +271,9 @@ func (executor *scCallExecutor) Execute(ctx context.Context) error {
 	}
 
 	filteredPendingOperations := executor.filterOperations(pendingOperations)
+	if executor.dryRun {
+		return executor.reportOperations(ctx, pendingOperations, filteredPendingOperations)
+	}
 
 	return executor.executeOperations(ctx, filteredPendingOperations)
 }
@@ -225,11 +326,27 @@ func (executor *scCallExecutor) parseResponse(response *data.VmValuesResponseDat
 
 func (executor *scCallExecutor) filterOperations(pendingOperations map[uint64]parsers.ProxySCCompleteCallData) map[uint64]parsers.ProxySCCompleteCallData {
 	result := make(map[uint64]parsers.ProxySCCompleteCallData)
+	decisions := make(map[uint64]bridgeCore.ScCallFilterDecision, len(pendingOperations))
 	for id, callData := range pendingOperations {
-		if executor.filter.ShouldExecute(callData) {
-			result[id] = callData
+		if !executor.filter.ShouldExecute(callData) {
+			decisions[id] = bridgeCore.ScCallDeniedByFilter
+			continue
+		}
+		if !executor.coordinator.ShouldExecute(id) {
+			executor.log.Trace("scCallExecutor.filterOperations: operation handled by another instance", "ID", id)
+			decisions[id] = bridgeCore.ScCallHandledByOtherInstance
+			continue
+		}
+		if !executor.journal.ShouldExecute(id) {
+			executor.log.Trace("scCallExecutor.filterOperations: operation skipped by the retry policy", "ID", id)
+			decisions[id] = bridgeCore.ScCallPostponedByRetryPolicy
+			continue
 		}
+
+		decisions[id] = bridgeCore.ScCallAllowed
+		result[id] = callData
 	}
+	executor.statusTracker.setPendingOperations(pendingOperations, decisions)
 
 	executor.log.Debug("scCallExecutor.filterOperations", "input pending ops", len(pendingOperations), "result pending ops", len(result))
 
@@ -242,44 +359,177 @@ func (executor *scCallExecutor) executeOperations(ctx context.Context, pendingOp
 		return fmt.Errorf("%w while fetching network configs", err)
 	}
 
-	for id, callData := range pendingOperations {
-		workingCtx, cancel := context.WithTimeout(ctx, executor.executionTimeout)
+	// the operations are executed in the order they were registered in the contract (oldest first)
+	ids := make([]uint64, 0, len(pendingOperations))
+	for id := range pendingOperations {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
 
-		executor.log.Debug("scCallExecutor.executeOperations", "executing ID", id, "call data", callData,
-			"maximum timeout", executor.executionTimeout)
-		err = executor.executeOperation(workingCtx, id, callData, networkConfig)
-		cancel()
+	executor.senders.refresh(ctx)
+	senders := executor.senders.getSelectedSenders()
+	if len(senders) == 0 {
+		return errNoActiveSenders
+	}
+
+	// the operations are distributed in a round-robin manner across the wallets, each wallet using its own nonce
+	// sequence, so a stuck transaction only delays the operations assigned to its wallet
+	idsPerSender := make([][]uint64, len(senders))
+	for index, id := range ids {
+		senderIndex := index % len(senders)
+		idsPerSender[senderIndex] = append(idsPerSender[senderIndex], id)
+	}
+
+	errs := make([]error, len(senders))
+	wg := &sync.WaitGroup{}
+	for i := range senders {
+		if len(idsPerSender[i]) == 0 {
+			continue
+		}
+
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+
+			errs[index] = executor.executeSenderOperations(ctx, senders[index], idsPerSender[index], pendingOperations, networkConfig)
+		}(i)
+	}
+	wg.Wait()
 
+	for _, errSender := range errs {
+		if errSender != nil {
+			return errSender
+		}
+	}
+
+	return nil
+}
+
+func (executor *scCallExecutor) executeSenderOperations(
+	ctx context.Context,
+	sender *sender,
+	ids []uint64,
+	pendingOperations map[uint64]parsers.ProxySCCompleteCallData,
+	networkConfig *data.NetworkConfig,
+) error {
+	for startIndex := 0; startIndex < len(ids); startIndex += executor.maxTransactionsInFlight {
+		endIndex := startIndex + executor.maxTransactionsInFlight
+		if endIndex > len(ids) {
+			endIndex = len(ids)
+		}
+
+		err := executor.executeOperationsInBulk(ctx, sender, ids[startIndex:endIndex], pendingOperations, networkConfig)
 		if err != nil {
-			return fmt.Errorf("%w for call data: %s", err, callData)
+			return err
 		}
 	}
 
 	return nil
 }
 
-func (executor *scCallExecutor) executeOperation(
+// executeOperationsInBulk will create the transactions with consecutive nonces, send them all at once and then check
+// their results concurrently
+func (executor *scCallExecutor) executeOperationsInBulk(
+	ctx context.Context,
+	sender *sender,
+	ids []uint64,
+	pendingOperations map[uint64]parsers.ProxySCCompleteCallData,
+	networkConfig *data.NetworkConfig,
+) error {
+	pendingTxs := make([]*pendingTransaction, 0, len(ids))
+	for _, id := range ids {
+		callData := pendingOperations[id]
+		executor.log.Debug("scCallExecutor.executeOperationsInBulk", "preparing ID", id, "call data", callData)
+
+		tx, err := executor.createTransaction(ctx, sender, id, callData, networkConfig)
+		if err != nil {
+			executor.journal.RecordAttempt(id, "", executor.checkTransactionResults)
+			executor.journal.RecordResult(id, err)
+			executor.dropTransactions(sender, len(pendingTxs))
+			return fmt.Errorf("%w for call data: %s", err, callData)
+		}
+		if tx == nil {
+			continue
+		}
+
+		pendingTxs = append(pendingTxs, &pendingTransaction{
+			id:       id,
+			callData: callData,
+			tx:       tx,
+		})
+	}
+	if len(pendingTxs) == 0 {
+		return nil
+	}
+
+	acceptedTxs, errSend := executor.sendTransactions(ctx, sender, pendingTxs)
+	if len(acceptedTxs) == 0 {
+		return errSend
+	}
+
+	errCheck := executor.checkResultsConcurrently(ctx, acceptedTxs)
+	if len(acceptedTxs) < len(pendingTxs) {
+		// the rejected transactions left a nonce gap. The nonces are re-fetched only after the accepted transactions
+		// were checked, so the rejected operations are rebuilt on the next execution without reusing their nonces
+		executor.dropTransactions(sender, len(pendingTxs)-len(acceptedTxs))
+	}
+	if errCheck != nil {
+		return errCheck
+	}
+
+	return errSend
+}
+
+func (executor *scCallExecutor) createTransaction(
 	ctx context.Context,
+	sender *sender,
 	id uint64,
 	callData parsers.ProxySCCompleteCallData,
 	networkConfig *data.NetworkConfig,
-) error {
-	txBuilder := builders.NewTxDataBuilder()
-	txBuilder.Function(scProxyCallFunction).ArgInt64(int64(id))
+) (*transaction.FrontendTransaction, error) {
+	tx, outcome, err := executor.buildTransaction(ctx, sender, id, callData, networkConfig)
+	if err != nil {
+		return nil, err
+	}
+	if outcome == outcomeSkip {
+		return nil, nil
+	}
 
-	dataBytes, err := txBuilder.ToDataBytes()
+	err = executor.nonceTxHandler.ApplyNonceAndGasPrice(ctx, sender.address, tx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	bech32Address, err := executor.senderAddress.AddressAsBech32String()
+	err = executor.signTransactionWithPrivateKey(sender, tx)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// buildTransaction creates the unsigned execution transaction, without the nonce and the gas price, and returns what
+// the executor should do with it
+func (executor *scCallExecutor) buildTransaction(
+	ctx context.Context,
+	sender *sender,
+	id uint64,
+	callData parsers.ProxySCCompleteCallData,
+	networkConfig *data.NetworkConfig,
+) (*transaction.FrontendTransaction, executionOutcome, error) {
+	txBuilder := builders.NewTxDataBuilder()
+	txBuilder.Function(scProxyCallFunction).ArgInt64(int64(id))
+
+	dataBytes, err := txBuilder.ToDataBytes()
+	if err != nil {
+		return nil, outcomeSkip, err
 	}
 
 	gasLimit, err := executor.codec.ExtractGasLimitFromRawCallData(callData.RawCallData)
 	if err != nil {
-		executor.log.Warn("scCallExecutor.executeOperation found a non-parsable raw call data",
+		executor.log.Warn("scCallExecutor.buildTransaction found a non-parsable raw call data",
 			"raw call data", callData.RawCallData, "error", err)
 		gasLimit = 0
 	}
@@ -289,11 +539,12 @@ func (executor *scCallExecutor) executeOperation(
 		Version:  networkConfig.MinTransactionVersion,
 		GasLimit: gasLimit + executor.extraGasToExecute,
 		Data:     dataBytes,
-		Sender:   bech32Address,
+		Sender:   sender.bech32Address,
 		Receiver: executor.scProxyBech32Address,
 		Value:    "0",
 	}
 
+	outcome := outcomeExecute
 	to, _ := callData.To.AddressAsBech32String()
 	if tx.GasLimit > contractMaxGasLimit {
 		// the contract will refund this transaction, so we will use less gas to preserve funds
@@ -308,6 +559,9 @@ func (executor *scCallExecutor) executeOperation(
 			"nonce", callData.Nonce,
 		)
 		tx.GasLimit = executor.gasLimitForOutOfGasTransactions
+		outcome = outcomeExecuteOutOfGas
+	} else {
+		executor.applyEstimatedGasLimit(ctx, id, tx)
 	}
 
 	if tx.GasLimit > executor.maxGasLimitToUse {
@@ -323,35 +577,212 @@ func (executor *scCallExecutor) executeOperation(
 			"nonce", callData.Nonce,
 		)
 
-		return nil
+		return tx, outcomeSkip, nil
 	}
 
-	err = executor.nonceTxHandler.ApplyNonceAndGasPrice(ctx, executor.senderAddress, tx)
+	return tx, outcome, nil
+}
+
+// applyEstimatedGasLimit will replace the gas limit declared in the call data with the one obtained by simulating the
+// transaction, increased by the safety margin and capped by the maximum gas limit allowed. If the simulation fails,
+// the declared gas limit is kept.
+func (executor *scCallExecutor) applyEstimatedGasLimit(ctx context.Context, id uint64, tx *transaction.FrontendTransaction) {
+	if !executor.gasEstimation.Enabled {
+		return
+	}
+
+	declaredGasLimit := tx.GasLimit
+	cost, err := executor.proxy.RequestTransactionCost(ctx, tx)
 	if err != nil {
-		return err
+		executor.log.Warn("scCallExecutor.applyEstimatedGasLimit: could not simulate the transaction, using the declared gas limit",
+			"tx ID", id, "declared gas limit", declaredGasLimit, "error", err)
+		return
+	}
+	if len(cost.RetMessage) > 0 || cost.TxCost == 0 {
+		executor.log.Warn("scCallExecutor.applyEstimatedGasLimit: the transaction simulation did not succeed, using the declared gas limit",
+			"tx ID", id, "declared gas limit", declaredGasLimit, "simulated gas", cost.TxCost, "message", cost.RetMessage)
+		return
 	}
 
-	err = executor.signTransactionWithPrivateKey(tx)
+	estimatedGasLimit := cost.TxCost + cost.TxCost*executor.gasEstimation.SafetyMarginInPercent/percentDivider
+	if estimatedGasLimit > executor.maxGasLimitToUse {
+		estimatedGasLimit = executor.maxGasLimitToUse
+	}
+
+	factor := executor.gasEstimation.DeviationWarningFactor
+	if declaredGasLimit > cost.TxCost*factor || declaredGasLimit*factor < cost.TxCost {
+		executor.log.Warn("scCallExecutor.applyEstimatedGasLimit: the declared gas limit differs significantly from the simulated one",
+			"tx ID", id, "declared gas limit", declaredGasLimit, "simulated gas", cost.TxCost, "deviation factor", factor)
+	}
+
+	executor.log.Debug("scCallExecutor.applyEstimatedGasLimit", "tx ID", id,
+		"declared gas limit", declaredGasLimit, "simulated gas", cost.TxCost, "gas limit", estimatedGasLimit)
+	tx.GasLimit = estimatedGasLimit
+}
+
+// sendTransactions sends the transactions in a single request and returns the transactions accepted by the proxy.
+// The proxy returns only the hashes of the accepted transactions, so, if some transactions were rejected, the accepted
+// ones are identified by their locally computed hashes
+func (executor *scCallExecutor) sendTransactions(ctx context.Context, sender *sender, pendingTxs []*pendingTransaction) ([]*pendingTransaction, error) {
+	txs := make([]*transaction.FrontendTransaction, 0, len(pendingTxs))
+	for _, pendingTx := range pendingTxs {
+		txs = append(txs, pendingTx.tx)
+	}
+
+	localHashes, err := executor.computeTransactionsHashes(txs)
 	if err != nil {
-		return err
+		executor.dropTransactions(sender, len(txs))
+		return nil, err
 	}
 
-	hash, err := executor.nonceTxHandler.SendTransaction(ctx, tx)
+	hashes, err := executor.proxy.SendTransactions(ctx, txs)
 	if err != nil {
-		return err
+		executor.dropTransactions(sender, len(txs))
+		return nil, fmt.Errorf("%w while sending %d transactions", err, len(txs))
 	}
+	atomic.AddUint32(&executor.numSentTransactions, uint32(len(hashes)))
 
-	executor.log.Info("scCallExecutor.executeOperation: sent transaction from executor",
-		"hash", hash,
-		"tx ID", id,
-		"call data", callData.String(),
-		"extra gas", executor.extraGasToExecute,
-		"sender", bech32Address,
-		"to", to)
+	acceptedTxs := pendingTxs
+	if len(hashes) == len(txs) {
+		for i, pendingTx := range pendingTxs {
+			pendingTx.hash = hashes[i]
+		}
+	} else {
+		acceptedTxs = executor.selectAcceptedTransactions(sender, pendingTxs, localHashes, hashes)
+	}
+
+	executor.recordSentTransactions(sender, acceptedTxs)
+	if len(acceptedTxs) == len(pendingTxs) {
+		return acceptedTxs, nil
+	}
 
-	atomic.AddUint32(&executor.numSentTransactions, 1)
+	err = fmt.Errorf("%w: sent %d transactions, accepted %d", errNotAllTransactionsWereSent, len(txs), len(hashes))
+	if len(acceptedTxs) == 0 {
+		executor.dropTransactions(sender, len(txs))
+		return nil, err
+	}
 
-	return executor.handleResults(ctx, hash)
+	return acceptedTxs, err
+}
+
+func (executor *scCallExecutor) computeTransactionsHashes(txs []*transaction.FrontendTransaction) ([]string, error) {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hash, err := executor.txHashComputer.ComputeTxHash(tx)
+		if err != nil {
+			return nil, fmt.Errorf("%w while computing the hash of the transaction with nonce %d", err, tx.Nonce)
+		}
+
+		hashes = append(hashes, hex.EncodeToString(hash))
+	}
+
+	return hashes, nil
+}
+
+func (executor *scCallExecutor) selectAcceptedTransactions(
+	sender *sender,
+	pendingTxs []*pendingTransaction,
+	localHashes []string,
+	acceptedHashes []string,
+) []*pendingTransaction {
+	acceptedHashesMap := make(map[string]struct{}, len(acceptedHashes))
+	for _, hash := range acceptedHashes {
+		acceptedHashesMap[hash] = struct{}{}
+	}
+
+	acceptedTxs := make([]*pendingTransaction, 0, len(acceptedHashes))
+	for i, pendingTx := range pendingTxs {
+		_, isAccepted := acceptedHashesMap[localHashes[i]]
+		if !isAccepted {
+			executor.log.Warn("scCallExecutor.sendTransactions: transaction rejected by the proxy, the operation will be retried",
+				"hash", localHashes[i],
+				"tx ID", pendingTx.id,
+				"nonce", pendingTx.tx.Nonce,
+				"sender", sender.bech32Address)
+			continue
+		}
+
+		pendingTx.hash = localHashes[i]
+		acceptedTxs = append(acceptedTxs, pendingTx)
+	}
+
+	return acceptedTxs
+}
+
+func (executor *scCallExecutor) recordSentTransactions(sender *sender, pendingTxs []*pendingTransaction) {
+	executor.statusTracker.recordSentTransactions(pendingTxs)
+
+	for _, pendingTx := range pendingTxs {
+		executor.journal.RecordAttempt(pendingTx.id, pendingTx.hash, executor.checkTransactionResults)
+
+		to, _ := pendingTx.callData.To.AddressAsBech32String()
+		executor.log.Info("scCallExecutor.sendTransactions: sent transaction from executor",
+			"hash", pendingTx.hash,
+			"tx ID", pendingTx.id,
+			"nonce", pendingTx.tx.Nonce,
+			"call data", pendingTx.callData.String(),
+			"extra gas", executor.extraGasToExecute,
+			"sender", sender.bech32Address,
+			"to", to)
+	}
+}
+
+// dropTransactions forces the nonce re-fetch on the next transaction, so the nonces of the transactions
+// that were not sent will be reused
+func (executor *scCallExecutor) dropTransactions(sender *sender, numTransactions int) {
+	if numTransactions == 0 {
+		return
+	}
+
+	err := executor.nonceTxHandler.DropTransactions(sender.address)
+	if err != nil {
+		executor.log.Error("scCallExecutor.dropTransactions", "error", err)
+	}
+}
+
+func (executor *scCallExecutor) checkResultsConcurrently(ctx context.Context, pendingTxs []*pendingTransaction) error {
+	errs := make([]error, len(pendingTxs))
+	chIndexes := make(chan int, len(pendingTxs))
+	for i := range pendingTxs {
+		chIndexes <- i
+	}
+	close(chIndexes)
+
+	numWorkers := executor.numWorkers
+	if numWorkers > len(pendingTxs) {
+		numWorkers = len(pendingTxs)
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(numWorkers)
+	for worker := 0; worker < numWorkers; worker++ {
+		go func() {
+			defer wg.Done()
+
+			for index := range chIndexes {
+				workingCtx, cancel := context.WithTimeout(ctx, executor.executionTimeout)
+				errs[index] = executor.handleResults(workingCtx, pendingTxs[index].hash)
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if executor.checkTransactionResults {
+		for i, err := range errs {
+			executor.journal.RecordResult(pendingTxs[i].id, err)
+			executor.statusTracker.recordExecutionResult(pendingTxs[i].hash, err)
+		}
+	}
+
+	// the errors are reported in the operations order so the outcome does not depend on the workers scheduling
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("%w for call data: %s", err, pendingTxs[i].callData)
+		}
+	}
+
+	return nil
 }
 
 func (executor *scCallExecutor) handleResults(ctx context.Context, hash string) error {
@@ -364,15 +795,15 @@ func (executor *scCallExecutor) handleResults(ctx context.Context, hash string)
 	return err
 }
 
-// signTransactionWithPrivateKey signs a transaction with the client's private key
-func (executor *scCallExecutor) signTransactionWithPrivateKey(tx *transaction.FrontendTransaction) error {
+// signTransactionWithPrivateKey signs a transaction with the sender's private key
+func (executor *scCallExecutor) signTransactionWithPrivateKey(sender *sender, tx *transaction.FrontendTransaction) error {
 	tx.Signature = ""
 	bytes, err := json.Marshal(&tx)
 	if err != nil {
 		return err
 	}
 
-	signature, err := executor.singleSigner.Sign(executor.privateKey, bytes)
+	signature, err := executor.singleSigner.Sign(sender.privateKey, bytes)
 	if err != nil {
 		return err
 	}
@@ -469,6 +900,21 @@ func (executor *scCallExecutor) waitForExtraDelay(ctx context.Context, err error
 	}
 }
 
+// GetPendingOperations returns the pending operations seen on the last execution, along with the decision taken for each of them
+func (executor *scCallExecutor) GetPendingOperations() []*bridgeCore.ScCallPendingOperation {
+	return executor.statusTracker.getPendingOperations()
+}
+
+// GetRecentExecutions returns the most recent executions, the newest first
+func (executor *scCallExecutor) GetRecentExecutions() []*bridgeCore.ScCallExecution {
+	return executor.statusTracker.getRecentExecutions()
+}
+
+// GetSendersStatus returns the nonce, the balance and the state of the accounts used to send the execution transactions
+func (executor *scCallExecutor) GetSendersStatus(ctx context.Context) ([]*bridgeCore.ScCallsSenderStatus, error) {
+	return executor.senders.getStatuses(ctx)
+}
+
 // GetNumSentTransaction returns the total sent transactions
 func (executor *scCallExecutor) GetNumSentTransaction() uint32 {
 	return atomic.LoadUint32(&executor.numSentTransactions)
@@ -0,0 +1,94 @@
+package multiversx
+
+import (
+	"context"
+	"fmt"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// reportOperations builds the execution transactions for the selected operations, exactly as the executor would do,
+// without applying the nonce, signing or sending them. The resulting report is passed to the dry run report handler
+func (executor *scCallExecutor) reportOperations(
+	ctx context.Context,
+	pendingOperations map[uint64]parsers.ProxySCCompleteCallData,
+	selectedOperations map[uint64]parsers.ProxySCCompleteCallData,
+) error {
+	networkConfig, err := executor.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("%w while fetching network configs", err)
+	}
+
+	executor.senders.refresh(ctx)
+	senders := executor.senders.getSelectedSenders()
+
+	// the pending operations are sorted by ID, so the senders are assigned in the same way as on a real execution
+	operations := executor.statusTracker.getPendingOperations()
+	report := &bridgeCore.ScCallDryRunReport{
+		Timestamp:  executor.statusTracker.getTimeHandler().Unix(),
+		Operations: make([]*bridgeCore.ScCallDryRunOperation, 0, len(operations)),
+	}
+	numSelected := 0
+	for _, operation := range operations {
+		result := &bridgeCore.ScCallDryRunOperation{
+			ScCallPendingOperation: *operation,
+			Outcome:                bridgeCore.ScCallDryRunNotSelected,
+		}
+		report.Operations = append(report.Operations, result)
+
+		callData, isSelected := selectedOperations[operation.ID]
+		if !isSelected {
+			continue
+		}
+		if len(senders) == 0 {
+			result.Outcome = bridgeCore.ScCallDryRunError
+			result.Error = errNoActiveSenders.Error()
+			continue
+		}
+
+		sender := senders[numSelected%len(senders)]
+		numSelected++
+		executor.fillDryRunOperation(ctx, result, sender, callData, networkConfig)
+	}
+
+	executor.log.Debug("scCallExecutor.reportOperations", "pending ops", len(pendingOperations), "selected ops", numSelected)
+	executor.dryRunReportHandler.HandleDryRunReport(report)
+
+	return nil
+}
+
+func (executor *scCallExecutor) fillDryRunOperation(
+	ctx context.Context,
+	result *bridgeCore.ScCallDryRunOperation,
+	sender *sender,
+	callData parsers.ProxySCCompleteCallData,
+	networkConfig *data.NetworkConfig,
+) {
+	result.Sender = sender.bech32Address
+	result.DeclaredGasLimit, _ = executor.codec.ExtractGasLimitFromRawCallData(callData.RawCallData)
+
+	tx, outcome, err := executor.buildTransaction(ctx, sender, result.ID, callData, networkConfig)
+	if err != nil {
+		result.Outcome = bridgeCore.ScCallDryRunError
+		result.Error = err.Error()
+		return
+	}
+
+	result.Outcome = toDryRunOutcome(outcome)
+	result.GasLimit = tx.GasLimit
+}
+
+func toDryRunOutcome(outcome executionOutcome) bridgeCore.ScCallDryRunOutcome {
+	switch outcome {
+	case outcomeExecute:
+		return bridgeCore.ScCallDryRunWouldExecute
+	case outcomeExecuteOutOfGas:
+		return bridgeCore.ScCallDryRunWouldExecuteOutOfGas
+	case outcomeSkip:
+		return bridgeCore.ScCallDryRunWouldSkip
+	default:
+		return bridgeCore.ScCallDryRunError
+	}
+}
@@ -7,12 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/parsers"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	testCrypto "github.com/multiversx/mx-bridge-eth-go/testsCommon/crypto"
@@ -20,9 +22,12 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var testCodec = &testsCommon.TestMultiversXCodec{}
@@ -33,14 +38,23 @@ func createMockArgsScCallExecutor() ArgsScCallExecutor {
 		Proxy:                           &interactors.ProxyStub{},
 		Codec:                           &testsCommon.MultiversxCodecStub{},
 		Filter:                          &testsCommon.ScCallsExecuteFilterStub{},
+		Journal:                         &testsCommon.ExecutionJournalStub{},
+		Coordinator:                     &testsCommon.ExecutionCoordinatorStub{},
+		StatusHandler:                   testsCommon.NewStatusHandlerMock("test"),
 		Log:                             &testsCommon.LoggerStub{},
 		ExtraGasToExecute:               100,
 		MaxGasLimitToUse:                minGasToExecuteSCCalls,
 		GasLimitForOutOfGasTransactions: minGasToExecuteSCCalls,
 		NonceTxHandler:                  &testsCommon.TxNonceHandlerV2Stub{},
-		PrivateKey:                      testCrypto.NewPrivateKeyMock(),
-		SingleSigner:                    &testCrypto.SingleSignerStub{},
-		CloseAppChan:                    make(chan struct{}),
+		PrivateKeys:                     []crypto.PrivateKey{testCrypto.NewPrivateKeyMock()},
+		SingleSigner: &testCrypto.SingleSignerStub{
+			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+				return []byte("sig"), nil
+			},
+		},
+		CloseAppChan:            make(chan struct{}),
+		NumWorkers:              4,
+		MaxTransactionsInFlight: 10,
 	}
 }
 
@@ -54,6 +68,14 @@ func createMockCheckConfigs() config.TransactionChecksConfig {
 	}
 }
 
+func createMockGasEstimationConfig() config.GasEstimationConfig {
+	return config.GasEstimationConfig{
+		Enabled:                true,
+		SafetyMarginInPercent:  20,
+		DeviationWarningFactor: 10,
+	}
+}
+
 func createTestProxySCCompleteCallData(token string) parsers.ProxySCCompleteCallData {
 	callData := parsers.ProxySCCompleteCallData{
 		RawCallData: testCodec.EncodeCallDataWithLenAndMarker(
@@ -106,6 +128,47 @@ func TestNewScCallExecutor(t *testing.T) {
 		assert.Nil(t, executor)
 		assert.Equal(t, errNilFilter, err)
 	})
+	t.Run("nil journal should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Journal = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilExecutionJournal, err)
+	})
+	t.Run("nil coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Coordinator = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilExecutionCoordinator, err)
+	})
+	t.Run("dry run with nil report handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.DryRun = true
+		args.DryRunReportHandler = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilDryRunReportHandler, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.StatusHandler = nil
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.Equal(t, errNilStatusHandler, err)
+	})
 	t.Run("nil logger should error", func(t *testing.T) {
 		t.Parallel()
 
@@ -130,7 +193,7 @@ func TestNewScCallExecutor(t *testing.T) {
 		t.Parallel()
 
 		args := createMockArgsScCallExecutor()
-		args.PrivateKey = nil
+		args.PrivateKeys = nil
 
 		executor, err := NewScCallExecutor(args)
 		assert.Nil(t, executor)
@@ -218,6 +281,52 @@ func TestNewScCallExecutor(t *testing.T) {
 		assert.Contains(t, err.Error(), "provided: 2009999, absolute minimum required: 2010000")
 		assert.Contains(t, err.Error(), "GasLimitForOutOfGasTransactions")
 	})
+	t.Run("invalid NumWorkers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.NumWorkers = 0
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.ErrorIs(t, err, errInvalidValue)
+		assert.Contains(t, err.Error(), "for NumWorkers: provided: 0, minimum: 1")
+	})
+	t.Run("invalid MaxTransactionsInFlight should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.MaxTransactionsInFlight = 0
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.ErrorIs(t, err, errInvalidValue)
+		assert.Contains(t, err.Error(), "for MaxTransactionsInFlight: provided: 0, minimum: 1")
+	})
+	t.Run("invalid GasEstimation.DeviationWarningFactor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.GasEstimation = createMockGasEstimationConfig()
+		args.GasEstimation.DeviationWarningFactor = 1
+
+		executor, err := NewScCallExecutor(args)
+		assert.Nil(t, executor)
+		assert.ErrorIs(t, err, errInvalidValue)
+		assert.Contains(t, err.Error(), "for GasEstimation.DeviationWarningFactor, minimum: 2, got: 1")
+	})
+	t.Run("invalid GasEstimation.DeviationWarningFactor should not error if the estimation is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.GasEstimation = createMockGasEstimationConfig()
+		args.GasEstimation.Enabled = false
+		args.GasEstimation.DeviationWarningFactor = 0
+
+		executor, err := NewScCallExecutor(args)
+		assert.NotNil(t, executor)
+		assert.Nil(t, err)
+	})
 	t.Run("should work without transaction checks", func(t *testing.T) {
 		t.Parallel()
 
@@ -261,10 +370,6 @@ func TestScCallExecutor_Execute(t *testing.T) {
 			assert.Fail(t, "should have not called ApplyNonceAndGasPriceCalled")
 			return runError
 		},
-		SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
-			assert.Fail(t, "should have not called SendTransactionCalled")
-			return "", runError
-		},
 	}
 
 	t.Run("get pending errors, should error", func(t *testing.T) {
@@ -400,12 +505,12 @@ func TestScCallExecutor_Execute(t *testing.T) {
 			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
 				return expectedError
 			},
-			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
-				assert.Fail(t, "should have not called SendTransactionCalled")
-				return "", runError
-			},
 		}
 		args.Proxy = &interactors.ProxyStub{
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				assert.Fail(t, "should have not called SendTransactionsCalled")
+				return nil, runError
+			},
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
 				return &data.VmValuesResponseData{
 					Data: &vm.VMOutputApi{
@@ -444,12 +549,12 @@ func TestScCallExecutor_Execute(t *testing.T) {
 			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
 				return nil
 			},
-			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
-				assert.Fail(t, "should have not called SendTransactionCalled")
-				return "", runError
-			},
 		}
 		args.Proxy = &interactors.ProxyStub{
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				assert.Fail(t, "should have not called SendTransactionsCalled")
+				return nil, runError
+			},
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
 				return &data.VmValuesResponseData{
 					Data: &vm.VMOutputApi{
@@ -485,19 +590,24 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		assert.ErrorIs(t, err, expectedError)
 		assert.Zero(t, executor.GetNumSentTransaction())
 	})
-	t.Run("SendTransaction errors, should error", func(t *testing.T) {
+	t.Run("SendTransactions errors, should error", func(t *testing.T) {
 		t.Parallel()
 
+		dropTransactionsCalled := false
 		args := createMockArgsScCallExecutor()
 		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
 			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
 				return nil
 			},
-			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
-				return "", expectedError
+			DropTransactionsCalled: func(address core.AddressHandler) error {
+				dropTransactionsCalled = true
+				return nil
 			},
 		}
 		args.Proxy = &interactors.ProxyStub{
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				return nil, expectedError
+			},
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
 				return &data.VmValuesResponseData{
 					Data: &vm.VMOutputApi{
@@ -532,6 +642,7 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		err := executor.Execute(context.Background())
 		assert.ErrorIs(t, err, expectedError)
 		assert.Equal(t, uint32(0), executor.GetNumSentTransaction())
+		assert.True(t, dropTransactionsCalled)
 	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
@@ -547,6 +658,28 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		sendWasCalled := false
 
 		args.Proxy = &interactors.ProxyStub{
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				require.Equal(t, 1, len(txs))
+				tx := txs[0]
+				assert.Equal(t, "TEST", tx.ChainID)
+				assert.Equal(t, uint32(111), tx.Version)
+				assert.Equal(t, args.ExtraGasToExecute+5000000, tx.GasLimit)
+				assert.Equal(t, nonceCounter-1, tx.Nonce)
+				assert.Equal(t, uint64(101010), tx.GasPrice)
+				assert.Equal(t, hex.EncodeToString([]byte("sig")), tx.Signature)
+				_, err := data.NewAddressFromBech32String(tx.Sender)
+				assert.Nil(t, err)
+				assert.Equal(t, "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e", tx.Receiver)
+				assert.Equal(t, "0", tx.Value)
+
+				// only the second pending operation gor through the filter
+				expectedData := scProxyCallFunction + "@02"
+				assert.Equal(t, expectedData, string(tx.Data))
+
+				sendWasCalled = true
+
+				return []string{txHash}, nil
+			},
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
 				assert.Equal(t, args.ScProxyBech32Address, vmRequest.Address)
 				assert.Equal(t, getPendingTransactionsFunction, vmRequest.FuncName)
@@ -605,26 +738,6 @@ func TestScCallExecutor_Execute(t *testing.T) {
 				nonceCounter++
 				return nil
 			},
-			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
-				assert.Equal(t, "TEST", tx.ChainID)
-				assert.Equal(t, uint32(111), tx.Version)
-				assert.Equal(t, args.ExtraGasToExecute+5000000, tx.GasLimit)
-				assert.Equal(t, nonceCounter-1, tx.Nonce)
-				assert.Equal(t, uint64(101010), tx.GasPrice)
-				assert.Equal(t, hex.EncodeToString([]byte("sig")), tx.Signature)
-				_, err := data.NewAddressFromBech32String(tx.Sender)
-				assert.Nil(t, err)
-				assert.Equal(t, "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e", tx.Receiver)
-				assert.Equal(t, "0", tx.Value)
-
-				// only the second pending operation gor through the filter
-				expectedData := scProxyCallFunction + "@02"
-				assert.Equal(t, expectedData, string(tx.Data))
-
-				sendWasCalled = true
-
-				return txHash, nil
-			},
 		}
 		args.SingleSigner = &testCrypto.SingleSignerStub{
 			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
@@ -649,6 +762,28 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		sendWasCalled := false
 
 		args.Proxy = &interactors.ProxyStub{
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				require.Equal(t, 1, len(txs))
+				tx := txs[0]
+				assert.Equal(t, "TEST", tx.ChainID)
+				assert.Equal(t, uint32(111), tx.Version)
+				assert.Equal(t, args.ExtraGasToExecute, tx.GasLimit) // no 5000000 added gas limit because it wasn't extracted
+				assert.Equal(t, nonceCounter-1, tx.Nonce)
+				assert.Equal(t, uint64(101010), tx.GasPrice)
+				assert.Equal(t, hex.EncodeToString([]byte("sig")), tx.Signature)
+				_, err := data.NewAddressFromBech32String(tx.Sender)
+				assert.Nil(t, err)
+				assert.Equal(t, "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e", tx.Receiver)
+				assert.Equal(t, "0", tx.Value)
+
+				// only the second pending operation gor through the filter
+				expectedData := scProxyCallFunction + "@02"
+				assert.Equal(t, expectedData, string(tx.Data))
+
+				sendWasCalled = true
+
+				return []string{""}, nil
+			},
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
 				assert.Equal(t, args.ScProxyBech32Address, vmRequest.Address)
 				assert.Equal(t, getPendingTransactionsFunction, vmRequest.FuncName)
@@ -699,26 +834,6 @@ func TestScCallExecutor_Execute(t *testing.T) {
 				nonceCounter++
 				return nil
 			},
-			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
-				assert.Equal(t, "TEST", tx.ChainID)
-				assert.Equal(t, uint32(111), tx.Version)
-				assert.Equal(t, args.ExtraGasToExecute, tx.GasLimit) // no 5000000 added gas limit because it wasn't extracted
-				assert.Equal(t, nonceCounter-1, tx.Nonce)
-				assert.Equal(t, uint64(101010), tx.GasPrice)
-				assert.Equal(t, hex.EncodeToString([]byte("sig")), tx.Signature)
-				_, err := data.NewAddressFromBech32String(tx.Sender)
-				assert.Nil(t, err)
-				assert.Equal(t, "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e", tx.Receiver)
-				assert.Equal(t, "0", tx.Value)
-
-				// only the second pending operation gor through the filter
-				expectedData := scProxyCallFunction + "@02"
-				assert.Equal(t, expectedData, string(tx.Data))
-
-				sendWasCalled = true
-
-				return "", nil
-			},
 		}
 		args.SingleSigner = &testCrypto.SingleSignerStub{
 			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
@@ -742,6 +857,28 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		sendWasCalled := false
 
 		args.Proxy = &interactors.ProxyStub{
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				require.Equal(t, 1, len(txs))
+				tx := txs[0]
+				assert.Equal(t, "TEST", tx.ChainID)
+				assert.Equal(t, uint32(111), tx.Version)
+				assert.Equal(t, args.GasLimitForOutOfGasTransactions, tx.GasLimit) // the gas limit was replaced
+				assert.Equal(t, nonceCounter-1, tx.Nonce)
+				assert.Equal(t, uint64(101010), tx.GasPrice)
+				assert.Equal(t, hex.EncodeToString([]byte("sig")), tx.Signature)
+				_, err := data.NewAddressFromBech32String(tx.Sender)
+				assert.Nil(t, err)
+				assert.Equal(t, "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e", tx.Receiver)
+				assert.Equal(t, "0", tx.Value)
+
+				// only the second pending operation gor through the filter
+				expectedData := scProxyCallFunction + "@02"
+				assert.Equal(t, expectedData, string(tx.Data))
+
+				sendWasCalled = true
+
+				return []string{""}, nil
+			},
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
 				assert.Equal(t, args.ScProxyBech32Address, vmRequest.Address)
 				assert.Equal(t, getPendingTransactionsFunction, vmRequest.FuncName)
@@ -792,26 +929,6 @@ func TestScCallExecutor_Execute(t *testing.T) {
 				nonceCounter++
 				return nil
 			},
-			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
-				assert.Equal(t, "TEST", tx.ChainID)
-				assert.Equal(t, uint32(111), tx.Version)
-				assert.Equal(t, args.GasLimitForOutOfGasTransactions, tx.GasLimit) // the gas limit was replaced
-				assert.Equal(t, nonceCounter-1, tx.Nonce)
-				assert.Equal(t, uint64(101010), tx.GasPrice)
-				assert.Equal(t, hex.EncodeToString([]byte("sig")), tx.Signature)
-				_, err := data.NewAddressFromBech32String(tx.Sender)
-				assert.Nil(t, err)
-				assert.Equal(t, "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e", tx.Receiver)
-				assert.Equal(t, "0", tx.Value)
-
-				// only the second pending operation gor through the filter
-				expectedData := scProxyCallFunction + "@02"
-				assert.Equal(t, expectedData, string(tx.Data))
-
-				sendWasCalled = true
-
-				return "", nil
-			},
 		}
 		args.SingleSigner = &testCrypto.SingleSignerStub{
 			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
@@ -832,6 +949,11 @@ func TestScCallExecutor_Execute(t *testing.T) {
 		args := createMockArgsScCallExecutor()
 
 		args.Proxy = &interactors.ProxyStub{
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				assert.Fail(t, "should have not called send")
+
+				return nil, nil
+			},
 			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
 				assert.Equal(t, args.ScProxyBech32Address, vmRequest.Address)
 				assert.Equal(t, getPendingTransactionsFunction, vmRequest.FuncName)
@@ -880,11 +1002,6 @@ func TestScCallExecutor_Execute(t *testing.T) {
 				assert.Fail(t, "should have not apply nonce")
 				return nil
 			},
-			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
-				assert.Fail(t, "should have not called send")
-
-				return "", nil
-			},
 		}
 		args.SingleSigner = &testCrypto.SingleSignerStub{
 			SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
@@ -900,6 +1017,893 @@ func TestScCallExecutor_Execute(t *testing.T) {
 	})
 }
 
+func TestScCallExecutor_applyEstimatedGasLimit(t *testing.T) {
+	t.Parallel()
+
+	declaredGasLimit := uint64(10000000)
+	t.Run("disabled estimation should not simulate", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.Proxy = &interactors.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				assert.Fail(t, "should have not called RequestTransactionCost")
+				return nil, nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		tx := &transaction.FrontendTransaction{GasLimit: declaredGasLimit}
+		executor.applyEstimatedGasLimit(context.Background(), 1, tx)
+		assert.Equal(t, declaredGasLimit, tx.GasLimit)
+	})
+	t.Run("simulation errors should keep the declared gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.GasEstimation = createMockGasEstimationConfig()
+		args.Proxy = &interactors.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				return nil, errors.New("simulation error")
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		tx := &transaction.FrontendTransaction{GasLimit: declaredGasLimit}
+		executor.applyEstimatedGasLimit(context.Background(), 1, tx)
+		assert.Equal(t, declaredGasLimit, tx.GasLimit)
+	})
+	t.Run("failed simulation should keep the declared gas limit", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.GasEstimation = createMockGasEstimationConfig()
+		args.Proxy = &interactors.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{
+					TxCost:     0,
+					RetMessage: "out of gas",
+				}, nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		tx := &transaction.FrontendTransaction{GasLimit: declaredGasLimit}
+		executor.applyEstimatedGasLimit(context.Background(), 1, tx)
+		assert.Equal(t, declaredGasLimit, tx.GasLimit)
+	})
+	t.Run("should apply the safety margin", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.MaxGasLimitToUse = 249999999
+		args.GasEstimation = createMockGasEstimationConfig()
+		args.Proxy = &interactors.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				assert.Equal(t, declaredGasLimit, tx.GasLimit)
+				return &data.TxCostResponseData{
+					TxCost: 5000000,
+				}, nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		executor.log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, _ ...interface{}) {
+				assert.Fail(t, "should have not logged a warning")
+			},
+		}
+		tx := &transaction.FrontendTransaction{GasLimit: declaredGasLimit}
+		executor.applyEstimatedGasLimit(context.Background(), 1, tx)
+		assert.Equal(t, uint64(6000000), tx.GasLimit)
+	})
+	t.Run("should cap the gas limit and warn on large deviations", func(t *testing.T) {
+		t.Parallel()
+
+		warnCalled := false
+		args := createMockArgsScCallExecutor()
+		args.MaxGasLimitToUse = 50000000
+		args.GasEstimation = createMockGasEstimationConfig()
+		args.Proxy = &interactors.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{
+					TxCost: declaredGasLimit*10 + 1,
+				}, nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		executor.log = &testsCommon.LoggerStub{
+			WarnCalled: func(message string, _ ...interface{}) {
+				assert.Contains(t, message, "differs significantly")
+				warnCalled = true
+			},
+		}
+		tx := &transaction.FrontendTransaction{GasLimit: declaredGasLimit}
+		executor.applyEstimatedGasLimit(context.Background(), 1, tx)
+		assert.Equal(t, args.MaxGasLimitToUse, tx.GasLimit)
+		assert.True(t, warnCalled)
+	})
+}
+
+func createPendingOperationsResponse(numOperations int) *data.VmValuesResponseData {
+	returnData := make([][]byte, 0, numOperations*2)
+	// the operations are provided in reverse order to check the execution ordering
+	for id := numOperations; id > 0; id-- {
+		returnData = append(returnData, big.NewInt(int64(id)).Bytes(), []byte(fmt.Sprintf("ProxySCCompleteCallData %d", id)))
+	}
+
+	return &data.VmValuesResponseData{
+		Data: &vm.VMOutputApi{
+			ReturnCode: okCodeAfterExecution,
+			ReturnData: returnData,
+		},
+	}
+}
+
+func TestScCallExecutor_ExecuteInBulk(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should send ordered transactions in bulk and check the results concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		numOperations := 7
+		args := createMockArgsScCallExecutor()
+		args.MaxTransactionsInFlight = 3
+		args.NumWorkers = 3
+		args.TransactionChecks = createMockCheckConfigs()
+		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
+
+		nonceCounter := uint64(100)
+		sentData := make([]string, 0, numOperations)
+		sentNonces := make([]uint64, 0, numOperations)
+		bulkSizes := make([]int, 0)
+		numStatusChecks := uint32(0)
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(numOperations), nil
+			},
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{}, nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				bulkSizes = append(bulkSizes, len(txs))
+				hashes := make([]string, 0, len(txs))
+				for _, tx := range txs {
+					sentData = append(sentData, string(tx.Data))
+					sentNonces = append(sentNonces, tx.Nonce)
+					hashes = append(hashes, fmt.Sprintf("hash %d", tx.Nonce))
+				}
+
+				return hashes, nil
+			},
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				atomic.AddUint32(&numStatusChecks, 1)
+				return transaction.TxStatusSuccess, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+				tx.Nonce = nonceCounter
+				nonceCounter++
+				return nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		start := time.Now()
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		// 3 bulks, each waiting a single check interval as the results of a bulk are checked concurrently
+		assert.Less(t, time.Since(start), time.Second*6)
+
+		assert.Equal(t, []int{3, 3, 1}, bulkSizes)
+		assert.Equal(t, []uint64{100, 101, 102, 103, 104, 105, 106}, sentNonces)
+		expectedData := make([]string, 0, numOperations)
+		for id := 1; id <= numOperations; id++ {
+			expectedData = append(expectedData, fmt.Sprintf("%s@%02x", scProxyCallFunction, id))
+		}
+		assert.Equal(t, expectedData, sentData)
+		assert.Equal(t, uint32(numOperations), atomic.LoadUint32(&numStatusChecks))
+		assert.Equal(t, uint32(numOperations), executor.GetNumSentTransaction())
+	})
+	t.Run("not all transactions accepted should record the accepted ones and drop the nonces after their checks", func(t *testing.T) {
+		t.Parallel()
+
+		txHashComputer, _ := builders.NewTxBuilder(cryptoProvider.NewSigner())
+		args := createMockArgsScCallExecutor()
+		args.TransactionChecks = createMockCheckConfigs()
+		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
+		mutEvents := sync.Mutex{}
+		events := make([]string, 0)
+		addEvent := func(event string) {
+			mutEvents.Lock()
+			events = append(events, event)
+			mutEvents.Unlock()
+		}
+		sentHashes := make(map[uint64]string)
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(3), nil
+			},
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{}, nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				require.Equal(t, 3, len(txs))
+				// the second transaction is rejected, the proxy returns only the accepted hashes
+				hashes := make([]string, 0, 2)
+				for _, index := range []int{0, 2} {
+					hash, err := txHashComputer.ComputeTxHash(txs[index])
+					require.Nil(t, err)
+					sentHashes[txs[index].Nonce] = hex.EncodeToString(hash)
+					hashes = append(hashes, hex.EncodeToString(hash))
+				}
+
+				return hashes, nil
+			},
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				addEvent("check " + hexTxHash)
+				return transaction.TxStatusSuccess, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+		nonceCounter := uint64(100)
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+				tx.Nonce = nonceCounter
+				nonceCounter++
+				return nil
+			},
+			DropTransactionsCalled: func(address core.AddressHandler) error {
+				addEvent("drop")
+				return nil
+			},
+		}
+		recordedAttempts := make(map[uint64]string)
+		args.Journal = &testsCommon.ExecutionJournalStub{
+			RecordAttemptCalled: func(id uint64, txHash string, checkResults bool) {
+				recordedAttempts[id] = txHash
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, errNotAllTransactionsWereSent)
+		assert.Contains(t, err.Error(), "sent 3 transactions, accepted 2")
+		assert.Equal(t, uint32(2), executor.GetNumSentTransaction())
+
+		expectedAttempts := map[uint64]string{
+			1: sentHashes[100],
+			3: sentHashes[102],
+		}
+		assert.Equal(t, expectedAttempts, recordedAttempts)
+
+		require.Equal(t, 3, len(events))
+		assert.ElementsMatch(t, []string{"check " + sentHashes[100], "check " + sentHashes[102]}, events[:2])
+		assert.Equal(t, "drop", events[2])
+
+		recentExecutions := executor.GetRecentExecutions()
+		assert.Equal(t, 2, len(recentExecutions))
+	})
+	t.Run("no transaction accepted should drop the transactions and error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		dropTransactionsCalled := false
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(3), nil
+			},
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{}, nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				return make([]string, 0), nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			DropTransactionsCalled: func(address core.AddressHandler) error {
+				dropTransactionsCalled = true
+				return nil
+			},
+		}
+		args.Journal = &testsCommon.ExecutionJournalStub{
+			RecordAttemptCalled: func(id uint64, txHash string, checkResults bool) {
+				assert.Fail(t, "should have not recorded an attempt")
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, errNotAllTransactionsWereSent)
+		assert.Contains(t, err.Error(), "sent 3 transactions, accepted 0")
+		assert.True(t, dropTransactionsCalled)
+		assert.Equal(t, uint32(0), executor.GetNumSentTransaction())
+	})
+	t.Run("a failed transaction should return the error after all results were checked", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.TransactionChecks = createMockCheckConfigs()
+		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
+		args.TransactionChecks.ExtraDelayInSecondsOnError = 1
+		args.TransactionChecks.CloseAppOnError = false
+		numStatusChecks := uint32(0)
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(3), nil
+			},
+			GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{}, nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				return []string{"hash 1", "hash 2", "hash 3"}, nil
+			},
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				atomic.AddUint32(&numStatusChecks, 1)
+				if hexTxHash == "hash 2" {
+					return transaction.TxStatusFail, nil
+				}
+
+				return transaction.TxStatusSuccess, nil
+			},
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, txHash string) (*data.TransactionInfo, error) {
+				return &data.TransactionInfo{}, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, errTransactionFailed)
+		assert.Equal(t, uint32(3), atomic.LoadUint32(&numStatusChecks))
+		assert.Equal(t, uint32(3), executor.GetNumSentTransaction())
+	})
+}
+
+func TestScCallExecutor_ExecuteWithJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("operations rejected by the journal should not be executed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		sentData := make([]string, 0)
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(3), nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				hashes := make([]string, 0, len(txs))
+				for _, tx := range txs {
+					sentData = append(sentData, string(tx.Data))
+					hashes = append(hashes, "hash")
+				}
+
+				return hashes, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+		args.Journal = &testsCommon.ExecutionJournalStub{
+			ShouldExecuteCalled: func(id uint64) bool {
+				return id != 2
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []string{scProxyCallFunction + "@01", scProxyCallFunction + "@03"}, sentData)
+	})
+	t.Run("should record the attempts and the results", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.TransactionChecks = createMockCheckConfigs()
+		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
+		args.TransactionChecks.ExtraDelayInSecondsOnError = 1
+		args.TransactionChecks.CloseAppOnError = false
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(2), nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				return []string{"hash 1", "hash 2"}, nil
+			},
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				if hexTxHash == "hash 2" {
+					return transaction.TxStatusFail, nil
+				}
+
+				return transaction.TxStatusSuccess, nil
+			},
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, txHash string) (*data.TransactionInfo, error) {
+				return &data.TransactionInfo{}, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+		attempts := make(map[uint64]string)
+		results := make(map[uint64]error)
+		args.Journal = &testsCommon.ExecutionJournalStub{
+			RecordAttemptCalled: func(id uint64, txHash string, checkResults bool) {
+				assert.True(t, checkResults)
+				attempts[id] = txHash
+			},
+			RecordResultCalled: func(id uint64, err error) {
+				results[id] = err
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, errTransactionFailed)
+		assert.Equal(t, map[uint64]string{1: "hash 1", 2: "hash 2"}, attempts)
+		assert.Equal(t, 2, len(results))
+		assert.Nil(t, results[1])
+		assert.ErrorIs(t, results[2], errTransactionFailed)
+	})
+	t.Run("transaction creation errors should be recorded as failed attempts", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsScCallExecutor()
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(1), nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+				return expectedErr
+			},
+		}
+		attemptRecorded := false
+		var recordedErr error
+		args.Journal = &testsCommon.ExecutionJournalStub{
+			RecordAttemptCalled: func(id uint64, txHash string, checkResults bool) {
+				assert.Equal(t, uint64(1), id)
+				assert.Empty(t, txHash)
+				attemptRecorded = true
+			},
+			RecordResultCalled: func(id uint64, err error) {
+				recordedErr = err
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, expectedErr)
+		assert.True(t, attemptRecorded)
+		assert.Equal(t, expectedErr, recordedErr)
+	})
+}
+
+func TestScCallExecutor_ExecuteWithCoordinator(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScCallExecutor()
+	sentData := make([]string, 0)
+	args.Proxy = &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			return createPendingOperationsResponse(4), nil
+		},
+		SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+			hashes := make([]string, 0, len(txs))
+			for _, tx := range txs {
+				sentData = append(sentData, string(tx.Data))
+				hashes = append(hashes, "hash")
+			}
+
+			return hashes, nil
+		},
+	}
+	args.Codec = &testsCommon.MultiversxCodecStub{
+		DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+			return createTestProxySCCompleteCallData("tkn"), nil
+		},
+	}
+	journalCalled := make(map[uint64]bool)
+	args.Journal = &testsCommon.ExecutionJournalStub{
+		ShouldExecuteCalled: func(id uint64) bool {
+			journalCalled[id] = true
+			return true
+		},
+	}
+	args.Coordinator = &testsCommon.ExecutionCoordinatorStub{
+		ShouldExecuteCalled: func(id uint64) bool {
+			return id%2 == 0
+		},
+	}
+
+	executor, _ := NewScCallExecutor(args)
+	err := executor.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, []string{scProxyCallFunction + "@02", scProxyCallFunction + "@04"}, sentData)
+	assert.Equal(t, map[uint64]bool{2: true, 4: true}, journalCalled)
+
+	pendingOperations := executor.GetPendingOperations()
+	require.Equal(t, 4, len(pendingOperations))
+	assert.Equal(t, bridgeCore.ScCallHandledByOtherInstance, pendingOperations[0].FilterDecision)
+	assert.Equal(t, bridgeCore.ScCallAllowed, pendingOperations[1].FilterDecision)
+	assert.Equal(t, bridgeCore.ScCallHandledByOtherInstance, pendingOperations[2].FilterDecision)
+	assert.Equal(t, bridgeCore.ScCallAllowed, pendingOperations[3].FilterDecision)
+}
+
+func TestScCallExecutor_ExecuteWithMultipleSenders(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should distribute the operations across the senders", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.PrivateKeys = []crypto.PrivateKey{testCrypto.NewPrivateKeyMock(), testCrypto.NewPrivateKeyMock()}
+		mutSent := sync.Mutex{}
+		sentData := make(map[string][]string)
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(5), nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				mutSent.Lock()
+				defer mutSent.Unlock()
+
+				hashes := make([]string, 0, len(txs))
+				for _, tx := range txs {
+					sentData[tx.Sender] = append(sentData[tx.Sender], string(tx.Data))
+					hashes = append(hashes, "hash")
+				}
+
+				return hashes, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+		mutNonces := sync.Mutex{}
+		noncesAddresses := make(map[string]int)
+		args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+				mutNonces.Lock()
+				defer mutNonces.Unlock()
+
+				bech32Address, _ := address.AddressAsBech32String()
+				assert.Equal(t, bech32Address, tx.Sender)
+				noncesAddresses[bech32Address]++
+
+				return nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		senders := executor.senders.getSelectedSenders()
+		require.Equal(t, 2, len(senders))
+		assert.Equal(t, map[string][]string{
+			senders[0].bech32Address: {scProxyCallFunction + "@01", scProxyCallFunction + "@03", scProxyCallFunction + "@05"},
+			senders[1].bech32Address: {scProxyCallFunction + "@02", scProxyCallFunction + "@04"},
+		}, sentData)
+		assert.Equal(t, map[string]int{
+			senders[0].bech32Address: 3,
+			senders[1].bech32Address: 2,
+		}, noncesAddresses)
+	})
+	t.Run("no active sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.SenderWallets.MinBalance = "1000"
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(2), nil
+			},
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{
+					Balance: "999",
+				}, nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				assert.Fail(t, "should have not called SendTransactions")
+				return nil, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		err := executor.Execute(context.Background())
+		assert.Equal(t, errNoActiveSenders, err)
+	})
+}
+
+func TestScCallExecutor_ExecuteDryRun(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScCallExecutor()
+	args.DryRun = true
+	args.MaxGasLimitToUse = 100000000
+	args.GasLimitForOutOfGasTransactions = 30000000
+	args.Proxy = &interactors.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			return createPendingOperationsResponse(4), nil
+		},
+		GetNetworkConfigCalled: func(ctx context.Context) (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{
+				ChainID:               "test",
+				MinTransactionVersion: 111,
+			}, nil
+		},
+		SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+			assert.Fail(t, "should have not called SendTransactions")
+			return nil, nil
+		},
+	}
+	gasLimits := map[string]uint64{
+		"ProxySCCompleteCallData 1": 5000000,
+		"ProxySCCompleteCallData 2": contractMaxGasLimit,
+		"ProxySCCompleteCallData 3": 150000000,
+		"ProxySCCompleteCallData 4": 5000000,
+	}
+	args.Codec = &testsCommon.MultiversxCodecStub{
+		DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+			callData := createTestProxySCCompleteCallData("tkn")
+			callData.RawCallData = buff
+
+			return callData, nil
+		},
+		ExtractGasLimitFromRawCallDataCalled: func(buff []byte) (uint64, error) {
+			return gasLimits[string(buff)], nil
+		},
+	}
+	args.Filter = &testsCommon.ScCallsExecuteFilterStub{
+		ShouldExecuteCalled: func(callData parsers.ProxySCCompleteCallData) bool {
+			return string(callData.RawCallData) != "ProxySCCompleteCallData 4"
+		},
+	}
+	args.NonceTxHandler = &testsCommon.TxNonceHandlerV2Stub{
+		ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+			assert.Fail(t, "should have not called ApplyNonceAndGasPrice")
+			return nil
+		},
+	}
+	args.SingleSigner = &testCrypto.SingleSignerStub{
+		SignCalled: func(private crypto.PrivateKey, msg []byte) ([]byte, error) {
+			assert.Fail(t, "should have not called Sign")
+			return nil, nil
+		},
+	}
+	var report *bridgeCore.ScCallDryRunReport
+	args.DryRunReportHandler = &testsCommon.DryRunReportHandlerStub{
+		HandleDryRunReportCalled: func(r *bridgeCore.ScCallDryRunReport) {
+			report = r
+		},
+	}
+
+	executor, _ := NewScCallExecutor(args)
+	err := executor.Execute(context.Background())
+	assert.Nil(t, err)
+
+	require.NotNil(t, report)
+	require.Equal(t, 4, len(report.Operations))
+	senderAddress := executor.senders.getSelectedSenders()[0].bech32Address
+	expectedResults := []struct {
+		outcome          bridgeCore.ScCallDryRunOutcome
+		sender           string
+		declaredGasLimit uint64
+		gasLimit         uint64
+	}{
+		{bridgeCore.ScCallDryRunWouldExecute, senderAddress, 5000000, 5000100},
+		{bridgeCore.ScCallDryRunWouldExecuteOutOfGas, senderAddress, contractMaxGasLimit, 30000000},
+		{bridgeCore.ScCallDryRunWouldSkip, senderAddress, 150000000, 150000100},
+		{bridgeCore.ScCallDryRunNotSelected, "", 0, 0},
+	}
+	for i, expected := range expectedResults {
+		operation := report.Operations[i]
+		assert.Equal(t, uint64(i+1), operation.ID)
+		assert.Equal(t, expected.outcome, operation.Outcome)
+		assert.Equal(t, expected.sender, operation.Sender)
+		assert.Equal(t, expected.declaredGasLimit, operation.DeclaredGasLimit)
+		assert.Equal(t, expected.gasLimit, operation.GasLimit)
+		assert.Empty(t, operation.Error)
+	}
+	assert.Equal(t, 0, len(executor.statusTracker.getRecentExecutions()))
+}
+
+func TestScCallExecutor_StatusProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should report the pending operations, the executions and the metrics", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.TransactionChecks = createMockCheckConfigs()
+		args.TransactionChecks.TimeInSecondsBetweenChecks = 1
+		args.TransactionChecks.ExtraDelayInSecondsOnError = 1
+		args.TransactionChecks.CloseAppOnError = false
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(4), nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				return []string{"hash 1", "hash 2"}, nil
+			},
+			ProcessTransactionStatusCalled: func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error) {
+				if hexTxHash == "hash 2" {
+					return transaction.TxStatusFail, nil
+				}
+
+				return transaction.TxStatusSuccess, nil
+			},
+			GetTransactionInfoWithResultsCalled: func(ctx context.Context, txHash string) (*data.TransactionInfo, error) {
+				return &data.TransactionInfo{}, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData(string(buff)), nil
+			},
+		}
+		args.Filter = &testsCommon.ScCallsExecuteFilterStub{
+			ShouldExecuteCalled: func(callData parsers.ProxySCCompleteCallData) bool {
+				return callData.Token != "ProxySCCompleteCallData 3"
+			},
+		}
+		args.Journal = &testsCommon.ExecutionJournalStub{
+			ShouldExecuteCalled: func(id uint64) bool {
+				return id != 4
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+
+		executor, _ := NewScCallExecutor(args)
+		assert.Empty(t, executor.GetPendingOperations())
+		assert.Empty(t, executor.GetRecentExecutions())
+
+		err := executor.Execute(context.Background())
+		assert.ErrorIs(t, err, errTransactionFailed)
+
+		pendingOperations := executor.GetPendingOperations()
+		require.Equal(t, 4, len(pendingOperations))
+		assert.Equal(t, bridgeCore.ScCallAllowed, pendingOperations[0].FilterDecision)
+		assert.Equal(t, bridgeCore.ScCallAllowed, pendingOperations[1].FilterDecision)
+		assert.Equal(t, bridgeCore.ScCallDeniedByFilter, pendingOperations[2].FilterDecision)
+		assert.Equal(t, bridgeCore.ScCallPostponedByRetryPolicy, pendingOperations[3].FilterDecision)
+		assert.Equal(t, uint64(3), pendingOperations[2].ID)
+		assert.Equal(t, "37", pendingOperations[2].Amount)
+
+		executions := executor.GetRecentExecutions()
+		require.Equal(t, 2, len(executions))
+		assert.Equal(t, "hash 2", executions[0].TxHash)
+		assert.Equal(t, uint64(2), executions[0].ID)
+		assert.Equal(t, bridgeCore.ScCallExecutionFailed, executions[0].Status)
+		assert.NotEmpty(t, executions[0].Error)
+		assert.Equal(t, "hash 1", executions[1].TxHash)
+		assert.Equal(t, bridgeCore.ScCallExecutionSuccess, executions[1].Status)
+		assert.Empty(t, executions[1].Error)
+
+		assert.Equal(t, 4, statusHandler.GetIntMetric(bridgeCore.MetricNumPendingOperations))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(bridgeCore.MetricNumAllowedOperations))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(bridgeCore.MetricNumSentTransactions))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(bridgeCore.MetricNumSuccessfulExecutions))
+		assert.Equal(t, 1, statusHandler.GetIntMetric(bridgeCore.MetricNumFailedExecutions))
+		assert.Equal(t, executions[0].Error, statusHandler.GetStringMetric(bridgeCore.MetricLastError))
+	})
+	t.Run("recent executions should be bounded", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScCallExecutor()
+		args.MaxTransactionsInFlight = maxRecentExecutions + 10
+		args.Proxy = &interactors.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return createPendingOperationsResponse(maxRecentExecutions + 10), nil
+			},
+			SendTransactionsCalled: func(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
+				hashes := make([]string, 0, len(txs))
+				for i := range txs {
+					hashes = append(hashes, fmt.Sprintf("hash %d", i+1))
+				}
+
+				return hashes, nil
+			},
+		}
+		args.Codec = &testsCommon.MultiversxCodecStub{
+			DecodeProxySCCompleteCallDataCalled: func(buff []byte) (parsers.ProxySCCompleteCallData, error) {
+				return createTestProxySCCompleteCallData("tkn"), nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		err := executor.Execute(context.Background())
+		assert.Nil(t, err)
+
+		executions := executor.GetRecentExecutions()
+		require.Equal(t, maxRecentExecutions, len(executions))
+		assert.Equal(t, fmt.Sprintf("hash %d", maxRecentExecutions+10), executions[0].TxHash)
+		assert.Equal(t, bridgeCore.ScCallExecutionSent, executions[0].Status)
+		assert.Equal(t, "hash 11", executions[maxRecentExecutions-1].TxHash)
+	})
+	t.Run("GetSendersStatus", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsScCallExecutor()
+		account := &data.Account{
+			Address: "erd1sender",
+			Nonce:   37,
+			Balance: "1000",
+		}
+		args.Proxy = &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				if account == nil {
+					return nil, expectedErr
+				}
+
+				return account, nil
+			},
+		}
+
+		executor, _ := NewScCallExecutor(args)
+		status, err := executor.GetSendersStatus(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, []*bridgeCore.ScCallsSenderStatus{
+			{
+				Address: "erd1sender",
+				Nonce:   37,
+				Balance: "1000",
+				Active:  true,
+			},
+		}, status)
+
+		account = nil
+		status, err = executor.GetSendersStatus(context.Background())
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, expectedErr)
+	})
+}
+
 func TestScCallExecutor_handleResults(t *testing.T) {
 	t.Parallel()
 
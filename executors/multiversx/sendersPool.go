@@ -0,0 +1,284 @@
+package multiversx
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// sender holds a wallet used to send the execution transactions
+type sender struct {
+	privateKey    crypto.PrivateKey
+	address       core.AddressHandler
+	bech32Address string
+	shardID       uint32
+	active        bool
+}
+
+type argsSendersPool struct {
+	proxy                Proxy
+	log                  logger.Logger
+	statusHandler        bridgeCore.StatusHandler
+	privateKeys          []crypto.PrivateKey
+	scProxyBech32Address string
+	cfg                  config.SenderWalletsConfig
+}
+
+// sendersPool keeps the wallets used to send the execution transactions. It periodically checks their balances,
+// removing from the selection the wallets that fall below the minimum balance and adding them back once topped up
+type sendersPool struct {
+	proxy                Proxy
+	log                  logger.Logger
+	statusHandler        bridgeCore.StatusHandler
+	scProxyBech32Address string
+	minBalance           *big.Int
+	balanceCheckInterval time.Duration
+	sameShardAsScProxy   bool
+	getTimeHandler       func() time.Time
+
+	mut              sync.RWMutex
+	senders          []*sender
+	selectedSenders  []*sender
+	scProxyShardID   uint32
+	shardsComputed   bool
+	lastBalanceCheck time.Time
+}
+
+func newSendersPool(args argsSendersPool) (*sendersPool, error) {
+	minBalance, err := parseMinBalance(args.cfg.MinBalance)
+	if err != nil {
+		return nil, err
+	}
+
+	senders, err := createSenders(args.privateKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := &sendersPool{
+		proxy:                args.proxy,
+		log:                  args.log,
+		statusHandler:        args.statusHandler,
+		scProxyBech32Address: args.scProxyBech32Address,
+		minBalance:           minBalance,
+		balanceCheckInterval: time.Second * time.Duration(args.cfg.BalanceCheckIntervalInSeconds),
+		sameShardAsScProxy:   args.cfg.SameShardAsScProxy,
+		getTimeHandler:       time.Now,
+		senders:              senders,
+	}
+	pool.selectSenders()
+
+	return pool, nil
+}
+
+func parseMinBalance(value string) (*big.Int, error) {
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	minBalance, ok := big.NewInt(0).SetString(value, 10)
+	if !ok || minBalance.Sign() < 0 {
+		return nil, fmt.Errorf("%w for SenderWallets.MinBalance: %s", errInvalidValue, value)
+	}
+
+	return minBalance, nil
+}
+
+func createSenders(privateKeys []crypto.PrivateKey) ([]*sender, error) {
+	if len(privateKeys) == 0 {
+		return nil, errNilPrivateKey
+	}
+
+	senders := make([]*sender, 0, len(privateKeys))
+	addresses := make(map[string]struct{}, len(privateKeys))
+	for index, privateKey := range privateKeys {
+		if check.IfNil(privateKey) {
+			return nil, fmt.Errorf("%w at index %d", errNilPrivateKey, index)
+		}
+
+		publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+		if err != nil {
+			return nil, err
+		}
+		address := data.NewAddressFromBytes(publicKeyBytes)
+		bech32Address, err := address.AddressAsBech32String()
+		if err != nil {
+			return nil, err
+		}
+		if _, found := addresses[bech32Address]; found {
+			return nil, fmt.Errorf("%w: %s", errDuplicatedSender, bech32Address)
+		}
+		addresses[bech32Address] = struct{}{}
+
+		senders = append(senders, &sender{
+			privateKey:    privateKey,
+			address:       address,
+			bech32Address: bech32Address,
+			active:        true,
+		})
+	}
+
+	return senders, nil
+}
+
+// refresh computes the shards of the wallets, if required, and checks their balances if the check interval elapsed
+func (pool *sendersPool) refresh(ctx context.Context) {
+	if pool.sameShardAsScProxy && !pool.shardsComputed {
+		pool.computeShards(ctx)
+	}
+
+	if pool.minBalance.Sign() == 0 {
+		return
+	}
+	now := pool.getTimeHandler()
+	if !pool.lastBalanceCheck.IsZero() && now.Sub(pool.lastBalanceCheck) < pool.balanceCheckInterval {
+		return
+	}
+	pool.lastBalanceCheck = now
+
+	balances := make([]*big.Int, len(pool.senders))
+	for i, s := range pool.senders {
+		balances[i] = pool.getBalance(ctx, s)
+	}
+
+	pool.mut.Lock()
+	defer pool.mut.Unlock()
+
+	for i, s := range pool.senders {
+		if balances[i] == nil {
+			continue
+		}
+
+		wasActive := s.active
+		s.active = balances[i].Cmp(pool.minBalance) >= 0
+		if wasActive && !s.active {
+			pool.log.Warn("sendersPool: wallet balance is below the minimum, the wallet will no longer be used",
+				"address", s.bech32Address, "balance", balances[i].String(), "min balance", pool.minBalance.String())
+		}
+		if !wasActive && s.active {
+			pool.log.Info("sendersPool: wallet was topped up and will be used again",
+				"address", s.bech32Address, "balance", balances[i].String())
+		}
+	}
+	pool.selectSenders()
+}
+
+func (pool *sendersPool) getBalance(ctx context.Context, s *sender) *big.Int {
+	account, err := pool.proxy.GetAccount(ctx, s.address)
+	if err != nil {
+		pool.log.Warn("sendersPool: could not get the wallet account", "address", s.bech32Address, "error", err)
+		return nil
+	}
+
+	balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+	if !ok {
+		pool.log.Warn("sendersPool: invalid wallet balance", "address", s.bech32Address, "balance", account.Balance)
+		return nil
+	}
+
+	return balance
+}
+
+func (pool *sendersPool) computeShards(ctx context.Context) {
+	scProxyShardID, err := pool.proxy.GetShardOfAddress(ctx, pool.scProxyBech32Address)
+	if err != nil {
+		pool.log.Warn("sendersPool: could not compute the SC proxy shard", "error", err)
+		return
+	}
+
+	shardIDs := make([]uint32, len(pool.senders))
+	for i, s := range pool.senders {
+		shardIDs[i], err = pool.proxy.GetShardOfAddress(ctx, s.bech32Address)
+		if err != nil {
+			pool.log.Warn("sendersPool: could not compute the wallet shard", "address", s.bech32Address, "error", err)
+			return
+		}
+	}
+
+	pool.mut.Lock()
+	defer pool.mut.Unlock()
+
+	pool.scProxyShardID = scProxyShardID
+	for i, s := range pool.senders {
+		s.shardID = shardIDs[i]
+	}
+	pool.shardsComputed = true
+	pool.selectSenders()
+}
+
+// selectSenders must be called under mutex protection
+func (pool *sendersPool) selectSenders() {
+	active := make([]*sender, 0, len(pool.senders))
+	sameShard := make([]*sender, 0, len(pool.senders))
+	for _, s := range pool.senders {
+		if !s.active {
+			continue
+		}
+
+		active = append(active, s)
+		if pool.shardsComputed && s.shardID == pool.scProxyShardID {
+			sameShard = append(sameShard, s)
+		}
+	}
+
+	pool.selectedSenders = active
+	if pool.sameShardAsScProxy && pool.shardsComputed {
+		if len(sameShard) > 0 {
+			pool.selectedSenders = sameShard
+		} else if len(active) > 0 {
+			pool.log.Warn("sendersPool: no active wallet is located in the SC proxy shard, using all active wallets",
+				"SC proxy shard", pool.scProxyShardID)
+		}
+	}
+
+	pool.statusHandler.SetIntMetric(bridgeCore.MetricNumActiveSenders, len(pool.selectedSenders))
+}
+
+// getSelectedSenders returns the wallets that should be used for sending the execution transactions
+func (pool *sendersPool) getSelectedSenders() []*sender {
+	pool.mut.RLock()
+	defer pool.mut.RUnlock()
+
+	result := make([]*sender, len(pool.selectedSenders))
+	copy(result, pool.selectedSenders)
+
+	return result
+}
+
+// getStatuses returns the current nonce and balance of all the wallets
+func (pool *sendersPool) getStatuses(ctx context.Context) ([]*bridgeCore.ScCallsSenderStatus, error) {
+	pool.mut.RLock()
+	selected := make(map[*sender]struct{}, len(pool.selectedSenders))
+	for _, s := range pool.selectedSenders {
+		selected[s] = struct{}{}
+	}
+	pool.mut.RUnlock()
+
+	result := make([]*bridgeCore.ScCallsSenderStatus, 0, len(pool.senders))
+	for _, s := range pool.senders {
+		account, err := pool.proxy.GetAccount(ctx, s.address)
+		if err != nil {
+			return nil, fmt.Errorf("%w for address %s", err, s.bech32Address)
+		}
+
+		_, isSelected := selected[s]
+		result = append(result, &bridgeCore.ScCallsSenderStatus{
+			Address: account.Address,
+			Nonce:   account.Nonce,
+			Balance: account.Balance,
+			Active:  isSelected,
+		})
+	}
+
+	return result, nil
+}
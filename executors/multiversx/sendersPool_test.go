@@ -0,0 +1,296 @@
+package multiversx
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	testCrypto "github.com/multiversx/mx-bridge-eth-go/testsCommon/crypto"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsSendersPool(numSenders int) argsSendersPool {
+	privateKeys := make([]crypto.PrivateKey, 0, numSenders)
+	for i := 0; i < numSenders; i++ {
+		privateKeys = append(privateKeys, testCrypto.NewPrivateKeyMock())
+	}
+
+	return argsSendersPool{
+		proxy:                &interactors.ProxyStub{},
+		log:                  &testsCommon.LoggerStub{},
+		statusHandler:        testsCommon.NewStatusHandlerMock("test"),
+		privateKeys:          privateKeys,
+		scProxyBech32Address: "erd1qqqqqqqqqqqqqpgqk839entmk46ykukvhpn90g6knskju3dtanaq20f66e",
+		cfg: config.SenderWalletsConfig{
+			MinBalance:                    "1000",
+			BalanceCheckIntervalInSeconds: 60,
+		},
+	}
+}
+
+func getSendersAddresses(senders []*sender) []string {
+	addresses := make([]string, 0, len(senders))
+	for _, s := range senders {
+		addresses = append(addresses, s.bech32Address)
+	}
+
+	return addresses
+}
+
+func TestNewSendersPool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid min balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSendersPool(1)
+		args.cfg.MinBalance = "-1"
+
+		pool, err := newSendersPool(args)
+		assert.Nil(t, pool)
+		assert.ErrorIs(t, err, errInvalidValue)
+		assert.Contains(t, err.Error(), "SenderWallets.MinBalance")
+	})
+	t.Run("no private keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSendersPool(0)
+
+		pool, err := newSendersPool(args)
+		assert.Nil(t, pool)
+		assert.Equal(t, errNilPrivateKey, err)
+	})
+	t.Run("nil private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSendersPool(2)
+		args.privateKeys[1] = nil
+
+		pool, err := newSendersPool(args)
+		assert.Nil(t, pool)
+		assert.ErrorIs(t, err, errNilPrivateKey)
+		assert.Contains(t, err.Error(), "at index 1")
+	})
+	t.Run("duplicated private key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSendersPool(2)
+		args.privateKeys = append(args.privateKeys, args.privateKeys[0])
+
+		pool, err := newSendersPool(args)
+		assert.Nil(t, pool)
+		assert.ErrorIs(t, err, errDuplicatedSender)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSendersPool(3)
+		args.cfg.MinBalance = ""
+
+		pool, err := newSendersPool(args)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(pool.getSelectedSenders()))
+		assert.Equal(t, 3, args.statusHandler.(*testsCommon.StatusHandlerMock).GetIntMetric(bridgeCore.MetricNumActiveSenders))
+	})
+}
+
+func TestParseMinBalance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		expected *big.Int
+	}{
+		{name: "empty value disables the checks", value: "", expected: big.NewInt(0)},
+		{name: "zero disables the checks", value: "0", expected: big.NewInt(0)},
+		{name: "0.5 EGLD in the smallest unit", value: "500000000000000000", expected: big.NewInt(500000000000000000)},
+		{name: "decimal EGLD value should error", value: "0.5"},
+		{name: "value with unit should error", value: "1EGLD"},
+		{name: "negative value should error", value: "-1"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			minBalance, err := parseMinBalance(tt.value)
+			if tt.expected == nil {
+				assert.ErrorIs(t, err, errInvalidValue)
+				assert.Nil(t, minBalance)
+				return
+			}
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.expected, minBalance)
+		})
+	}
+}
+
+func TestSendersPool_refresh(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled balance checks should not request the accounts", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSendersPool(2)
+		args.cfg.MinBalance = "0"
+		args.proxy = &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				assert.Fail(t, "should have not called GetAccount")
+				return nil, nil
+			},
+		}
+
+		pool, _ := newSendersPool(args)
+		pool.refresh(context.Background())
+		assert.Equal(t, 2, len(pool.getSelectedSenders()))
+	})
+	t.Run("wallets below the minimum balance should be removed and added back once topped up", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSendersPool(3)
+		balances := make(map[string]string)
+		numGetAccountCalls := 0
+		args.proxy = &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				numGetAccountCalls++
+				bech32Address, _ := address.AddressAsBech32String()
+				if balances[bech32Address] == "error" {
+					return nil, errors.New("expected error")
+				}
+
+				return &data.Account{
+					Address: bech32Address,
+					Balance: balances[bech32Address],
+				}, nil
+			},
+		}
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.statusHandler = statusHandler
+
+		pool, _ := newSendersPool(args)
+		currentTime := time.Unix(1000, 0)
+		pool.getTimeHandler = func() time.Time {
+			return currentTime
+		}
+		addresses := getSendersAddresses(pool.senders)
+		balances[addresses[0]] = "1000"
+		balances[addresses[1]] = "999"
+		balances[addresses[2]] = "error"
+
+		pool.refresh(context.Background())
+		assert.Equal(t, 3, numGetAccountCalls)
+		assert.Equal(t, []string{addresses[0], addresses[2]}, getSendersAddresses(pool.getSelectedSenders()))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(bridgeCore.MetricNumActiveSenders))
+
+		// the check interval did not elapse
+		balances[addresses[1]] = "5000"
+		currentTime = currentTime.Add(time.Second * 59)
+		pool.refresh(context.Background())
+		assert.Equal(t, 3, numGetAccountCalls)
+		assert.Equal(t, []string{addresses[0], addresses[2]}, getSendersAddresses(pool.getSelectedSenders()))
+
+		currentTime = currentTime.Add(time.Second)
+		balances[addresses[2]] = "10"
+		pool.refresh(context.Background())
+		assert.Equal(t, 6, numGetAccountCalls)
+		assert.Equal(t, []string{addresses[0], addresses[1]}, getSendersAddresses(pool.getSelectedSenders()))
+		assert.Equal(t, 2, statusHandler.GetIntMetric(bridgeCore.MetricNumActiveSenders))
+	})
+	t.Run("same shard as the SC proxy should select the wallets from that shard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSendersPool(3)
+		args.cfg.MinBalance = "0"
+		args.cfg.SameShardAsScProxy = true
+		shards := make(map[string]uint32)
+		shards[args.scProxyBech32Address] = 1
+		var shardErr error
+		args.proxy = &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				return shards[bech32Address], shardErr
+			},
+		}
+
+		pool, _ := newSendersPool(args)
+		addresses := getSendersAddresses(pool.senders)
+		shards[addresses[1]] = 1
+
+		shardErr = errors.New("expected error")
+		pool.refresh(context.Background())
+		assert.Equal(t, addresses, getSendersAddresses(pool.getSelectedSenders()))
+
+		shardErr = nil
+		pool.refresh(context.Background())
+		assert.Equal(t, []string{addresses[1]}, getSendersAddresses(pool.getSelectedSenders()))
+	})
+	t.Run("no wallet in the SC proxy shard should use all active wallets", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSendersPool(2)
+		args.cfg.MinBalance = "0"
+		args.cfg.SameShardAsScProxy = true
+		args.proxy = &interactors.ProxyStub{
+			GetShardOfAddressCalled: func(ctx context.Context, bech32Address string) (uint32, error) {
+				if bech32Address == args.scProxyBech32Address {
+					return 2, nil
+				}
+
+				return 0, nil
+			},
+		}
+
+		pool, _ := newSendersPool(args)
+		pool.refresh(context.Background())
+		assert.Equal(t, getSendersAddresses(pool.senders), getSendersAddresses(pool.getSelectedSenders()))
+	})
+}
+
+func TestSendersPool_getStatuses(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsSendersPool(2)
+	args.proxy = &interactors.ProxyStub{
+		GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+			bech32Address, _ := address.AddressAsBech32String()
+			return &data.Account{
+				Address: bech32Address,
+				Nonce:   7,
+				Balance: "500",
+			}, nil
+		},
+	}
+
+	pool, _ := newSendersPool(args)
+	pool.refresh(context.Background())
+
+	addresses := getSendersAddresses(pool.senders)
+	statuses, err := pool.getStatuses(context.Background())
+	require.Nil(t, err)
+	assert.Equal(t, []*bridgeCore.ScCallsSenderStatus{
+		{
+			Address: addresses[0],
+			Nonce:   7,
+			Balance: "500",
+			Active:  false,
+		},
+		{
+			Address: addresses[1],
+			Nonce:   7,
+			Balance: "500",
+			Active:  false,
+		},
+	}, statuses)
+}
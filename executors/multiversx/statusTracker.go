@@ -0,0 +1,159 @@
+package multiversx
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/parsers"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// statusTracker keeps the last seen pending operations, with the decision taken for each of them, and the most
+// recent executions. It also updates the executor metrics
+type statusTracker struct {
+	statusHandler       bridgeCore.StatusHandler
+	maxRecentExecutions int
+	getTimeHandler      func() time.Time
+
+	mut               sync.RWMutex
+	pendingOperations []*bridgeCore.ScCallPendingOperation
+	recentExecutions  []*bridgeCore.ScCallExecution
+}
+
+func newStatusTracker(statusHandler bridgeCore.StatusHandler, maxRecentExecutions int) *statusTracker {
+	return &statusTracker{
+		statusHandler:       statusHandler,
+		maxRecentExecutions: maxRecentExecutions,
+		getTimeHandler:      time.Now,
+		pendingOperations:   make([]*bridgeCore.ScCallPendingOperation, 0),
+		recentExecutions:    make([]*bridgeCore.ScCallExecution, 0, maxRecentExecutions),
+	}
+}
+
+func (tracker *statusTracker) setPendingOperations(
+	pendingOperations map[uint64]parsers.ProxySCCompleteCallData,
+	decisions map[uint64]bridgeCore.ScCallFilterDecision,
+) {
+	result := make([]*bridgeCore.ScCallPendingOperation, 0, len(pendingOperations))
+	numAllowed := 0
+	for id, callData := range pendingOperations {
+		decision := decisions[id]
+		if decision == bridgeCore.ScCallAllowed {
+			numAllowed++
+		}
+
+		result = append(result, createPendingOperation(id, callData, decision))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	tracker.mut.Lock()
+	tracker.pendingOperations = result
+	tracker.mut.Unlock()
+
+	tracker.statusHandler.SetIntMetric(bridgeCore.MetricNumPendingOperations, len(pendingOperations))
+	tracker.statusHandler.SetIntMetric(bridgeCore.MetricNumAllowedOperations, numAllowed)
+}
+
+func createPendingOperation(id uint64, callData parsers.ProxySCCompleteCallData, decision bridgeCore.ScCallFilterDecision) *bridgeCore.ScCallPendingOperation {
+	to := ""
+	if !check.IfNil(callData.To) {
+		to, _ = callData.To.AddressAsBech32String()
+	}
+	amount := ""
+	if callData.Amount != nil {
+		amount = callData.Amount.String()
+	}
+
+	return &bridgeCore.ScCallPendingOperation{
+		ID:             id,
+		From:           callData.From.Hex(),
+		To:             to,
+		Token:          callData.Token,
+		Amount:         amount,
+		Nonce:          callData.Nonce,
+		FilterDecision: decision,
+	}
+}
+
+func (tracker *statusTracker) recordSentTransactions(pendingTxs []*pendingTransaction) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	now := tracker.getTimeHandler().Unix()
+	for _, pendingTx := range pendingTxs {
+		tracker.recentExecutions = append(tracker.recentExecutions, &bridgeCore.ScCallExecution{
+			ID:        pendingTx.id,
+			TxHash:    pendingTx.hash,
+			Sender:    pendingTx.tx.Sender,
+			Nonce:     pendingTx.tx.Nonce,
+			GasLimit:  pendingTx.tx.GasLimit,
+			Status:    bridgeCore.ScCallExecutionSent,
+			Timestamp: now,
+		})
+	}
+	if len(tracker.recentExecutions) > tracker.maxRecentExecutions {
+		numToRemove := len(tracker.recentExecutions) - tracker.maxRecentExecutions
+		tracker.recentExecutions = append(make([]*bridgeCore.ScCallExecution, 0, tracker.maxRecentExecutions),
+			tracker.recentExecutions[numToRemove:]...)
+	}
+
+	tracker.statusHandler.AddIntMetric(bridgeCore.MetricNumSentTransactions, len(pendingTxs))
+}
+
+func (tracker *statusTracker) recordExecutionResult(txHash string, err error) {
+	status := bridgeCore.ScCallExecutionSuccess
+	metric := bridgeCore.MetricNumSuccessfulExecutions
+	errString := ""
+	if err != nil {
+		status = bridgeCore.ScCallExecutionFailed
+		metric = bridgeCore.MetricNumFailedExecutions
+		errString = err.Error()
+		tracker.statusHandler.SetStringMetric(bridgeCore.MetricLastError, errString)
+	}
+	tracker.statusHandler.AddIntMetric(metric, 1)
+
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	for i := len(tracker.recentExecutions) - 1; i >= 0; i-- {
+		execution := tracker.recentExecutions[i]
+		if execution.TxHash != txHash {
+			continue
+		}
+
+		execution.Status = status
+		execution.Error = errString
+		return
+	}
+}
+
+func (tracker *statusTracker) getPendingOperations() []*bridgeCore.ScCallPendingOperation {
+	tracker.mut.RLock()
+	defer tracker.mut.RUnlock()
+
+	result := make([]*bridgeCore.ScCallPendingOperation, 0, len(tracker.pendingOperations))
+	for _, operation := range tracker.pendingOperations {
+		operationCopy := *operation
+		result = append(result, &operationCopy)
+	}
+
+	return result
+}
+
+// getRecentExecutions returns the most recent executions, the newest first
+func (tracker *statusTracker) getRecentExecutions() []*bridgeCore.ScCallExecution {
+	tracker.mut.RLock()
+	defer tracker.mut.RUnlock()
+
+	result := make([]*bridgeCore.ScCallExecution, 0, len(tracker.recentExecutions))
+	for i := len(tracker.recentExecutions) - 1; i >= 0; i-- {
+		executionCopy := *tracker.recentExecutions[i]
+		result = append(result, &executionCopy)
+	}
+
+	return result
+}
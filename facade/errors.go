@@ -4,3 +4,18 @@ import "errors"
 
 // ErrNilMetricsHolder signals that a nil metrics holder was provided
 var ErrNilMetricsHolder = errors.New("nil metrics holder")
+
+// ErrNilMisbehaviourEvidenceProvider signals that a nil misbehaviour evidence provider was provided
+var ErrNilMisbehaviourEvidenceProvider = errors.New("nil misbehaviour evidence provider")
+
+// ErrNilSignaturesProgressProvider signals that a nil signatures progress provider was provided
+var ErrNilSignaturesProgressProvider = errors.New("nil signatures progress provider")
+
+// ErrNilFeeAccountingProvider signals that a nil fee accounting provider was provided
+var ErrNilFeeAccountingProvider = errors.New("nil fee accounting provider")
+
+// ErrNilBatchTimelinesProvider signals that a nil batch timelines provider was provided
+var ErrNilBatchTimelinesProvider = errors.New("nil batch timelines provider")
+
+// ErrNilScCallsExecutorStatusProvider signals that a nil SC calls executor status provider was provided
+var ErrNilScCallsExecutorStatusProvider = errors.New("nil SC calls executor status provider")
@@ -0,0 +1,62 @@
+package facade
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+const (
+	prometheusMetricPrefix = "bridge_"
+	prometheusHandlerLabel = "handler"
+)
+
+// prometheusMetrics returns all the numeric metrics of all status handlers in the Prometheus text format
+func prometheusMetrics(metricsHolder core.MetricsHolder) string {
+	names := metricsHolder.GetAvailableStatusHandlers()
+	sort.Strings(names)
+
+	builder := strings.Builder{}
+	for _, name := range names {
+		metrics, err := metricsHolder.GetAllMetrics(name)
+		if err != nil {
+			continue
+		}
+
+		keys := make([]string, 0, len(metrics))
+		for key := range metrics {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			// only numeric values are accepted by Prometheus
+			switch metrics[key].(type) {
+			case int, int64, uint64, float64:
+			default:
+				continue
+			}
+
+			builder.WriteString(fmt.Sprintf("%s{%s=\"%s\"} %v\n", toPrometheusName(key), prometheusHandlerLabel, name, metrics[key]))
+		}
+	}
+
+	return builder.String()
+}
+
+// toPrometheusName converts the metric name in a valid Prometheus metric name
+func toPrometheusName(metric string) string {
+	converted := strings.Map(func(r rune) rune {
+		isLetter := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+		isDigit := r >= '0' && r <= '9'
+		if isLetter || isDigit {
+			return r
+		}
+
+		return '_'
+	}, strings.ToLower(metric))
+
+	return prometheusMetricPrefix + converted
+}
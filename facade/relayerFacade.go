@@ -1,6 +1,8 @@
 package facade
 
 import (
+	"bytes"
+
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 )
@@ -9,15 +11,23 @@ const availableMetrics = "available metrics"
 
 // ArgsRelayerFacade represents the DTO struct used in the relayer facade constructor
 type ArgsRelayerFacade struct {
-	MetricsHolder core.MetricsHolder
-	ApiInterface  string
-	PprofEnabled  bool
+	MetricsHolder              core.MetricsHolder
+	EvidenceProvider           core.MisbehaviourEvidenceProvider
+	SignaturesProgressProvider core.SignaturesProgressHandler
+	FeeAccountingProvider      core.FeeAccountingProvider
+	BatchTimelinesProvider     core.BatchTimelinesProvider
+	ApiInterface               string
+	PprofEnabled               bool
 }
 
 type relayerFacade struct {
-	metricsHolder core.MetricsHolder
-	apiInterface  string
-	pprofEnabled  bool
+	metricsHolder              core.MetricsHolder
+	evidenceProvider           core.MisbehaviourEvidenceProvider
+	signaturesProgressProvider core.SignaturesProgressHandler
+	feeAccountingProvider      core.FeeAccountingProvider
+	batchTimelinesProvider     core.BatchTimelinesProvider
+	apiInterface               string
+	pprofEnabled               bool
 }
 
 // NewRelayerFacade is the implementation of the relayer facade
@@ -25,11 +35,27 @@ func NewRelayerFacade(args ArgsRelayerFacade) (*relayerFacade, error) {
 	if check.IfNil(args.MetricsHolder) {
 		return nil, ErrNilMetricsHolder
 	}
+	if check.IfNil(args.EvidenceProvider) {
+		return nil, ErrNilMisbehaviourEvidenceProvider
+	}
+	if check.IfNil(args.SignaturesProgressProvider) {
+		return nil, ErrNilSignaturesProgressProvider
+	}
+	if check.IfNil(args.FeeAccountingProvider) {
+		return nil, ErrNilFeeAccountingProvider
+	}
+	if check.IfNil(args.BatchTimelinesProvider) {
+		return nil, ErrNilBatchTimelinesProvider
+	}
 
 	return &relayerFacade{
-		apiInterface:  args.ApiInterface,
-		pprofEnabled:  args.PprofEnabled,
-		metricsHolder: args.MetricsHolder,
+		apiInterface:               args.ApiInterface,
+		pprofEnabled:               args.PprofEnabled,
+		metricsHolder:              args.MetricsHolder,
+		evidenceProvider:           args.EvidenceProvider,
+		signaturesProgressProvider: args.SignaturesProgressProvider,
+		feeAccountingProvider:      args.FeeAccountingProvider,
+		batchTimelinesProvider:     args.BatchTimelinesProvider,
 	}, nil
 }
 
@@ -59,6 +85,43 @@ func (rf *relayerFacade) GetMetricsList() core.GeneralMetrics {
 	return result
 }
 
+// GetPrometheusMetrics returns all the numeric metrics in the Prometheus text format
+func (rf *relayerFacade) GetPrometheusMetrics() string {
+	return prometheusMetrics(rf.metricsHolder)
+}
+
+// GetMisbehaviourEvidence returns all the misbehaviour evidence gathered about the other relayers
+func (rf *relayerFacade) GetMisbehaviourEvidence() []*core.MisbehaviourEvidence {
+	return rf.evidenceProvider.GetAllEvidence()
+}
+
+// GetSignaturesProgress returns the signatures collection progress of each half-bridge
+func (rf *relayerFacade) GetSignaturesProgress() map[string]*core.SignaturesProgress {
+	return rf.signaturesProgressProvider.GetAllSignaturesProgress()
+}
+
+// GetFeeAccountingReport returns the fees paid by the relayer, aggregated by day, chain and action, along with the
+// tracked transactions
+func (rf *relayerFacade) GetFeeAccountingReport() *core.FeeAccountingReport {
+	return rf.feeAccountingProvider.GetFeeAccountingReport()
+}
+
+// GetDailyFeesCSV returns the fees paid by the relayer, aggregated by day, chain and action, in the CSV format
+func (rf *relayerFacade) GetDailyFeesCSV() ([]byte, error) {
+	buff := bytes.NewBuffer(nil)
+	err := rf.feeAccountingProvider.WriteDailyFeesCSV(buff)
+	if err != nil {
+		return nil, err
+	}
+
+	return buff.Bytes(), nil
+}
+
+// GetBatchTimelines returns the timelines of the recent batches, from creation to execution
+func (rf *relayerFacade) GetBatchTimelines() []*core.BatchTimeline {
+	return rf.batchTimelinesProvider.GetBatchTimelines()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (rf *relayerFacade) IsInterfaceNil() bool {
 	return rf == nil
@@ -2,6 +2,7 @@ package facade
 
 import (
 	"errors"
+	"io"
 	"testing"
 
 	"github.com/multiversx/mx-bridge-eth-go/core"
@@ -14,9 +15,13 @@ import (
 
 func createMockArguments() ArgsRelayerFacade {
 	return ArgsRelayerFacade{
-		MetricsHolder: status.NewMetricsHolder(),
-		ApiInterface:  core.WebServerOffString,
-		PprofEnabled:  true,
+		MetricsHolder:              status.NewMetricsHolder(),
+		EvidenceProvider:           &testsCommon.MisbehaviourEvidenceProviderStub{},
+		SignaturesProgressProvider: status.NewSignaturesProgressHolder(),
+		FeeAccountingProvider:      &testsCommon.FeeAccountingProviderStub{},
+		BatchTimelinesProvider:     &testsCommon.BatchTimelinesProviderStub{},
+		ApiInterface:               core.WebServerOffString,
+		PprofEnabled:               true,
 	}
 }
 
@@ -31,6 +36,38 @@ func TestNewRelayerFacade(t *testing.T) {
 		assert.True(t, check.IfNil(facade))
 		assert.True(t, errors.Is(err, ErrNilMetricsHolder))
 	})
+	t.Run("nil evidence provider should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.EvidenceProvider = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilMisbehaviourEvidenceProvider))
+	})
+	t.Run("nil signatures progress provider should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.SignaturesProgressProvider = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilSignaturesProgressProvider))
+	})
+	t.Run("nil fee accounting provider should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.FeeAccountingProvider = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilFeeAccountingProvider))
+	})
+	t.Run("nil batch timelines provider should error", func(t *testing.T) {
+		args := createMockArguments()
+		args.BatchTimelinesProvider = nil
+
+		facade, err := NewRelayerFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.True(t, errors.Is(err, ErrNilBatchTimelinesProvider))
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArguments()
 
@@ -103,3 +140,156 @@ func TestRelayerFacade_GetMetricsList(t *testing.T) {
 	expected[availableMetrics] = []string{"mock1", "mock2"}
 	assert.Equal(t, expected, response)
 }
+
+func TestRelayerFacade_GetMisbehaviourEvidence(t *testing.T) {
+	t.Parallel()
+
+	evidence := []*core.MisbehaviourEvidence{
+		{
+			Type:             core.ConflictingSignatures,
+			BatchID:          37,
+			RelayerPublicKey: []byte("pk"),
+		},
+	}
+	args := createMockArguments()
+	args.EvidenceProvider = &testsCommon.MisbehaviourEvidenceProviderStub{
+		GetAllEvidenceCalled: func() []*core.MisbehaviourEvidence {
+			return evidence
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, evidence, facade.GetMisbehaviourEvidence())
+}
+
+func TestRelayerFacade_GetSignaturesProgress(t *testing.T) {
+	t.Parallel()
+
+	progress := &core.SignaturesProgress{
+		BatchID:        37,
+		Quorum:         2,
+		NumSignatures:  1,
+		Signers:        []string{"relayer1"},
+		MissingSigners: []string{"relayer2"},
+	}
+	args := createMockArguments()
+	progressHolder := status.NewSignaturesProgressHolder()
+	progressHolder.SetSignaturesProgress("half-bridge", progress)
+	args.SignaturesProgressProvider = progressHolder
+	facade, _ := NewRelayerFacade(args)
+
+	expected := map[string]*core.SignaturesProgress{
+		"half-bridge": progress,
+	}
+	assert.Equal(t, expected, facade.GetSignaturesProgress())
+}
+
+func TestRelayerFacade_GetFeeAccountingReport(t *testing.T) {
+	t.Parallel()
+
+	report := &core.FeeAccountingReport{
+		Daily: []*core.DailyFees{
+			{
+				Day:             "2024-05-06",
+				Chain:           "MultiversX",
+				Action:          core.SignAction,
+				NumTransactions: 2,
+				GasUsed:         1000,
+				Fee:             "50000",
+			},
+		},
+	}
+	args := createMockArguments()
+	args.FeeAccountingProvider = &testsCommon.FeeAccountingProviderStub{
+		GetFeeAccountingReportCalled: func() *core.FeeAccountingReport {
+			return report
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, report, facade.GetFeeAccountingReport())
+}
+
+func TestRelayerFacade_GetDailyFeesCSV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("writer errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArguments()
+		args.FeeAccountingProvider = &testsCommon.FeeAccountingProviderStub{
+			WriteDailyFeesCSVCalled: func(writer io.Writer) error {
+				return expectedErr
+			},
+		}
+		facade, _ := NewRelayerFacade(args)
+
+		csvData, err := facade.GetDailyFeesCSV()
+		assert.Nil(t, csvData)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArguments()
+		args.FeeAccountingProvider = &testsCommon.FeeAccountingProviderStub{
+			WriteDailyFeesCSVCalled: func(writer io.Writer) error {
+				_, err := writer.Write([]byte("csv data"))
+				return err
+			},
+		}
+		facade, _ := NewRelayerFacade(args)
+
+		csvData, err := facade.GetDailyFeesCSV()
+		assert.Nil(t, err)
+		assert.Equal(t, "csv data", string(csvData))
+	})
+}
+
+func TestRelayerFacade_GetBatchTimelines(t *testing.T) {
+	t.Parallel()
+
+	timelines := []*core.BatchTimeline{
+		{
+			Direction:        "ToMultiversX",
+			BatchID:          37,
+			BlockNumber:      1234,
+			CreatedAt:        1000,
+			ExecutedAt:       1300,
+			LatencyInSeconds: 300,
+		},
+	}
+	args := createMockArguments()
+	args.BatchTimelinesProvider = &testsCommon.BatchTimelinesProviderStub{
+		GetBatchTimelinesCalled: func() []*core.BatchTimeline {
+			return timelines
+		},
+	}
+	facade, _ := NewRelayerFacade(args)
+
+	assert.Equal(t, timelines, facade.GetBatchTimelines())
+}
+
+func TestRelayerFacade_GetPrometheusMetrics(t *testing.T) {
+	t.Parallel()
+
+	sh1 := testsCommon.NewStatusHandlerMock("mock1")
+	sh1.SetIntMetric("num batches", 4)
+	sh1.SetStringMetric("last error", "error")
+	sh2 := testsCommon.NewStatusHandlerMock("mock-2")
+	sh2.SetIntMetric("ethereum last queried block-number", 37)
+	metricHolder := status.NewMetricsHolder()
+	errSetup := metricHolder.AddStatusHandler(sh2)
+	require.Nil(t, errSetup)
+	errSetup = metricHolder.AddStatusHandler(sh1)
+	require.Nil(t, errSetup)
+
+	args := createMockArguments()
+	args.MetricsHolder = metricHolder
+	facade, _ := NewRelayerFacade(args)
+
+	expected := "bridge_ethereum_last_queried_block_number{handler=\"mock-2\"} 37\n" +
+		"bridge_num_batches{handler=\"mock1\"} 4\n"
+	assert.Equal(t, expected, facade.GetPrometheusMetrics())
+}
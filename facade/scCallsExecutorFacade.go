@@ -0,0 +1,105 @@
+package facade
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ArgsScCallsExecutorFacade represents the DTO struct used in the SC calls executor facade constructor
+type ArgsScCallsExecutorFacade struct {
+	MetricsHolder  core.MetricsHolder
+	StatusProvider core.ScCallsExecutorStatusProvider
+	ApiInterface   string
+	PprofEnabled   bool
+}
+
+type scCallsExecutorFacade struct {
+	metricsHolder  core.MetricsHolder
+	statusProvider core.ScCallsExecutorStatusProvider
+	apiInterface   string
+	pprofEnabled   bool
+}
+
+// NewScCallsExecutorFacade is the implementation of the SC calls executor facade
+func NewScCallsExecutorFacade(args ArgsScCallsExecutorFacade) (*scCallsExecutorFacade, error) {
+	if check.IfNil(args.MetricsHolder) {
+		return nil, ErrNilMetricsHolder
+	}
+	if check.IfNil(args.StatusProvider) {
+		return nil, ErrNilScCallsExecutorStatusProvider
+	}
+
+	return &scCallsExecutorFacade{
+		metricsHolder:  args.MetricsHolder,
+		statusProvider: args.StatusProvider,
+		apiInterface:   args.ApiInterface,
+		pprofEnabled:   args.PprofEnabled,
+	}, nil
+}
+
+// RestApiInterface returns the interface on which the rest API should start on, based on the flags provided
+func (facade *scCallsExecutorFacade) RestApiInterface() string {
+	return facade.apiInterface
+}
+
+// PprofEnabled returns if profiling mode should be active or not on the application
+func (facade *scCallsExecutorFacade) PprofEnabled() bool {
+	return facade.pprofEnabled
+}
+
+// GetMetrics returns specified metric info. Errors if the metric is not found
+func (facade *scCallsExecutorFacade) GetMetrics(name string) (core.GeneralMetrics, error) {
+	return facade.metricsHolder.GetAllMetrics(name)
+}
+
+// GetMetricsList returns a list of all available metrics
+func (facade *scCallsExecutorFacade) GetMetricsList() core.GeneralMetrics {
+	availableNames := facade.metricsHolder.GetAvailableStatusHandlers()
+	result := make(core.GeneralMetrics)
+	result[availableMetrics] = availableNames
+
+	return result
+}
+
+// GetPrometheusMetrics returns all the numeric metrics in the Prometheus text format
+func (facade *scCallsExecutorFacade) GetPrometheusMetrics() string {
+	return prometheusMetrics(facade.metricsHolder)
+}
+
+// GetPendingOperations returns the pending operations, as seen on the last execution, along with the filter decision
+func (facade *scCallsExecutorFacade) GetPendingOperations() []*core.ScCallPendingOperation {
+	return facade.statusProvider.GetPendingOperations()
+}
+
+// GetRecentExecutions returns the most recent executions with their hashes and outcomes
+func (facade *scCallsExecutorFacade) GetRecentExecutions() []*core.ScCallExecution {
+	return facade.statusProvider.GetRecentExecutions()
+}
+
+// GetSendersStatus returns the balance, the nonce and the state of the accounts used to send the execution transactions
+func (facade *scCallsExecutorFacade) GetSendersStatus(ctx context.Context) ([]*core.ScCallsSenderStatus, error) {
+	return facade.statusProvider.GetSendersStatus(ctx)
+}
+
+// GetQuarantined returns the IDs of the quarantined operations
+func (facade *scCallsExecutorFacade) GetQuarantined() []uint64 {
+	return facade.statusProvider.GetQuarantined()
+}
+
+// ReleaseFromQuarantine will allow the requested quarantined operations to be executed again. It returns the IDs
+// of the released operations
+func (facade *scCallsExecutorFacade) ReleaseFromQuarantine(request core.ScCallsQuarantineRelease) []uint64 {
+	ids := request.IDs
+	if request.All {
+		ids = facade.statusProvider.GetQuarantined()
+	}
+
+	return facade.statusProvider.ReleaseFromQuarantine(ids)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (facade *scCallsExecutorFacade) IsInterfaceNil() bool {
+	return facade == nil
+}
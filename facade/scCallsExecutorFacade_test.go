@@ -0,0 +1,142 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/status"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsScCallsExecutorFacade() ArgsScCallsExecutorFacade {
+	return ArgsScCallsExecutorFacade{
+		MetricsHolder:  status.NewMetricsHolder(),
+		StatusProvider: &testsCommon.ScCallsExecutorStatusProviderStub{},
+		ApiInterface:   core.WebServerOffString,
+		PprofEnabled:   true,
+	}
+}
+
+func TestNewScCallsExecutorFacade(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil metrics holder should error", func(t *testing.T) {
+		args := createMockArgsScCallsExecutorFacade()
+		args.MetricsHolder = nil
+
+		facade, err := NewScCallsExecutorFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.Equal(t, ErrNilMetricsHolder, err)
+	})
+	t.Run("nil status provider should error", func(t *testing.T) {
+		args := createMockArgsScCallsExecutorFacade()
+		args.StatusProvider = nil
+
+		facade, err := NewScCallsExecutorFacade(args)
+		assert.True(t, check.IfNil(facade))
+		assert.Equal(t, ErrNilScCallsExecutorStatusProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		facade, err := NewScCallsExecutorFacade(createMockArgsScCallsExecutorFacade())
+		assert.False(t, check.IfNil(facade))
+		assert.Nil(t, err)
+	})
+}
+
+func TestScCallsExecutorFacade_Getters(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScCallsExecutorFacade()
+	sh := testsCommon.NewStatusHandlerMock(core.ScCallsExecutorStatusHandlerName)
+	sh.SetIntMetric(core.MetricNumPendingOperations, 3)
+	errSetup := args.MetricsHolder.AddStatusHandler(sh)
+	require.Nil(t, errSetup)
+
+	pendingOperations := []*core.ScCallPendingOperation{
+		{
+			ID:             1,
+			FilterDecision: core.ScCallDeniedByFilter,
+		},
+	}
+	executions := []*core.ScCallExecution{
+		{
+			ID:     1,
+			TxHash: "hash",
+			Status: core.ScCallExecutionSuccess,
+		},
+	}
+	senderStatus := []*core.ScCallsSenderStatus{
+		{
+			Address: "erd1sender",
+			Nonce:   37,
+			Balance: "1000",
+			Active:  true,
+		},
+	}
+	expectedErr := errors.New("expected error")
+	var senderErr error
+	args.StatusProvider = &testsCommon.ScCallsExecutorStatusProviderStub{
+		GetPendingOperationsCalled: func() []*core.ScCallPendingOperation {
+			return pendingOperations
+		},
+		GetRecentExecutionsCalled: func() []*core.ScCallExecution {
+			return executions
+		},
+		GetSendersStatusCalled: func(ctx context.Context) ([]*core.ScCallsSenderStatus, error) {
+			if senderErr != nil {
+				return nil, senderErr
+			}
+
+			return senderStatus, nil
+		},
+	}
+	facade, _ := NewScCallsExecutorFacade(args)
+
+	assert.Equal(t, core.WebServerOffString, facade.RestApiInterface())
+	assert.True(t, facade.PprofEnabled())
+	assert.Equal(t, pendingOperations, facade.GetPendingOperations())
+	assert.Equal(t, executions, facade.GetRecentExecutions())
+
+	metrics, err := facade.GetMetrics(core.ScCallsExecutorStatusHandlerName)
+	assert.Nil(t, err)
+	assert.Equal(t, core.GeneralMetrics{core.MetricNumPendingOperations: 3}, metrics)
+	assert.Equal(t, core.GeneralMetrics{availableMetrics: []string{core.ScCallsExecutorStatusHandlerName}}, facade.GetMetricsList())
+	assert.Equal(t, "bridge_num_pending_operations{handler=\"sc-calls-executor\"} 3\n", facade.GetPrometheusMetrics())
+
+	result, err := facade.GetSendersStatus(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, senderStatus, result)
+
+	senderErr = expectedErr
+	result, err = facade.GetSendersStatus(context.Background())
+	assert.Nil(t, result)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestScCallsExecutorFacade_ReleaseFromQuarantine(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScCallsExecutorFacade()
+	var releasedIDs []uint64
+	args.StatusProvider = &testsCommon.ScCallsExecutorStatusProviderStub{
+		GetQuarantinedCalled: func() []uint64 {
+			return []uint64{3, 7}
+		},
+		ReleaseFromQuarantineCalled: func(ids []uint64) []uint64 {
+			releasedIDs = ids
+			return ids
+		},
+	}
+	facade, _ := NewScCallsExecutorFacade(args)
+
+	assert.Equal(t, []uint64{3, 7}, facade.GetQuarantined())
+	assert.Equal(t, []uint64{7}, facade.ReleaseFromQuarantine(core.ScCallsQuarantineRelease{IDs: []uint64{7}}))
+	assert.Equal(t, []uint64{7}, releasedIDs)
+	assert.Equal(t, []uint64{3, 7}, facade.ReleaseFromQuarantine(core.ScCallsQuarantineRelease{All: true}))
+	assert.Equal(t, []uint64{3, 7}, releasedIDs)
+}
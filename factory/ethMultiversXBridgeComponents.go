@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/steps/multiversxToEth"
 	"github.com/multiversx/mx-bridge-eth-go/bridges/ethMultiversX/topology"
 	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/clients/balanceMonitor"
 	balanceValidatorManagement "github.com/multiversx/mx-bridge-eth-go/clients/balanceValidator"
 	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
 	"github.com/multiversx/mx-bridge-eth-go/clients/ethereum"
@@ -24,13 +25,20 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/clients/roleProviders"
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
 	"github.com/multiversx/mx-bridge-eth-go/core/converters"
 	"github.com/multiversx/mx-bridge-eth-go/core/timer"
+	"github.com/multiversx/mx-bridge-eth-go/feeAccounting"
+	feeAccountingDisabled "github.com/multiversx/mx-bridge-eth-go/feeAccounting/disabled"
+	"github.com/multiversx/mx-bridge-eth-go/latency"
+	latencyDisabled "github.com/multiversx/mx-bridge-eth-go/latency/disabled"
+	"github.com/multiversx/mx-bridge-eth-go/misbehaviour"
 	"github.com/multiversx/mx-bridge-eth-go/p2p"
 	"github.com/multiversx/mx-bridge-eth-go/stateMachine"
 	"github.com/multiversx/mx-bridge-eth-go/status"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	factoryMarshaller "github.com/multiversx/mx-chain-core-go/marshal/factory"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
@@ -47,7 +55,9 @@ import (
 const (
 	minTimeForBootstrap     = time.Millisecond * 100
 	minTimeBeforeRepeatJoin = time.Second * 30
+	maxTrackedBatches       = 100
 	pollingDurationOnError  = time.Second * 5
+	feeAccountingLogId      = "FeeAccounting"
 )
 
 var suite = ed25519.NewEd25519()
@@ -86,9 +96,17 @@ type ethMultiversXBridgeComponents struct {
 	multiversXRoleProvider            MultiversXRoleProvider
 	ethereumRoleProvider              EthereumRoleProvider
 	broadcaster                       Broadcaster
+	misbehaviourDetector              misbehaviourDetector
+	feeAccountant                     feeAccountant
+	ethTxCostResolver                 core.TransactionCostResolver
+	multiversXTxCostResolver          core.TransactionCostResolver
+	latencyTracker                    latencyTracker
+	ethBlockTimestampResolver         core.BlockTimestampResolver
+	multiversXBlockTimestampResolver  core.BlockTimestampResolver
 	timer                             core.Timer
 	timeForBootstrap                  time.Duration
 	metricsHolder                     core.MetricsHolder
+	signaturesProgressHolder          core.SignaturesProgressHandler
 	addressConverter                  core.AddressConverter
 
 	ethToMultiversXMachineStates    core.MachineStates
@@ -122,17 +140,18 @@ func NewEthMultiversXBridgeComponents(args ArgsEthereumToMultiversXBridge) (*eth
 	ethToMultiversXName := evmCompatibleChain.EvmCompatibleChainToMultiversXName()
 	baseLogId := evmCompatibleChain.BaseLogId()
 	components := &ethMultiversXBridgeComponents{
-		baseLogger:           core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToMultiversXName), baseLogId),
-		evmCompatibleChain:   evmCompatibleChain,
-		messenger:            args.Messenger,
-		statusStorer:         args.StatusStorer,
-		closableHandlers:     make([]io.Closer, 0),
-		proxy:                args.Proxy,
-		timer:                timer.NewNTPTimer(),
-		timeForBootstrap:     args.TimeForBootstrap,
-		timeBeforeRepeatJoin: args.TimeBeforeRepeatJoin,
-		metricsHolder:        args.MetricsHolder,
-		appStatusHandler:     args.AppStatusHandler,
+		baseLogger:               core.NewLoggerWithIdentifier(logger.GetOrCreate(ethToMultiversXName), baseLogId),
+		evmCompatibleChain:       evmCompatibleChain,
+		messenger:                args.Messenger,
+		statusStorer:             args.StatusStorer,
+		closableHandlers:         make([]io.Closer, 0),
+		proxy:                    args.Proxy,
+		timer:                    timer.NewNTPTimer(),
+		timeForBootstrap:         args.TimeForBootstrap,
+		timeBeforeRepeatJoin:     args.TimeBeforeRepeatJoin,
+		metricsHolder:            args.MetricsHolder,
+		signaturesProgressHolder: status.NewSignaturesProgressHolder(),
+		appStatusHandler:         args.AppStatusHandler,
 	}
 
 	addressConverter, err := converters.NewAddressConverter()
@@ -148,7 +167,7 @@ func NewEthMultiversXBridgeComponents(args ArgsEthereumToMultiversXBridge) (*eth
 		return nil, err
 	}
 
-	err = components.createDataGetter()
+	err = components.createDataGetter(args)
 	if err != nil {
 		return nil, err
 	}
@@ -173,6 +192,16 @@ func NewEthMultiversXBridgeComponents(args ArgsEthereumToMultiversXBridge) (*eth
 		return nil, err
 	}
 
+	err = components.createFeeAccountant(args.Configs.GeneralConfig.Relayer.FeeAccounting)
+	if err != nil {
+		return nil, err
+	}
+
+	err = components.createLatencyTracker(args.Configs.GeneralConfig.Relayer.TransferLatency)
+	if err != nil {
+		return nil, err
+	}
+
 	err = components.createEthereumToMultiversXBridge(args)
 	if err != nil {
 		return nil, err
@@ -264,7 +293,7 @@ func (components *ethMultiversXBridgeComponents) createMultiversXKeysAndAddresse
 	return nil
 }
 
-func (components *ethMultiversXBridgeComponents) createDataGetter() error {
+func (components *ethMultiversXBridgeComponents) createDataGetter(args ArgsEthereumToMultiversXBridge) error {
 	multiversXDataGetterLogId := components.evmCompatibleChain.MultiversXDataGetterLogId()
 	argsMXClientDataGetter := multiversx.ArgsMXClientDataGetter{
 		MultisigContractAddress: components.multiversXMultisigContractAddress,
@@ -272,6 +301,7 @@ func (components *ethMultiversXBridgeComponents) createDataGetter() error {
 		RelayerAddress:          components.multiversXRelayerAddress,
 		Proxy:                   components.proxy,
 		Log:                     core.NewLoggerWithIdentifier(logger.GetOrCreate(multiversXDataGetterLogId), multiversXDataGetterLogId),
+		ContractsVersion:        core.ContractsVersion(args.Configs.GeneralConfig.MultiversX.ContractsVersion),
 	}
 
 	var err error
@@ -300,12 +330,26 @@ func (components *ethMultiversXBridgeComponents) createMultiversXClient(args Arg
 		RoleProvider:                 components.multiversXRoleProvider,
 		StatusHandler:                args.MultiversXClientStatusHandler,
 		ClientAvailabilityAllowDelta: chainConfigs.ClientAvailabilityAllowDelta,
+		ContractsVersion:             core.ContractsVersion(chainConfigs.ContractsVersion),
+	}
+
+	multiversXClient, err := multiversx.NewClient(clientArgs)
+	if err != nil {
+		return err
 	}
 
-	components.multiversXClient, err = multiversx.NewClient(clientArgs)
+	components.multiversXClient = multiversXClient
+	components.multiversXTxCostResolver = multiversXClient
+	components.multiversXBlockTimestampResolver = multiversXClient
 	components.addClosableComponent(components.multiversXClient)
 
-	return err
+	return components.createBalanceMonitor(
+		"MultiversX",
+		components.evmCompatibleChain.MultiversXBalanceMonitorLogId(),
+		multiversXClient,
+		args.MultiversXClientStatusHandler,
+		chainConfigs.BalanceMonitor,
+	)
 }
 
 func (components *ethMultiversXBridgeComponents) createEthereumClient(args ArgsEthereumToMultiversXBridge) error {
@@ -335,7 +379,7 @@ func (components *ethMultiversXBridgeComponents) createEthereumClient(args ArgsE
 		return err
 	}
 
-	peerDenialEvaluator, err := p2p.NewPeerDenialEvaluator(antifloodComponents.BlacklistHandler, antifloodComponents.PubKeysCacher)
+	peerDenialEvaluator, err := components.createPeerDenialEvaluator(args.Configs.GeneralConfig.P2P.PrivateNetwork, antifloodComponents)
 	if err != nil {
 		return err
 	}
@@ -344,6 +388,16 @@ func (components *ethMultiversXBridgeComponents) createEthereumClient(args ArgsE
 		return err
 	}
 
+	marshaller, err := factoryMarshaller.NewMarshalizer(args.Configs.GeneralConfig.Relayer.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+
+	err = components.createMisbehaviourDetector()
+	if err != nil {
+		return err
+	}
+
 	broadcasterLogId := components.evmCompatibleChain.BroadcasterLogId()
 	ethToMultiversXName := components.evmCompatibleChain.EvmCompatibleChainToMultiversXName()
 	argsBroadcaster := p2p.ArgsBroadcaster{
@@ -356,6 +410,9 @@ func (components *ethMultiversXBridgeComponents) createEthereumClient(args ArgsE
 		PrivateKey:             components.multiversXRelayerPrivateKey,
 		Name:                   ethToMultiversXName,
 		AntifloodComponents:    antifloodComponents,
+		Marshalizer:            marshaller,
+		PrivateNetworkEnabled:  args.Configs.GeneralConfig.P2P.PrivateNetwork.Enabled,
+		MisbehaviourDetector:   components.misbehaviourDetector,
 	}
 
 	components.broadcaster, err = p2p.NewBroadcaster(argsBroadcaster)
@@ -375,7 +432,15 @@ func (components *ethMultiversXBridgeComponents) createEthereumClient(args ArgsE
 		return err
 	}
 
-	signaturesHolder := ethmultiversx.NewSignatureHolder()
+	signaturesHolderConfig := args.Configs.GeneralConfig.Relayer.SignaturesHolder
+	argsSignaturesHolder := ethmultiversx.ArgsSignaturesHolder{
+		Expiry:                    time.Duration(signaturesHolderConfig.ExpiryInSeconds) * time.Second,
+		MaxMessageHashesPerSigner: signaturesHolderConfig.MaxMessageHashesPerSigner,
+	}
+	signaturesHolder, err := ethmultiversx.NewSignatureHolder(argsSignaturesHolder)
+	if err != nil {
+		return err
+	}
 	components.ethToMultiversXSignaturesHolder = signaturesHolder
 	err = components.broadcaster.AddBroadcastClient(signaturesHolder)
 	if err != nil {
@@ -403,9 +468,66 @@ func (components *ethMultiversXBridgeComponents) createEthereumClient(args ArgsE
 		EventsBlockRangeTo:           ethereumConfigs.EventsBlockRangeTo,
 	}
 
-	components.ethClient, err = ethereum.NewEthereumClient(argsEthClient)
+	ethClient, err := ethereum.NewEthereumClient(argsEthClient)
+	if err != nil {
+		return err
+	}
+
+	components.ethClient = ethClient
+	components.ethTxCostResolver = ethClient
+	components.ethBlockTimestampResolver = ethClient
 
-	return err
+	return components.createBalanceMonitor(
+		string(components.evmCompatibleChain),
+		components.evmCompatibleChain.EvmCompatibleChainBalanceMonitorLogId(),
+		ethClient,
+		args.ClientWrapper,
+		ethereumConfigs.BalanceMonitor,
+	)
+}
+
+func (components *ethMultiversXBridgeComponents) createBalanceMonitor(
+	chainName string,
+	balanceMonitorLogId string,
+	fundsHandler balanceMonitor.RelayerFundsHandler,
+	statusHandler core.StatusHandler,
+	cfg config.BalanceMonitorConfig,
+) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(balanceMonitorLogId), balanceMonitorLogId)
+	argsBalanceMonitor := balanceMonitor.ArgsBalanceMonitor{
+		Log:           log,
+		ChainName:     chainName,
+		FundsHandler:  fundsHandler,
+		StatusHandler: statusHandler,
+		Config:        cfg,
+	}
+
+	monitor, err := balanceMonitor.NewBalanceMonitor(argsBalanceMonitor)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             chainName + " balance monitor",
+		PollingInterval:  time.Duration(cfg.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         monitor,
+	}
+
+	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
 }
 
 func (components *ethMultiversXBridgeComponents) createMultiversXRoleProvider(args ArgsEthereumToMultiversXBridge) error {
@@ -528,9 +650,13 @@ func (components *ethMultiversXBridgeComponents) createEthereumToMultiversXBridg
 		TimeForWaitOnEthereum:        timeForTransferExecution,
 		SignaturesHolder:             disabled.NewDisabledSignaturesHolder(),
 		BalanceValidator:             balanceValidator,
+		SignaturesProgressHandler:    components.signaturesProgressHolder,
 		MaxQuorumRetriesOnEthereum:   args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
 		MaxQuorumRetriesOnMultiversX: args.Configs.GeneralConfig.MultiversX.MaxRetriesOnQuorumReached,
 		MaxRestriesOnWasProposed:     args.Configs.GeneralConfig.MultiversX.MaxRetriesOnWasTransferProposed,
+		TransactionsRecorder:         components.feeAccountant,
+		LatencyRecorder:              components.latencyTracker,
+		Direction:                    batchProcessor.ToMultiversX,
 	}
 
 	bridge, err := ethmultiversx.NewBridgeExecutor(argsBridgeExecutor)
@@ -596,9 +722,13 @@ func (components *ethMultiversXBridgeComponents) createMultiversXToEthereumBridg
 		TimeForWaitOnEthereum:        timeForWaitOnEthereum,
 		SignaturesHolder:             components.ethToMultiversXSignaturesHolder,
 		BalanceValidator:             balanceValidator,
+		SignaturesProgressHandler:    components.signaturesProgressHolder,
 		MaxQuorumRetriesOnEthereum:   args.Configs.GeneralConfig.Eth.MaxRetriesOnQuorumReached,
 		MaxQuorumRetriesOnMultiversX: args.Configs.GeneralConfig.MultiversX.MaxRetriesOnQuorumReached,
 		MaxRestriesOnWasProposed:     args.Configs.GeneralConfig.MultiversX.MaxRetriesOnWasTransferProposed,
+		TransactionsRecorder:         components.feeAccountant,
+		LatencyRecorder:              components.latencyTracker,
+		Direction:                    batchProcessor.FromMultiversX,
 	}
 
 	bridge, err := ethmultiversx.NewBridgeExecutor(argsBridgeExecutor)
@@ -757,6 +887,144 @@ func (components *ethMultiversXBridgeComponents) createAntifloodComponents(antif
 	return antiFloodComponents, nil
 }
 
+func (components *ethMultiversXBridgeComponents) createPeerDenialEvaluator(
+	privateNetworkConfig config.PrivateNetworkConfig,
+	antifloodComponents *antifloodFactory.AntiFloodComponents,
+) (p2p.PeerDenialEvaluator, error) {
+	peerDenialEvaluator, err := p2p.NewPeerDenialEvaluator(antifloodComponents.BlacklistHandler, antifloodComponents.PubKeysCacher)
+	if err != nil {
+		return nil, err
+	}
+	if !privateNetworkConfig.Enabled {
+		return peerDenialEvaluator, nil
+	}
+
+	components.baseLogger.Info("running the relayers in a private network", "num static peers", len(privateNetworkConfig.StaticPeers))
+	argsPrivateNetworkEvaluator := p2p.ArgsPrivateNetworkPeerDenialEvaluator{
+		PeerDenialEvaluator: peerDenialEvaluator,
+		StaticPeers:         privateNetworkConfig.StaticPeers,
+	}
+
+	return p2p.NewPrivateNetworkPeerDenialEvaluator(argsPrivateNetworkEvaluator)
+}
+
+func (components *ethMultiversXBridgeComponents) createMisbehaviourDetector() error {
+	statusHandler, err := status.NewStatusHandler(core.MisbehaviourStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	argsDetector := misbehaviour.ArgsMisbehaviourDetector{
+		Log:               core.NewLoggerWithIdentifier(logger.GetOrCreate(core.MisbehaviourStatusHandlerName), core.MisbehaviourStatusHandlerName),
+		Storer:            components.statusStorer,
+		StatusHandler:     statusHandler,
+		MaxTrackedBatches: maxTrackedBatches,
+	}
+
+	components.misbehaviourDetector, err = misbehaviour.NewMisbehaviourDetector(argsDetector)
+
+	return err
+}
+
+func (components *ethMultiversXBridgeComponents) createFeeAccountant(cfg config.FeeAccountingConfig) error {
+	if !cfg.Enabled {
+		components.feeAccountant = feeAccountingDisabled.NewDisabledFeeAccountant()
+		return nil
+	}
+
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(feeAccountingLogId), feeAccountingLogId)
+	argsFeeAccountant := feeAccounting.ArgsFeeAccountant{
+		Log:                    log,
+		Storer:                 components.statusStorer,
+		EvmCompatibleChain:     components.evmCompatibleChain,
+		EthereumResolver:       components.ethTxCostResolver,
+		MultiversXResolver:     components.multiversXTxCostResolver,
+		MaxTrackedTransactions: cfg.MaxTrackedTransactions,
+		MaxResolveAttempts:     cfg.MaxResolveAttempts,
+		RetentionInDays:        cfg.RetentionInDays,
+	}
+
+	accountant, err := feeAccounting.NewFeeAccountant(argsFeeAccountant)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "fee accountant",
+		PollingInterval:  time.Duration(cfg.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         accountant,
+	}
+
+	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.feeAccountant = accountant
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
+func (components *ethMultiversXBridgeComponents) createLatencyTracker(cfg config.TransferLatencyConfig) error {
+	if !cfg.Enabled {
+		components.latencyTracker = latencyDisabled.NewDisabledLatencyTracker()
+		return nil
+	}
+
+	statusHandler, err := status.NewStatusHandler(core.LatencyStatusHandlerName, components.statusStorer)
+	if err != nil {
+		return err
+	}
+
+	err = components.metricsHolder.AddStatusHandler(statusHandler)
+	if err != nil {
+		return err
+	}
+
+	log := core.NewLoggerWithIdentifier(logger.GetOrCreate(core.LatencyStatusHandlerName), core.LatencyStatusHandlerName)
+	argsLatencyTracker := latency.ArgsLatencyTracker{
+		Log:                       log,
+		StatusHandler:             statusHandler,
+		EthereumResolver:          components.ethBlockTimestampResolver,
+		MultiversXResolver:        components.multiversXBlockTimestampResolver,
+		MaxTrackedBatches:         cfg.MaxTrackedBatches,
+		HistogramBucketsInSeconds: cfg.HistogramBucketsInSeconds,
+	}
+
+	tracker, err := latency.NewLatencyTracker(argsLatencyTracker)
+	if err != nil {
+		return err
+	}
+
+	argsPollingHandler := polling.ArgsPollingHandler{
+		Log:              log,
+		Name:             "latency tracker",
+		PollingInterval:  time.Duration(cfg.PollingIntervalInSeconds) * time.Second,
+		PollingWhenError: pollingDurationOnError,
+		Executor:         tracker,
+	}
+
+	pollingHandler, err := polling.NewPollingHandler(argsPollingHandler)
+	if err != nil {
+		return err
+	}
+
+	components.latencyTracker = tracker
+	components.addClosableComponent(pollingHandler)
+	components.pollingHandlers = append(components.pollingHandlers, pollingHandler)
+
+	return nil
+}
+
 func (components *ethMultiversXBridgeComponents) startBroadcastJoinRetriesLoop(ctx context.Context) {
 	broadcastTimer := time.NewTimer(components.timeBeforeRepeatJoin)
 	defer broadcastTimer.Stop()
@@ -812,3 +1080,23 @@ func (components *ethMultiversXBridgeComponents) MultiversXRelayerAddress() sdkC
 func (components *ethMultiversXBridgeComponents) EthereumRelayerAddress() common.Address {
 	return components.ethereumRelayerAddress
 }
+
+// MisbehaviourEvidenceProvider returns the component able to provide the gathered misbehaviour evidence
+func (components *ethMultiversXBridgeComponents) MisbehaviourEvidenceProvider() core.MisbehaviourEvidenceProvider {
+	return components.misbehaviourDetector
+}
+
+// SignaturesProgressProvider returns the component able to provide the signatures collection progress
+func (components *ethMultiversXBridgeComponents) SignaturesProgressProvider() core.SignaturesProgressHandler {
+	return components.signaturesProgressHolder
+}
+
+// FeeAccountingProvider returns the component able to provide the fees paid by the relayer
+func (components *ethMultiversXBridgeComponents) FeeAccountingProvider() core.FeeAccountingProvider {
+	return components.feeAccountant
+}
+
+// BatchTimelinesProvider returns the component able to provide the timelines of the recent batches
+func (components *ethMultiversXBridgeComponents) BatchTimelinesProvider() core.BatchTimelinesProvider {
+	return components.latencyTracker
+}
@@ -8,14 +8,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-bridge-eth-go/clients"
 	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/p2p"
 	"github.com/multiversx/mx-bridge-eth-go/status"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
 	p2pMocks "github.com/multiversx/mx-bridge-eth-go/testsCommon/p2p"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	chainConfig "github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-sdk-go/blockchain"
@@ -72,9 +75,16 @@ func createMockEthMultiversXBridgeArgs() ArgsEthereumToMultiversXBridge {
 			},
 		},
 		Relayer: config.ConfigRelayer{
+			Marshalizer: chainConfig.MarshalizerConfig{
+				Type: "gogo protobuf",
+			},
 			RoleProvider: config.RoleProviderConfig{
 				PollingIntervalInMillis: 1000,
 			},
+			SignaturesHolder: config.SignaturesHolderConfig{
+				ExpiryInSeconds:           3600,
+				MaxMessageHashesPerSigner: 100,
+			},
 		},
 		StateMachine: map[string]config.ConfigStateMachine{
 			"EthereumToMultiversX": stateMachineConfig,
@@ -203,6 +213,15 @@ func TestNewEthMultiversXBridgeComponents(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, components)
 	})
+	t.Run("err on createEthereumClient, invalid signatures holder config", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.Relayer.SignaturesHolder.MaxMessageHashesPerSigner = 0
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.True(t, errors.Is(err, clients.ErrInvalidValue))
+		assert.Nil(t, components)
+	})
 	t.Run("err on createEthereumClient, invalid gas price selector", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthMultiversXBridgeArgs()
@@ -212,6 +231,27 @@ func TestNewEthMultiversXBridgeComponents(t *testing.T) {
 		assert.NotNil(t, err)
 		assert.Nil(t, components)
 	})
+	t.Run("err on createEthereumClient, private network without static peers", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.P2P.PrivateNetwork.Enabled = true
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		assert.Equal(t, p2p.ErrEmptyStaticPeers, err)
+		assert.Nil(t, components)
+	})
+	t.Run("private network should work", func(t *testing.T) {
+		t.Parallel()
+		args := createMockEthMultiversXBridgeArgs()
+		args.Configs.GeneralConfig.P2P.PrivateNetwork = config.PrivateNetworkConfig{
+			Enabled:     true,
+			StaticPeers: []string{"/ip4/127.0.0.1/tcp/10010/p2p/16Uiu2HAmAzokH1ozUF52Vy3RKqRfCMr9ZdNDkUQFEkXRs9DqvmKf"},
+		}
+
+		components, err := NewEthMultiversXBridgeComponents(args)
+		require.Nil(t, err)
+		require.NotNil(t, components)
+	})
 	t.Run("err missing state machine config", func(t *testing.T) {
 		t.Parallel()
 		args := createMockEthMultiversXBridgeArgs()
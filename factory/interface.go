@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/multiversx/mx-bridge-eth-go/core"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 )
 
@@ -31,7 +32,7 @@ type EthereumRoleProvider interface {
 
 // Broadcaster defines a component able to communicate with other such instances and manage signatures and other state related data
 type Broadcaster interface {
-	BroadcastSignature(signature []byte, messageHash []byte)
+	BroadcastSignature(signature []byte, messageHash []byte, batchID uint64)
 	BroadcastJoinTopic()
 	SortedPublicKeys() [][]byte
 	RegisterOnTopics() error
@@ -40,6 +41,23 @@ type Broadcaster interface {
 	IsInterfaceNil() bool
 }
 
+type misbehaviourDetector interface {
+	ProcessSignature(msg *core.SignedMessage, ethMsg *core.EthereumSignature, peerID chainCore.PeerID)
+	ProcessLocalSignature(msg *core.SignedMessage, ethMsg *core.EthereumSignature)
+	GetAllEvidence() []*core.MisbehaviourEvidence
+	IsInterfaceNil() bool
+}
+
+type feeAccountant interface {
+	core.TransactionsRecorder
+	core.FeeAccountingProvider
+}
+
+type latencyTracker interface {
+	core.LatencyRecorder
+	core.BatchTimelinesProvider
+}
+
 // StateMachine defines a state machine component
 type StateMachine interface {
 	Execute(ctx context.Context) error
@@ -9,12 +9,25 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/facade"
 )
 
-// StartWebServer creates and starts a web server able to respond with the metrics holder information
-func StartWebServer(configs config.Configs, metricsHolder core.MetricsHolder) (io.Closer, error) {
+// StartWebServer creates and starts a web server able to respond with the metrics holder information, the gathered
+// misbehaviour evidence, the signatures collection progress, the fees paid by the relayer and the timelines of the
+// recent batches
+func StartWebServer(
+	configs config.Configs,
+	metricsHolder core.MetricsHolder,
+	evidenceProvider core.MisbehaviourEvidenceProvider,
+	signaturesProgressProvider core.SignaturesProgressHandler,
+	feeAccountingProvider core.FeeAccountingProvider,
+	batchTimelinesProvider core.BatchTimelinesProvider,
+) (io.Closer, error) {
 	argsFacade := facade.ArgsRelayerFacade{
-		MetricsHolder: metricsHolder,
-		ApiInterface:  configs.FlagsConfig.RestApiInterface,
-		PprofEnabled:  configs.FlagsConfig.EnablePprof,
+		MetricsHolder:              metricsHolder,
+		EvidenceProvider:           evidenceProvider,
+		SignaturesProgressProvider: signaturesProgressProvider,
+		FeeAccountingProvider:      feeAccountingProvider,
+		BatchTimelinesProvider:     batchTimelinesProvider,
+		ApiInterface:               configs.FlagsConfig.RestApiInterface,
+		PprofEnabled:               configs.FlagsConfig.EnablePprof,
 	}
 
 	relayerFacade, err := facade.NewRelayerFacade(argsFacade)
@@ -40,3 +53,43 @@ func StartWebServer(configs config.Configs, metricsHolder core.MetricsHolder) (i
 
 	return httpServerWrapper, nil
 }
+
+// StartScCallsExecutorWebServer creates and starts a web server able to respond with the metrics holder information
+// and the SC calls executor status
+func StartScCallsExecutorWebServer(
+	flagsConfig config.ContextFlagsConfig,
+	apiRoutesConfig config.ApiRoutesConfig,
+	antiFloodConfig config.WebAntifloodConfig,
+	metricsHolder core.MetricsHolder,
+	statusProvider core.ScCallsExecutorStatusProvider,
+) (io.Closer, error) {
+	argsFacade := facade.ArgsScCallsExecutorFacade{
+		MetricsHolder:  metricsHolder,
+		StatusProvider: statusProvider,
+		ApiInterface:   flagsConfig.RestApiInterface,
+		PprofEnabled:   flagsConfig.EnablePprof,
+	}
+
+	executorFacade, err := facade.NewScCallsExecutorFacade(argsFacade)
+	if err != nil {
+		return nil, err
+	}
+
+	httpServerArgs := gin.ArgsNewWebServer{
+		Facade:          executorFacade,
+		ApiConfig:       apiRoutesConfig,
+		AntiFloodConfig: antiFloodConfig,
+	}
+
+	httpServerWrapper, err := gin.NewWebServerHandler(httpServerArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = httpServerWrapper.StartHttpServer()
+	if err != nil {
+		return nil, err
+	}
+
+	return httpServerWrapper, nil
+}
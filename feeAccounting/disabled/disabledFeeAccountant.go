@@ -0,0 +1,38 @@
+package disabled
+
+import (
+	"io"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/feeAccounting"
+)
+
+type disabledFeeAccountant struct {
+}
+
+// NewDisabledFeeAccountant will return a disabled fee accountant instance
+func NewDisabledFeeAccountant() *disabledFeeAccountant {
+	return &disabledFeeAccountant{}
+}
+
+// RecordTransaction does nothing
+func (disabled *disabledFeeAccountant) RecordTransaction(_ uint64, _ string, _ core.RelayerTxAction, _ string) {
+}
+
+// GetFeeAccountingReport returns an empty report
+func (disabled *disabledFeeAccountant) GetFeeAccountingReport() *core.FeeAccountingReport {
+	return &core.FeeAccountingReport{
+		Daily:        make([]*core.DailyFees, 0),
+		Transactions: make([]*core.RelayerTransaction, 0),
+	}
+}
+
+// WriteDailyFeesCSV writes only the CSV header line
+func (disabled *disabledFeeAccountant) WriteDailyFeesCSV(writer io.Writer) error {
+	return feeAccounting.WriteDailyFeesCSV(writer, nil)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledFeeAccountant) IsInterfaceNil() bool {
+	return disabled == nil
+}
@@ -0,0 +1,35 @@
+package disabled
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledFeeAccountant_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledFeeAccountant()
+	assert.False(t, check.IfNil(disabled))
+	disabled.RecordTransaction(1, "direction", core.SignAction, "hash")
+
+	report := disabled.GetFeeAccountingReport()
+	assert.Empty(t, report.Daily)
+	assert.Empty(t, report.Transactions)
+
+	buff := bytes.NewBuffer(nil)
+	err := disabled.WriteDailyFeesCSV(buff)
+	assert.Nil(t, err)
+	assert.Equal(t, "day,chain,action,numTransactions,gasUsed,fee\n", buff.String())
+}
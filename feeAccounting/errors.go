@@ -0,0 +1,15 @@
+package feeAccounting
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStorer signals that a nil storer was provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrNilTransactionCostResolver signals that a nil transaction cost resolver was provided
+var ErrNilTransactionCostResolver = errors.New("nil transaction cost resolver")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
@@ -0,0 +1,421 @@
+package feeAccounting
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	feeAccountingStorerKey = "fee accounting"
+	multiversXChainName    = "MultiversX"
+	dayLayout              = "2006-01-02"
+	minTrackedTransactions = 1
+	minResolveAttempts     = 1
+	minRetentionInDays     = 1
+)
+
+var dailyFeesCSVHeader = []string{"day", "chain", "action", "numTransactions", "gasUsed", "fee"}
+
+// ArgsFeeAccountant is the DTO used in the fee accountant constructor
+type ArgsFeeAccountant struct {
+	Log                    logger.Logger
+	Storer                 core.Storer
+	EvmCompatibleChain     chain.Chain
+	EthereumResolver       core.TransactionCostResolver
+	MultiversXResolver     core.TransactionCostResolver
+	MaxTrackedTransactions int
+	MaxResolveAttempts     int
+	RetentionInDays        int
+}
+
+type persistedData struct {
+	Transactions []*core.RelayerTransaction `json:"transactions"`
+	Daily        []*core.DailyFees          `json:"daily"`
+}
+
+type feeAccountant struct {
+	log                    logger.Logger
+	storer                 core.Storer
+	evmCompatibleChainName string
+	resolvers              map[string]core.TransactionCostResolver
+	maxTrackedTransactions int
+	maxResolveAttempts     int
+	retentionInDays        int
+	getTimeHandler         func() time.Time
+
+	mut          sync.RWMutex
+	transactions []*core.RelayerTransaction
+	dailyFees    map[string]*core.DailyFees
+}
+
+// NewFeeAccountant creates a new fee accountant instance. The accountant records the transactions sent by the relayer,
+// fetches their gas used and fee as soon as they are final and aggregates the costs by day, chain and action
+func NewFeeAccountant(args ArgsFeeAccountant) (*feeAccountant, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	evmCompatibleChainName := string(args.EvmCompatibleChain)
+	accountant := &feeAccountant{
+		log:                    args.Log,
+		storer:                 args.Storer,
+		evmCompatibleChainName: evmCompatibleChainName,
+		resolvers: map[string]core.TransactionCostResolver{
+			evmCompatibleChainName: args.EthereumResolver,
+			multiversXChainName:    args.MultiversXResolver,
+		},
+		maxTrackedTransactions: args.MaxTrackedTransactions,
+		maxResolveAttempts:     args.MaxResolveAttempts,
+		retentionInDays:        args.RetentionInDays,
+		getTimeHandler:         time.Now,
+		transactions:           make([]*core.RelayerTransaction, 0),
+		dailyFees:              make(map[string]*core.DailyFees),
+	}
+	accountant.tryLoadPersistedData()
+
+	return accountant, nil
+}
+
+func checkArgs(args ArgsFeeAccountant) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if len(args.EvmCompatibleChain) == 0 {
+		return fmt.Errorf("%w for EvmCompatibleChain: empty value", ErrInvalidValue)
+	}
+	if check.IfNil(args.EthereumResolver) {
+		return fmt.Errorf("%w for the %s chain", ErrNilTransactionCostResolver, args.EvmCompatibleChain)
+	}
+	if check.IfNil(args.MultiversXResolver) {
+		return fmt.Errorf("%w for the %s chain", ErrNilTransactionCostResolver, multiversXChainName)
+	}
+	if args.MaxTrackedTransactions < minTrackedTransactions {
+		return fmt.Errorf("%w for MaxTrackedTransactions, minimum %d, got %d", ErrInvalidValue, minTrackedTransactions, args.MaxTrackedTransactions)
+	}
+	if args.MaxResolveAttempts < minResolveAttempts {
+		return fmt.Errorf("%w for MaxResolveAttempts, minimum %d, got %d", ErrInvalidValue, minResolveAttempts, args.MaxResolveAttempts)
+	}
+	if args.RetentionInDays < minRetentionInDays {
+		return fmt.Errorf("%w for RetentionInDays, minimum %d, got %d", ErrInvalidValue, minRetentionInDays, args.RetentionInDays)
+	}
+
+	return nil
+}
+
+// RecordTransaction records a transaction sent by the relayer. Its cost will be fetched by the next Execute calls
+func (accountant *feeAccountant) RecordTransaction(batchID uint64, direction string, action core.RelayerTxAction, txHash string) {
+	if len(txHash) == 0 {
+		return
+	}
+
+	accountant.mut.Lock()
+	defer accountant.mut.Unlock()
+
+	for _, tx := range accountant.transactions {
+		if tx.TxHash == txHash {
+			return
+		}
+	}
+
+	tx := &core.RelayerTransaction{
+		TxHash:    txHash,
+		Chain:     accountant.chainOfAction(action),
+		BatchID:   batchID,
+		Direction: direction,
+		Action:    action,
+		Timestamp: accountant.getTimeHandler().Unix(),
+		Status:    core.RelayerTxPending,
+	}
+	accountant.transactions = append(accountant.transactions, tx)
+	accountant.pruneTransactions()
+	accountant.persistData()
+
+	accountant.log.Debug("feeAccountant: recorded transaction", "chain", tx.Chain, "action", action,
+		"batch ID", batchID, "direction", direction, "hash", txHash)
+}
+
+func (accountant *feeAccountant) chainOfAction(action core.RelayerTxAction) string {
+	if action == core.ExecuteTransferAction {
+		return accountant.evmCompatibleChainName
+	}
+
+	return multiversXChainName
+}
+
+// Execute fetches the cost of the pending transactions and aggregates the resolved ones
+func (accountant *feeAccountant) Execute(ctx context.Context) error {
+	pending := accountant.getPendingTransactions()
+	for _, tx := range pending {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		gasUsed, fee, err := accountant.resolvers[tx.Chain].GetTransactionCost(ctx, tx.TxHash)
+		accountant.applyResult(tx, gasUsed, fee, err)
+	}
+
+	accountant.mut.Lock()
+	accountant.pruneDailyFees()
+	accountant.persistData()
+	accountant.mut.Unlock()
+
+	return nil
+}
+
+func (accountant *feeAccountant) getPendingTransactions() []core.RelayerTransaction {
+	accountant.mut.RLock()
+	defer accountant.mut.RUnlock()
+
+	pending := make([]core.RelayerTransaction, 0)
+	for _, tx := range accountant.transactions {
+		if tx.Status == core.RelayerTxPending {
+			pending = append(pending, *tx)
+		}
+	}
+
+	return pending
+}
+
+func (accountant *feeAccountant) applyResult(resolved core.RelayerTransaction, gasUsed uint64, fee *big.Int, err error) {
+	accountant.mut.Lock()
+	defer accountant.mut.Unlock()
+
+	tx := accountant.getTransaction(resolved.TxHash)
+	if tx == nil || tx.Status != core.RelayerTxPending {
+		return
+	}
+
+	tx.ResolveAttempts++
+	if err == nil && fee == nil {
+		err = fmt.Errorf("%w: nil fee", ErrInvalidValue)
+	}
+	if err != nil {
+		if tx.ResolveAttempts < accountant.maxResolveAttempts {
+			accountant.log.Debug("feeAccountant: can not fetch the transaction cost yet", "chain", tx.Chain,
+				"hash", tx.TxHash, "attempts", tx.ResolveAttempts, "error", err)
+			return
+		}
+
+		tx.Status = core.RelayerTxUnresolved
+		accountant.log.Warn("feeAccountant: giving up fetching the transaction cost", "chain", tx.Chain,
+			"action", tx.Action, "batch ID", tx.BatchID, "hash", tx.TxHash, "attempts", tx.ResolveAttempts, "error", err)
+		return
+	}
+
+	tx.Status = core.RelayerTxResolved
+	tx.GasUsed = gasUsed
+	tx.Fee = fee.String()
+	accountant.addToDailyFees(tx, fee)
+
+	accountant.log.Debug("feeAccountant: fetched transaction cost", "chain", tx.Chain, "action", tx.Action,
+		"batch ID", tx.BatchID, "hash", tx.TxHash, "gas used", gasUsed, "fee", tx.Fee)
+}
+
+func (accountant *feeAccountant) getTransaction(txHash string) *core.RelayerTransaction {
+	for _, tx := range accountant.transactions {
+		if tx.TxHash == txHash {
+			return tx
+		}
+	}
+
+	return nil
+}
+
+func (accountant *feeAccountant) addToDailyFees(tx *core.RelayerTransaction, fee *big.Int) {
+	day := time.Unix(tx.Timestamp, 0).UTC().Format(dayLayout)
+	key := fmt.Sprintf("%s|%s|%s", day, tx.Chain, tx.Action)
+	entry, found := accountant.dailyFees[key]
+	if !found {
+		entry = &core.DailyFees{
+			Day:    day,
+			Chain:  tx.Chain,
+			Action: tx.Action,
+			Fee:    "0",
+		}
+		accountant.dailyFees[key] = entry
+	}
+
+	totalFee, ok := big.NewInt(0).SetString(entry.Fee, 10)
+	if !ok {
+		totalFee = big.NewInt(0)
+	}
+	entry.NumTransactions++
+	entry.GasUsed += tx.GasUsed
+	entry.Fee = totalFee.Add(totalFee, fee).String()
+}
+
+// pruneTransactions keeps at most maxTrackedTransactions, dropping the oldest finalized transactions first
+func (accountant *feeAccountant) pruneTransactions() {
+	numToRemove := len(accountant.transactions) - accountant.maxTrackedTransactions
+	if numToRemove <= 0 {
+		return
+	}
+
+	kept := make([]*core.RelayerTransaction, 0, accountant.maxTrackedTransactions)
+	for _, tx := range accountant.transactions {
+		if numToRemove > 0 && tx.Status != core.RelayerTxPending {
+			numToRemove--
+			continue
+		}
+		kept = append(kept, tx)
+	}
+	if numToRemove > 0 {
+		accountant.log.Warn("feeAccountant: dropping pending transactions, too many transactions to track",
+			"num dropped", numToRemove)
+		kept = kept[numToRemove:]
+	}
+
+	accountant.transactions = kept
+}
+
+func (accountant *feeAccountant) pruneDailyFees() {
+	oldestDay := accountant.getTimeHandler().UTC().AddDate(0, 0, -accountant.retentionInDays).Format(dayLayout)
+	for key, entry := range accountant.dailyFees {
+		if entry.Day < oldestDay {
+			delete(accountant.dailyFees, key)
+		}
+	}
+}
+
+func (accountant *feeAccountant) persistData() {
+	data := &persistedData{
+		Transactions: accountant.transactions,
+		Daily:        accountant.getSortedDailyFees(),
+	}
+	buff, err := json.Marshal(data)
+	if err != nil {
+		accountant.log.Error("feeAccountant.persistData marshal", "error", err)
+		return
+	}
+
+	err = accountant.storer.Put([]byte(feeAccountingStorerKey), buff)
+	if err != nil {
+		accountant.log.Error("feeAccountant.persistData writing to storer", "error", err)
+	}
+}
+
+func (accountant *feeAccountant) tryLoadPersistedData() {
+	buff, err := accountant.storer.Get([]byte(feeAccountingStorerKey))
+	if err != nil {
+		accountant.log.Debug("feeAccountant.tryLoadPersistedData reading from storer", "error", err)
+		return
+	}
+
+	data := &persistedData{}
+	err = json.Unmarshal(buff, data)
+	if err != nil {
+		accountant.log.Warn("feeAccountant.tryLoadPersistedData unmarshal", "error", err)
+		return
+	}
+
+	for _, tx := range data.Transactions {
+		if tx != nil {
+			accountant.transactions = append(accountant.transactions, tx)
+		}
+	}
+	for _, entry := range data.Daily {
+		if entry != nil {
+			accountant.dailyFees[fmt.Sprintf("%s|%s|%s", entry.Day, entry.Chain, entry.Action)] = entry
+		}
+	}
+	accountant.pruneTransactions()
+
+	accountant.log.Debug("feeAccountant.tryLoadPersistedData loaded data",
+		"num transactions", len(accountant.transactions), "num daily entries", len(accountant.dailyFees))
+}
+
+// getSortedDailyFees returns copies of the daily entries, sorted by day, chain and action
+func (accountant *feeAccountant) getSortedDailyFees() []*core.DailyFees {
+	result := make([]*core.DailyFees, 0, len(accountant.dailyFees))
+	for _, entry := range accountant.dailyFees {
+		entryCopy := *entry
+		result = append(result, &entryCopy)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Day != result[j].Day {
+			return result[i].Day < result[j].Day
+		}
+		if result[i].Chain != result[j].Chain {
+			return result[i].Chain < result[j].Chain
+		}
+		return result[i].Action < result[j].Action
+	})
+
+	return result
+}
+
+// GetFeeAccountingReport returns the fees aggregated by day, chain and action, together with the tracked transactions
+func (accountant *feeAccountant) GetFeeAccountingReport() *core.FeeAccountingReport {
+	accountant.mut.RLock()
+	defer accountant.mut.RUnlock()
+
+	transactions := make([]*core.RelayerTransaction, 0, len(accountant.transactions))
+	for _, tx := range accountant.transactions {
+		txCopy := *tx
+		transactions = append(transactions, &txCopy)
+	}
+
+	return &core.FeeAccountingReport{
+		Daily:        accountant.getSortedDailyFees(),
+		Transactions: transactions,
+	}
+}
+
+// WriteDailyFeesCSV writes the fees aggregated by day, chain and action in the CSV format
+func (accountant *feeAccountant) WriteDailyFeesCSV(writer io.Writer) error {
+	accountant.mut.RLock()
+	daily := accountant.getSortedDailyFees()
+	accountant.mut.RUnlock()
+
+	return WriteDailyFeesCSV(writer, daily)
+}
+
+// WriteDailyFeesCSV writes the provided daily fees in the CSV format, preceded by the header line
+func WriteDailyFeesCSV(writer io.Writer, daily []*core.DailyFees) error {
+	csvWriter := csv.NewWriter(writer)
+	err := csvWriter.Write(dailyFeesCSVHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range daily {
+		err = csvWriter.Write([]string{
+			entry.Day,
+			entry.Chain,
+			string(entry.Action),
+			strconv.FormatUint(entry.NumTransactions, 10),
+			strconv.FormatUint(entry.GasUsed, 10),
+			entry.Fee,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (accountant *feeAccountant) IsInterfaceNil() bool {
+	return accountant == nil
+}
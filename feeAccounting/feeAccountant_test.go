@@ -0,0 +1,387 @@
+package feeAccounting
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/clients"
+	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const direction = "ToMultiversX"
+
+func createMockArgsFeeAccountant() ArgsFeeAccountant {
+	return ArgsFeeAccountant{
+		Log:                    &testsCommon.LoggerStub{},
+		Storer:                 testsCommon.NewStorerMock(),
+		EvmCompatibleChain:     chain.Ethereum,
+		EthereumResolver:       &testsCommon.TransactionCostResolverStub{},
+		MultiversXResolver:     &testsCommon.TransactionCostResolverStub{},
+		MaxTrackedTransactions: 100,
+		MaxResolveAttempts:     3,
+		RetentionInDays:        30,
+	}
+}
+
+func createTimeHandler(timestamp *int64) func() time.Time {
+	return func() time.Time {
+		return time.Unix(*timestamp, 0)
+	}
+}
+
+func TestNewFeeAccountant(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.Log = nil
+
+		accountant, err := NewFeeAccountant(args)
+		assert.True(t, check.IfNil(accountant))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.Storer = nil
+
+		accountant, err := NewFeeAccountant(args)
+		assert.True(t, check.IfNil(accountant))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("empty EVM compatible chain should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.EvmCompatibleChain = ""
+
+		accountant, err := NewFeeAccountant(args)
+		assert.True(t, check.IfNil(accountant))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "EvmCompatibleChain")
+	})
+	t.Run("nil Ethereum resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.EthereumResolver = nil
+
+		accountant, err := NewFeeAccountant(args)
+		assert.True(t, check.IfNil(accountant))
+		assert.ErrorIs(t, err, ErrNilTransactionCostResolver)
+		assert.Contains(t, err.Error(), "Ethereum")
+	})
+	t.Run("nil MultiversX resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.MultiversXResolver = nil
+
+		accountant, err := NewFeeAccountant(args)
+		assert.True(t, check.IfNil(accountant))
+		assert.ErrorIs(t, err, ErrNilTransactionCostResolver)
+		assert.Contains(t, err.Error(), "MultiversX")
+	})
+	t.Run("invalid MaxTrackedTransactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.MaxTrackedTransactions = 0
+
+		accountant, err := NewFeeAccountant(args)
+		assert.True(t, check.IfNil(accountant))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "MaxTrackedTransactions")
+	})
+	t.Run("invalid MaxResolveAttempts should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.MaxResolveAttempts = 0
+
+		accountant, err := NewFeeAccountant(args)
+		assert.True(t, check.IfNil(accountant))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "MaxResolveAttempts")
+	})
+	t.Run("invalid RetentionInDays should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.RetentionInDays = 0
+
+		accountant, err := NewFeeAccountant(args)
+		assert.True(t, check.IfNil(accountant))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "RetentionInDays")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		accountant, err := NewFeeAccountant(createMockArgsFeeAccountant())
+		assert.False(t, check.IfNil(accountant))
+		assert.Nil(t, err)
+	})
+}
+
+func TestFeeAccountant_RecordTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty hash should not record", func(t *testing.T) {
+		t.Parallel()
+
+		accountant, _ := NewFeeAccountant(createMockArgsFeeAccountant())
+		accountant.RecordTransaction(1, direction, core.ProposeTransferAction, "")
+
+		assert.Empty(t, accountant.GetFeeAccountingReport().Transactions)
+	})
+	t.Run("should record once and assign the chain from the action", func(t *testing.T) {
+		t.Parallel()
+
+		timestamp := int64(1700000000)
+		accountant, _ := NewFeeAccountant(createMockArgsFeeAccountant())
+		accountant.getTimeHandler = createTimeHandler(&timestamp)
+
+		accountant.RecordTransaction(1, direction, core.ProposeTransferAction, "hash1")
+		accountant.RecordTransaction(1, direction, core.ProposeTransferAction, "hash1")
+		accountant.RecordTransaction(2, "FromMultiversX", core.ExecuteTransferAction, "hash2")
+
+		expected := []*core.RelayerTransaction{
+			{
+				TxHash:    "hash1",
+				Chain:     "MultiversX",
+				BatchID:   1,
+				Direction: direction,
+				Action:    core.ProposeTransferAction,
+				Timestamp: timestamp,
+				Status:    core.RelayerTxPending,
+			},
+			{
+				TxHash:    "hash2",
+				Chain:     "Ethereum",
+				BatchID:   2,
+				Direction: "FromMultiversX",
+				Action:    core.ExecuteTransferAction,
+				Timestamp: timestamp,
+				Status:    core.RelayerTxPending,
+			},
+		}
+		assert.Equal(t, expected, accountant.GetFeeAccountingReport().Transactions)
+	})
+	t.Run("should drop the oldest finalized transactions first", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.MaxTrackedTransactions = 2
+		args.MultiversXResolver = &testsCommon.TransactionCostResolverStub{
+			GetTransactionCostCalled: func(ctx context.Context, txHash string) (uint64, *big.Int, error) {
+				if txHash == "hash2" {
+					return 10, big.NewInt(100), nil
+				}
+				return 0, nil, clients.ErrTransactionNotFinal
+			},
+		}
+		accountant, _ := NewFeeAccountant(args)
+
+		accountant.RecordTransaction(1, direction, core.SignAction, "hash1")
+		accountant.RecordTransaction(1, direction, core.PerformAction, "hash2")
+		_ = accountant.Execute(context.Background())
+
+		accountant.RecordTransaction(2, direction, core.SignAction, "hash3")
+		transactions := accountant.GetFeeAccountingReport().Transactions
+		require.Equal(t, 2, len(transactions))
+		assert.Equal(t, "hash1", transactions[0].TxHash)
+		assert.Equal(t, "hash3", transactions[1].TxHash)
+
+		accountant.RecordTransaction(3, direction, core.SignAction, "hash4")
+		transactions = accountant.GetFeeAccountingReport().Transactions
+		require.Equal(t, 2, len(transactions))
+		assert.Equal(t, "hash3", transactions[0].TxHash)
+		assert.Equal(t, "hash4", transactions[1].TxHash)
+	})
+}
+
+func TestFeeAccountant_Execute(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should resolve and aggregate by day, chain and action", func(t *testing.T) {
+		t.Parallel()
+
+		costs := map[string]int64{
+			"hash1": 100,
+			"hash2": 200,
+			"hash3": 300,
+			"hash4": 400,
+		}
+		resolver := &testsCommon.TransactionCostResolverStub{
+			GetTransactionCostCalled: func(ctx context.Context, txHash string) (uint64, *big.Int, error) {
+				return uint64(costs[txHash] / 10), big.NewInt(costs[txHash]), nil
+			},
+		}
+		args := createMockArgsFeeAccountant()
+		args.EthereumResolver = resolver
+		args.MultiversXResolver = resolver
+		accountant, _ := NewFeeAccountant(args)
+		timestamp := int64(1700000000) // 2023-11-14 22:13:20 UTC
+		accountant.getTimeHandler = createTimeHandler(&timestamp)
+
+		accountant.RecordTransaction(1, direction, core.SignAction, "hash1")
+		accountant.RecordTransaction(2, direction, core.SignAction, "hash2")
+		accountant.RecordTransaction(2, "FromMultiversX", core.ExecuteTransferAction, "hash3")
+		timestamp += 3600 * 2
+		accountant.RecordTransaction(3, direction, core.SignAction, "hash4")
+
+		err := accountant.Execute(context.Background())
+		assert.Nil(t, err)
+
+		expectedDaily := []*core.DailyFees{
+			{
+				Day:             "2023-11-14",
+				Chain:           "Ethereum",
+				Action:          core.ExecuteTransferAction,
+				NumTransactions: 1,
+				GasUsed:         30,
+				Fee:             "300",
+			},
+			{
+				Day:             "2023-11-14",
+				Chain:           "MultiversX",
+				Action:          core.SignAction,
+				NumTransactions: 2,
+				GasUsed:         30,
+				Fee:             "300",
+			},
+			{
+				Day:             "2023-11-15",
+				Chain:           "MultiversX",
+				Action:          core.SignAction,
+				NumTransactions: 1,
+				GasUsed:         40,
+				Fee:             "400",
+			},
+		}
+		report := accountant.GetFeeAccountingReport()
+		assert.Equal(t, expectedDaily, report.Daily)
+		for _, tx := range report.Transactions {
+			assert.Equal(t, core.RelayerTxResolved, tx.Status)
+			assert.Equal(t, 1, tx.ResolveAttempts)
+		}
+
+		buff := bytes.NewBuffer(nil)
+		err = accountant.WriteDailyFeesCSV(buff)
+		assert.Nil(t, err)
+		expectedCSV := "day,chain,action,numTransactions,gasUsed,fee\n" +
+			"2023-11-14,Ethereum,ExecuteTransfer,1,30,300\n" +
+			"2023-11-14,MultiversX,Sign,2,30,300\n" +
+			"2023-11-15,MultiversX,Sign,1,40,400\n"
+		assert.Equal(t, expectedCSV, buff.String())
+
+		// resolved transactions are not queried again
+		args.MultiversXResolver.(*testsCommon.TransactionCostResolverStub).GetTransactionCostCalled = func(ctx context.Context, txHash string) (uint64, *big.Int, error) {
+			assert.Fail(t, "should have not been called")
+			return 0, nil, nil
+		}
+		err = accountant.Execute(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, expectedDaily, accountant.GetFeeAccountingReport().Daily)
+	})
+	t.Run("should give up after the maximum number of attempts", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		args := createMockArgsFeeAccountant()
+		args.EthereumResolver = &testsCommon.TransactionCostResolverStub{
+			GetTransactionCostCalled: func(ctx context.Context, txHash string) (uint64, *big.Int, error) {
+				numCalls++
+				return 0, nil, errors.New("receipt not available")
+			},
+		}
+		accountant, _ := NewFeeAccountant(args)
+		accountant.RecordTransaction(1, "FromMultiversX", core.ExecuteTransferAction, "hash")
+
+		for i := 0; i < args.MaxResolveAttempts+2; i++ {
+			err := accountant.Execute(context.Background())
+			assert.Nil(t, err)
+		}
+
+		report := accountant.GetFeeAccountingReport()
+		assert.Equal(t, args.MaxResolveAttempts, numCalls)
+		assert.Equal(t, core.RelayerTxUnresolved, report.Transactions[0].Status)
+		assert.Equal(t, args.MaxResolveAttempts, report.Transactions[0].ResolveAttempts)
+		assert.Empty(t, report.Daily)
+	})
+	t.Run("closed context should return error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.MultiversXResolver = &testsCommon.TransactionCostResolverStub{
+			GetTransactionCostCalled: func(ctx context.Context, txHash string) (uint64, *big.Int, error) {
+				assert.Fail(t, "should have not been called")
+				return 0, nil, nil
+			},
+		}
+		accountant, _ := NewFeeAccountant(args)
+		accountant.RecordTransaction(1, direction, core.SignAction, "hash")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		err := accountant.Execute(ctx)
+		assert.Equal(t, context.Canceled, err)
+	})
+	t.Run("should prune the daily entries older than the retention period", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFeeAccountant()
+		args.RetentionInDays = 1
+		accountant, _ := NewFeeAccountant(args)
+		timestamp := int64(1700000000)
+		accountant.getTimeHandler = createTimeHandler(&timestamp)
+
+		accountant.RecordTransaction(1, direction, core.SignAction, "hash")
+		_ = accountant.Execute(context.Background())
+		assert.Equal(t, 1, len(accountant.GetFeeAccountingReport().Daily))
+
+		timestamp += 3600 * 24
+		_ = accountant.Execute(context.Background())
+		assert.Equal(t, 1, len(accountant.GetFeeAccountingReport().Daily))
+
+		timestamp += 3600 * 24
+		_ = accountant.Execute(context.Background())
+		assert.Empty(t, accountant.GetFeeAccountingReport().Daily)
+	})
+}
+
+func TestFeeAccountant_PersistedData(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsFeeAccountant()
+	args.MultiversXResolver = &testsCommon.TransactionCostResolverStub{
+		GetTransactionCostCalled: func(ctx context.Context, txHash string) (uint64, *big.Int, error) {
+			if txHash == "hash1" {
+				return 10, big.NewInt(100), nil
+			}
+			return 0, nil, clients.ErrTransactionNotFinal
+		},
+	}
+	accountant, _ := NewFeeAccountant(args)
+	accountant.RecordTransaction(1, direction, core.ProposeTransferAction, "hash1")
+	accountant.RecordTransaction(1, direction, core.SignAction, "hash2")
+	_ = accountant.Execute(context.Background())
+	expectedReport := accountant.GetFeeAccountingReport()
+
+	reloaded, _ := NewFeeAccountant(args)
+	assert.Equal(t, expectedReport, reloaded.GetFeeAccountingReport())
+	assert.Equal(t, 1, len(reloaded.getPendingTransactions()))
+}
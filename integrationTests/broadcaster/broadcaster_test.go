@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
 	p2pMocks "github.com/multiversx/mx-bridge-eth-go/testsCommon/p2p"
 	mockRoleProviders "github.com/multiversx/mx-bridge-eth-go/testsCommon/roleProviders"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	chainConfig "github.com/multiversx/mx-chain-go/config"
 	chainP2P "github.com/multiversx/mx-chain-go/p2p"
@@ -45,6 +46,9 @@ func TestNetworkOfBroadcastersShouldPassTheSignatures(t *testing.T) {
 
 			return false
 		},
+		SortedPublicKeysCalled: func() [][]byte {
+			return copyAndSortBytesSlices(publicKeysBytes)
+		},
 	}
 
 	integrationTests.Log.Info("creating broadcasters...")
@@ -212,6 +216,8 @@ func createBroadcaster(
 		SignatureProcessor:     &testsCommon.SignatureProcessorStub{},
 		Name:                   "test",
 		AntifloodComponents:    ac,
+		Marshalizer:            &marshal.GogoProtoMarshalizer{},
+		MisbehaviourDetector:   &p2pMocks.MisbehaviourDetectorStub{},
 	}
 
 	b, err := p2p.NewBroadcaster(args)
@@ -278,7 +284,7 @@ func createSignatures(numSignatures int, suffix string) [][]byte {
 func sendSignatures(broadcasters []integrationTests.Broadcaster, signatures [][]byte, messageHash []byte) {
 	integrationTests.Log.Info("sending signatures...")
 	for i, b := range broadcasters {
-		b.BroadcastSignature(signatures[i], messageHash)
+		b.BroadcastSignature(signatures[i], messageHash, 1)
 	}
 
 	time.Sleep(time.Second)
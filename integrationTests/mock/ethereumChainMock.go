@@ -46,6 +46,8 @@ type EthereumChainMock struct {
 	ProposeMultiTransferEsdtBatchCalled func()
 	BalanceAtCalled                     func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 	FilterLogsCalled                    func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	TransactionReceiptCalled            func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	HeaderByNumberCalled                func(ctx context.Context, number *big.Int) (*types.Header, error)
 	finalNonce                          uint64
 }
 
@@ -239,6 +241,11 @@ func (mock *EthereumChainMock) ExecuteTransfer(_ *bind.TransactOpts, tokens []co
 	return tx, nil
 }
 
+// SimulateExecuteTransfer -
+func (mock *EthereumChainMock) SimulateExecuteTransfer(_ context.Context, _ common.Address, _ []common.Address, _ []common.Address, _ []*big.Int, _ []*big.Int, _ *big.Int, _ [][]byte) error {
+	return nil
+}
+
 // Quorum -
 func (mock *EthereumChainMock) Quorum(_ context.Context) (*big.Int, error) {
 	mock.mutState.RLock()
@@ -287,6 +294,24 @@ func (mock *EthereumChainMock) FilterLogs(ctx context.Context, q ethereum.Filter
 	return []types.Log{}, nil
 }
 
+// TransactionReceipt -
+func (mock *EthereumChainMock) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if mock.TransactionReceiptCalled != nil {
+		return mock.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return nil, ethereum.NotFound
+}
+
+// HeaderByNumber -
+func (mock *EthereumChainMock) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if mock.HeaderByNumberCalled != nil {
+		return mock.HeaderByNumberCalled(ctx, number)
+	}
+
+	return nil, ethereum.NotFound
+}
+
 // IsPaused -
 func (mock *EthereumChainMock) IsPaused(_ context.Context) (bool, error) {
 	return false, nil
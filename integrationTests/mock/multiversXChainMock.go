@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"net/http"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -136,6 +137,11 @@ func (mock *MultiversXChainMock) ProcessTransactionStatus(_ context.Context, _ s
 	return "", nil
 }
 
+// GetHTTP -
+func (mock *MultiversXChainMock) GetHTTP(_ context.Context, _ string) ([]byte, int, error) {
+	return nil, http.StatusNotFound, nil
+}
+
 // AddRelayer -
 func (mock *MultiversXChainMock) AddRelayer(address sdkCore.AddressHandler) {
 	mock.mutState.Lock()
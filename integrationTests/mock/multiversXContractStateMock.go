@@ -242,6 +242,8 @@ func (mock *multiversXContractStateMock) processVmRequests(vmRequest *data.VmVal
 		return mock.vmRequestWasActionExecuted(vmRequest), nil
 	case "quorumReached":
 		return mock.vmRequestQuorumReached(vmRequest), nil
+	case "getActionSignerCount":
+		return mock.vmRequestGetActionSignerCount(vmRequest), nil
 	case "getTokenIdForErc20Address":
 		return mock.vmRequestGetTokenIdForErc20Address(vmRequest), nil
 	case "getErc20AddressForTokenId":
@@ -258,6 +260,8 @@ func (mock *multiversXContractStateMock) processVmRequests(vmRequest *data.VmVal
 		return mock.vmRequestGetLastExecutedEthTxId(vmRequest), nil
 	case "signed":
 		return mock.vmRequestSigned(vmRequest), nil
+	case "getQuorum":
+		return mock.vmRequestGetQuorum(vmRequest), nil
 	case "isPaused":
 		return mock.vmRequestIsPaused(vmRequest), nil
 	case "isMintBurnToken":
@@ -403,6 +407,13 @@ func (mock *multiversXContractStateMock) vmRequestQuorumReached(vmRequest *data.
 	return createOkVmResponse([][]byte{BoolToByteSlice(quorumReached)})
 }
 
+func (mock *multiversXContractStateMock) vmRequestGetActionSignerCount(vmRequest *data.VmValueRequest) *data.VmValuesResponseData {
+	actionID := getBigIntFromString(vmRequest.Args[0])
+	m := mock.signedActionIDs[actionID.String()]
+
+	return createOkVmResponse([][]byte{big.NewInt(int64(len(m))).Bytes()})
+}
+
 func (mock *multiversXContractStateMock) vmRequestGetTokenIdForErc20Address(vmRequest *data.VmValueRequest) *data.VmValuesResponseData {
 	address := common.HexToAddress(vmRequest.Args[0])
 
@@ -419,6 +430,12 @@ func (mock *multiversXContractStateMock) vmRequestGetAllStakedRelayers(_ *data.V
 	return createOkVmResponse(mock.relayers)
 }
 
+func (mock *multiversXContractStateMock) vmRequestGetQuorum(_ *data.VmValueRequest) *data.VmValuesResponseData {
+	val := big.NewInt(int64(mock.quorum))
+
+	return createOkVmResponse([][]byte{val.Bytes()})
+}
+
 func (mock *multiversXContractStateMock) vmRequestGetLastExecutedEthBatchId(_ *data.VmValueRequest) *data.VmValuesResponseData {
 	val := big.NewInt(int64(mock.lastExecutedEthBatchId))
 
@@ -119,6 +119,10 @@ func CreateBridgeComponentsConfig(index int, workingDir string, gasStationURL st
 			RoleProvider: config.RoleProviderConfig{
 				PollingIntervalInMillis: 1000,
 			},
+			SignaturesHolder: config.SignaturesHolderConfig{
+				ExpiryInSeconds:           3600,
+				MaxMessageHashesPerSigner: 100,
+			},
 		},
 	}
 }
@@ -127,10 +127,11 @@ func (handler *EthereumHandler) DeployContracts(ctx context.Context) {
 	handler.checkEthTxResult(ctx, tx.Hash())
 
 	handler.EthChainWrapper, err = wrappers.NewEthereumChainWrapper(wrappers.ArgsEthereumChainWrapper{
-		StatusHandler:    &testsCommon.StatusHandlerStub{},
-		MultiSigContract: handler.BridgeContract,
-		SafeContract:     handler.SafeContract,
-		BlockchainClient: handler.SimulatedChainWrapper,
+		StatusHandler:           &testsCommon.StatusHandlerStub{},
+		MultiSigContract:        handler.BridgeContract,
+		MultiSigContractAddress: handler.BridgeAddress,
+		SafeContract:            handler.SafeContract,
+		BlockchainClient:        handler.SimulatedChainWrapper,
 	})
 	require.NoError(handler, err)
 
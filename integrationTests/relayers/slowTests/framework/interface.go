@@ -52,6 +52,9 @@ type EthereumBlockchainClient interface {
 	ChainID(ctx context.Context) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
 	FilterLogs(ctx context.Context, q goEthereum.FilterQuery) ([]types.Log, error)
+	PendingCallContract(ctx context.Context, call goEthereum.CallMsg) ([]byte, error)
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
 }
 
 // ERC20Contract defines the operations of an ERC20 contract
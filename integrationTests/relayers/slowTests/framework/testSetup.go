@@ -12,6 +12,7 @@ import (
 
 	"github.com/multiversx/mx-bridge-eth-go/config"
 	"github.com/multiversx/mx-bridge-eth-go/executors/multiversx/module"
+	chainConfig "github.com/multiversx/mx-chain-go/config"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/stretchr/testify/require"
 )
@@ -124,6 +125,8 @@ func (setup *TestSetup) startScCallerModule() {
 		IntervalToResendTxsInSeconds:    1,
 		PrivateKeyFile:                  path.Join(setup.WorkingDir, SCCallerFilename),
 		PollingIntervalInMillis:         1000, // 1 second
+		NumWorkers:                      4,
+		MaxTransactionsInFlight:         20,
 		Filter: config.PendingOperationsFilterConfig{
 			AllowedEthAddresses: []string{"*"},
 			AllowedMvxAddresses: []string{"*"},
@@ -135,6 +138,24 @@ func (setup *TestSetup) startScCallerModule() {
 			ExecutionTimeoutInSeconds:  2,
 			TimeInSecondsBetweenChecks: 1,
 		},
+		ExecutionJournal: config.ExecutionJournalConfig{
+			Storage: chainConfig.StorageConfig{
+				Cache: chainConfig.CacheConfig{
+					Name:     "ExecutionJournal",
+					Type:     "LRU",
+					Capacity: 100,
+				},
+				DB: chainConfig.DBConfig{
+					FilePath: "ExecutionJournalDB",
+					Type:     "MemoryDB",
+				},
+			},
+			MaxFinalizedEntries:     1000,
+			MaxUnfinalizedEntries:   1000,
+			MaxAttempts:             3,
+			InitialBackoffInSeconds: 1,
+			MaxBackoffInSeconds:     10,
+		},
 	}
 
 	var err error
@@ -0,0 +1,29 @@
+package disabled
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+type disabledLatencyTracker struct {
+}
+
+// NewDisabledLatencyTracker will return a disabled latency tracker instance
+func NewDisabledLatencyTracker() *disabledLatencyTracker {
+	return &disabledLatencyTracker{}
+}
+
+// RecordBatchCreation does nothing
+func (disabled *disabledLatencyTracker) RecordBatchCreation(_ string, _ uint64, _ uint64) {
+}
+
+// RecordStep does nothing
+func (disabled *disabledLatencyTracker) RecordStep(_ string, _ uint64, _ core.TransferStep) {
+}
+
+// GetBatchTimelines returns an empty list
+func (disabled *disabledLatencyTracker) GetBatchTimelines() []*core.BatchTimeline {
+	return make([]*core.BatchTimeline, 0)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (disabled *disabledLatencyTracker) IsInterfaceNil() bool {
+	return disabled == nil
+}
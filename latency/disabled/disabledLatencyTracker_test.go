@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisabledLatencyTracker_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	disabled := NewDisabledLatencyTracker()
+	assert.False(t, check.IfNil(disabled))
+	disabled.RecordBatchCreation("direction", 1, 2)
+	disabled.RecordStep("direction", 1, core.ProposedStep)
+	assert.Empty(t, disabled.GetBatchTimelines())
+}
@@ -0,0 +1,15 @@
+package latency
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilStatusHandler signals that a nil status handler was provided
+var ErrNilStatusHandler = errors.New("nil status handler")
+
+// ErrNilBlockTimestampResolver signals that a nil block timestamp resolver was provided
+var ErrNilBlockTimestampResolver = errors.New("nil block timestamp resolver")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
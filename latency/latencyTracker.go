@@ -0,0 +1,337 @@
+package latency
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/core/batchProcessor"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const minTrackedBatches = 1
+
+// ArgsLatencyTracker is the DTO used in the latency tracker constructor
+type ArgsLatencyTracker struct {
+	Log                       logger.Logger
+	StatusHandler             core.StatusHandler
+	EthereumResolver          core.BlockTimestampResolver
+	MultiversXResolver        core.BlockTimestampResolver
+	MaxTrackedBatches         int
+	HistogramBucketsInSeconds []uint64
+}
+
+type trackedBatch struct {
+	timeline         core.BatchTimeline
+	creationResolved bool
+	latencyObserved  bool
+	publishedSteps   map[core.TransferStep]struct{}
+}
+
+type latencyTracker struct {
+	log               logger.Logger
+	statusHandler     core.StatusHandler
+	resolvers         map[string]core.BlockTimestampResolver
+	stepsOrder        map[string][]core.TransferStep
+	maxTrackedBatches int
+	buckets           []uint64
+	getTimeHandler    func() time.Time
+
+	mut     sync.RWMutex
+	batches []*trackedBatch
+}
+
+// NewLatencyTracker creates a new latency tracker instance. The tracker records the moments each batch went through
+// the transfer steps, resolves the batch creation time from the source chain block and publishes, for each direction,
+// the transfer latency histogram and the duration of each step
+func NewLatencyTracker(args ArgsLatencyTracker) (*latencyTracker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := &latencyTracker{
+		log:           args.Log,
+		statusHandler: args.StatusHandler,
+		resolvers: map[string]core.BlockTimestampResolver{
+			string(batchProcessor.ToMultiversX):   args.EthereumResolver,
+			string(batchProcessor.FromMultiversX): args.MultiversXResolver,
+		},
+		stepsOrder: map[string][]core.TransferStep{
+			string(batchProcessor.ToMultiversX): {
+				core.BatchCreatedStep, core.ProposedStep, core.QuorumReachedStep, core.ExecutedStep,
+			},
+			string(batchProcessor.FromMultiversX): {
+				core.BatchCreatedStep, core.ProposedStep, core.QuorumReachedStep, core.ExecutedStep, core.StatusSetStep,
+			},
+		},
+		maxTrackedBatches: args.MaxTrackedBatches,
+		buckets:           args.HistogramBucketsInSeconds,
+		getTimeHandler:    time.Now,
+		batches:           make([]*trackedBatch, 0),
+	}
+
+	return tracker, nil
+}
+
+func checkArgs(args ArgsLatencyTracker) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if check.IfNil(args.EthereumResolver) {
+		return fmt.Errorf("%w for the %s direction", ErrNilBlockTimestampResolver, batchProcessor.ToMultiversX)
+	}
+	if check.IfNil(args.MultiversXResolver) {
+		return fmt.Errorf("%w for the %s direction", ErrNilBlockTimestampResolver, batchProcessor.FromMultiversX)
+	}
+	if args.MaxTrackedBatches < minTrackedBatches {
+		return fmt.Errorf("%w for MaxTrackedBatches, minimum %d, got %d", ErrInvalidValue, minTrackedBatches, args.MaxTrackedBatches)
+	}
+	if len(args.HistogramBucketsInSeconds) == 0 {
+		return fmt.Errorf("%w for HistogramBucketsInSeconds: empty list", ErrInvalidValue)
+	}
+	for i, bucket := range args.HistogramBucketsInSeconds {
+		if bucket == 0 {
+			return fmt.Errorf("%w for HistogramBucketsInSeconds: zero bucket at index %d", ErrInvalidValue, i)
+		}
+		if i > 0 && bucket <= args.HistogramBucketsInSeconds[i-1] {
+			return fmt.Errorf("%w for HistogramBucketsInSeconds: the buckets should be strictly increasing, index %d", ErrInvalidValue, i)
+		}
+	}
+
+	return nil
+}
+
+// RecordBatchCreation records the source chain block in which the batch was created. The block timestamp will be
+// fetched by the next Execute calls
+func (tracker *latencyTracker) RecordBatchCreation(direction string, batchID uint64, blockNumber uint64) {
+	if !tracker.isKnownDirection(direction) {
+		tracker.log.Warn("latencyTracker: unknown direction", "direction", direction, "batch ID", batchID)
+		return
+	}
+
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	batch := tracker.getOrCreateBatch(direction, batchID)
+	if batch.timeline.BlockNumber == 0 {
+		batch.timeline.BlockNumber = blockNumber
+	}
+}
+
+// RecordStep records the current time as the moment the batch went through the provided step. Only the first
+// observation of a step is kept
+func (tracker *latencyTracker) RecordStep(direction string, batchID uint64, step core.TransferStep) {
+	if !tracker.isKnownDirection(direction) {
+		tracker.log.Warn("latencyTracker: unknown direction", "direction", direction, "batch ID", batchID)
+		return
+	}
+
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	batch := tracker.getOrCreateBatch(direction, batchID)
+	timestamp := getStepTimestamp(&batch.timeline, step)
+	if timestamp == nil {
+		tracker.log.Warn("latencyTracker: unknown step", "step", step, "direction", direction, "batch ID", batchID)
+		return
+	}
+	if *timestamp != 0 {
+		return
+	}
+
+	*timestamp = tracker.getTimeHandler().Unix()
+	tracker.log.Debug("latencyTracker: recorded step", "direction", direction, "batch ID", batchID, "step", step)
+	tracker.publishMetrics(batch)
+}
+
+// Execute fetches the creation time of the batches whose source chain block timestamp is not yet known
+func (tracker *latencyTracker) Execute(ctx context.Context) error {
+	unresolved := tracker.getUnresolvedBatches()
+	for _, timeline := range unresolved {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		timestamp, err := tracker.resolvers[timeline.Direction].GetBlockTimestamp(ctx, timeline.BlockNumber)
+		if err != nil {
+			tracker.log.Debug("latencyTracker: can not fetch the batch creation time", "direction", timeline.Direction,
+				"batch ID", timeline.BatchID, "block", timeline.BlockNumber, "error", err)
+			continue
+		}
+
+		tracker.applyCreationTimestamp(timeline, timestamp)
+	}
+
+	return nil
+}
+
+func (tracker *latencyTracker) getUnresolvedBatches() []core.BatchTimeline {
+	tracker.mut.RLock()
+	defer tracker.mut.RUnlock()
+
+	unresolved := make([]core.BatchTimeline, 0)
+	for _, batch := range tracker.batches {
+		if !batch.creationResolved && batch.timeline.BlockNumber > 0 {
+			unresolved = append(unresolved, batch.timeline)
+		}
+	}
+
+	return unresolved
+}
+
+func (tracker *latencyTracker) applyCreationTimestamp(resolved core.BatchTimeline, timestamp uint64) {
+	tracker.mut.Lock()
+	defer tracker.mut.Unlock()
+
+	batch := tracker.getBatch(resolved.Direction, resolved.BatchID)
+	if batch == nil || batch.creationResolved {
+		return
+	}
+
+	batch.creationResolved = true
+	batch.timeline.CreatedAt = int64(timestamp)
+	tracker.publishMetrics(batch)
+}
+
+// publishMetrics publishes the durations of the steps whose previous step timestamp is known and, once, the batch
+// transfer latency. Must be called under mutex protection
+func (tracker *latencyTracker) publishMetrics(batch *trackedBatch) {
+	direction := batch.timeline.Direction
+	steps := tracker.stepsOrder[direction]
+	for i := 1; i < len(steps); i++ {
+		_, published := batch.publishedSteps[steps[i]]
+		if published {
+			continue
+		}
+
+		current := *getStepTimestamp(&batch.timeline, steps[i])
+		previous := *getStepTimestamp(&batch.timeline, steps[i-1])
+		if current == 0 || previous == 0 {
+			continue
+		}
+
+		batch.publishedSteps[steps[i]] = struct{}{}
+		metric := fmt.Sprintf("%s %s %s", direction, steps[i], core.MetricLastStepDuration)
+		tracker.statusHandler.SetIntMetric(metric, int(nonNegative(current-previous)))
+	}
+
+	tracker.observeLatency(batch)
+}
+
+// observeLatency adds the time elapsed between the batch creation and its execution on the destination chain to
+// the direction histogram. Must be called under mutex protection
+func (tracker *latencyTracker) observeLatency(batch *trackedBatch) {
+	timeline := &batch.timeline
+	if batch.latencyObserved || timeline.CreatedAt == 0 || timeline.ExecutedAt == 0 {
+		return
+	}
+
+	batch.latencyObserved = true
+	timeline.LatencyInSeconds = nonNegative(timeline.ExecutedAt - timeline.CreatedAt)
+
+	direction := timeline.Direction
+	for _, bucket := range tracker.buckets {
+		if uint64(timeline.LatencyInSeconds) <= bucket {
+			tracker.statusHandler.AddIntMetric(bucketMetric(direction, bucket), 1)
+		}
+	}
+	tracker.statusHandler.AddIntMetric(fmt.Sprintf("%s %s", direction, core.MetricTransferLatencyCount), 1)
+	tracker.statusHandler.AddIntMetric(fmt.Sprintf("%s %s", direction, core.MetricTransferLatencySum), int(timeline.LatencyInSeconds))
+	tracker.statusHandler.SetIntMetric(fmt.Sprintf("%s %s", direction, core.MetricLastTransferLatency), int(timeline.LatencyInSeconds))
+
+	tracker.log.Info("latencyTracker: transfer executed on the destination chain", "direction", direction,
+		"batch ID", timeline.BatchID, "latency in seconds", timeline.LatencyInSeconds)
+}
+
+func bucketMetric(direction string, bucket uint64) string {
+	return fmt.Sprintf("%s %s le %ds", direction, core.MetricTransferLatencyBucket, bucket)
+}
+
+func nonNegative(value int64) int64 {
+	if value < 0 {
+		return 0
+	}
+
+	return value
+}
+
+func getStepTimestamp(timeline *core.BatchTimeline, step core.TransferStep) *int64 {
+	switch step {
+	case core.BatchCreatedStep:
+		return &timeline.CreatedAt
+	case core.ProposedStep:
+		return &timeline.ProposedAt
+	case core.QuorumReachedStep:
+		return &timeline.QuorumReachedAt
+	case core.ExecutedStep:
+		return &timeline.ExecutedAt
+	case core.StatusSetStep:
+		return &timeline.StatusSetAt
+	default:
+		return nil
+	}
+}
+
+func (tracker *latencyTracker) isKnownDirection(direction string) bool {
+	_, found := tracker.resolvers[direction]
+	return found
+}
+
+// getBatch returns the tracked batch, if existing. Must be called under mutex protection
+func (tracker *latencyTracker) getBatch(direction string, batchID uint64) *trackedBatch {
+	for _, batch := range tracker.batches {
+		if batch.timeline.Direction == direction && batch.timeline.BatchID == batchID {
+			return batch
+		}
+	}
+
+	return nil
+}
+
+// getOrCreateBatch returns the tracked batch, creating it if not existing. Must be called under mutex protection
+func (tracker *latencyTracker) getOrCreateBatch(direction string, batchID uint64) *trackedBatch {
+	batch := tracker.getBatch(direction, batchID)
+	if batch != nil {
+		return batch
+	}
+
+	batch = &trackedBatch{
+		timeline: core.BatchTimeline{
+			Direction: direction,
+			BatchID:   batchID,
+		},
+		publishedSteps: make(map[core.TransferStep]struct{}),
+	}
+	tracker.batches = append(tracker.batches, batch)
+	if len(tracker.batches) > tracker.maxTrackedBatches {
+		tracker.batches = tracker.batches[len(tracker.batches)-tracker.maxTrackedBatches:]
+	}
+
+	return batch
+}
+
+// GetBatchTimelines returns a copy of the timelines of the tracked batches, oldest first
+func (tracker *latencyTracker) GetBatchTimelines() []*core.BatchTimeline {
+	tracker.mut.RLock()
+	defer tracker.mut.RUnlock()
+
+	timelines := make([]*core.BatchTimeline, 0, len(tracker.batches))
+	for _, batch := range tracker.batches {
+		timeline := batch.timeline
+		timelines = append(timelines, &timeline)
+	}
+
+	return timelines
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tracker *latencyTracker) IsInterfaceNil() bool {
+	return tracker == nil
+}
@@ -0,0 +1,383 @@
+package latency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	toMultiversX   = "ToMultiversX"
+	fromMultiversX = "FromMultiversX"
+)
+
+func createMockArgsLatencyTracker() ArgsLatencyTracker {
+	return ArgsLatencyTracker{
+		Log:                       &testsCommon.LoggerStub{},
+		StatusHandler:             testsCommon.NewStatusHandlerMock("mock"),
+		EthereumResolver:          &testsCommon.BlockTimestampResolverStub{},
+		MultiversXResolver:        &testsCommon.BlockTimestampResolverStub{},
+		MaxTrackedBatches:         100,
+		HistogramBucketsInSeconds: []uint64{60, 300, 900},
+	}
+}
+
+func createTimeHandler(timestamp *int64) func() time.Time {
+	return func() time.Time {
+		return time.Unix(*timestamp, 0)
+	}
+}
+
+func TestNewLatencyTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLatencyTracker()
+		args.Log = nil
+
+		tracker, err := NewLatencyTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLatencyTracker()
+		args.StatusHandler = nil
+
+		tracker, err := NewLatencyTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("nil Ethereum resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLatencyTracker()
+		args.EthereumResolver = nil
+
+		tracker, err := NewLatencyTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, ErrNilBlockTimestampResolver)
+		assert.Contains(t, err.Error(), toMultiversX)
+	})
+	t.Run("nil MultiversX resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLatencyTracker()
+		args.MultiversXResolver = nil
+
+		tracker, err := NewLatencyTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, ErrNilBlockTimestampResolver)
+		assert.Contains(t, err.Error(), fromMultiversX)
+	})
+	t.Run("invalid MaxTrackedBatches should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLatencyTracker()
+		args.MaxTrackedBatches = 0
+
+		tracker, err := NewLatencyTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "MaxTrackedBatches")
+	})
+	t.Run("empty histogram buckets should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLatencyTracker()
+		args.HistogramBucketsInSeconds = nil
+
+		tracker, err := NewLatencyTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "empty list")
+	})
+	t.Run("zero histogram bucket should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLatencyTracker()
+		args.HistogramBucketsInSeconds = []uint64{0, 60}
+
+		tracker, err := NewLatencyTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "zero bucket at index 0")
+	})
+	t.Run("unsorted histogram buckets should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsLatencyTracker()
+		args.HistogramBucketsInSeconds = []uint64{60, 60}
+
+		tracker, err := NewLatencyTracker(args)
+		assert.True(t, check.IfNil(tracker))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "strictly increasing, index 1")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tracker, err := NewLatencyTracker(createMockArgsLatencyTracker())
+		assert.False(t, check.IfNil(tracker))
+		assert.Nil(t, err)
+		assert.Empty(t, tracker.GetBatchTimelines())
+	})
+}
+
+func TestLatencyTracker_RecordShouldIgnoreUnknownDirectionsAndSteps(t *testing.T) {
+	t.Parallel()
+
+	tracker, _ := NewLatencyTracker(createMockArgsLatencyTracker())
+	tracker.RecordBatchCreation("unknown", 1, 100)
+	tracker.RecordStep("unknown", 1, core.ProposedStep)
+	assert.Empty(t, tracker.GetBatchTimelines())
+
+	tracker.RecordStep(toMultiversX, 1, "unknown step")
+	timelines := tracker.GetBatchTimelines()
+	require.Equal(t, 1, len(timelines))
+	assert.Equal(t, &core.BatchTimeline{Direction: toMultiversX, BatchID: 1}, timelines[0])
+}
+
+func TestLatencyTracker_RecordStepShouldKeepTheFirstObservation(t *testing.T) {
+	t.Parallel()
+
+	currentTime := int64(1000)
+	tracker, _ := NewLatencyTracker(createMockArgsLatencyTracker())
+	tracker.getTimeHandler = createTimeHandler(&currentTime)
+
+	tracker.RecordBatchCreation(toMultiversX, 1, 100)
+	tracker.RecordStep(toMultiversX, 1, core.ProposedStep)
+	currentTime = 2000
+	tracker.RecordBatchCreation(toMultiversX, 1, 200)
+	tracker.RecordStep(toMultiversX, 1, core.ProposedStep)
+
+	timelines := tracker.GetBatchTimelines()
+	require.Equal(t, 1, len(timelines))
+	assert.Equal(t, uint64(100), timelines[0].BlockNumber)
+	assert.Equal(t, int64(1000), timelines[0].ProposedAt)
+}
+
+func TestLatencyTracker_ToMultiversXFlow(t *testing.T) {
+	t.Parallel()
+
+	currentTime := int64(1000)
+	args := createMockArgsLatencyTracker()
+	statusHandler := testsCommon.NewStatusHandlerMock("mock")
+	args.StatusHandler = statusHandler
+	args.EthereumResolver = &testsCommon.BlockTimestampResolverStub{
+		GetBlockTimestampCalled: func(ctx context.Context, blockNumber uint64) (uint64, error) {
+			assert.Equal(t, uint64(100), blockNumber)
+			return 940, nil
+		},
+	}
+	args.MultiversXResolver = &testsCommon.BlockTimestampResolverStub{
+		GetBlockTimestampCalled: func(ctx context.Context, blockNumber uint64) (uint64, error) {
+			assert.Fail(t, "should have not called the MultiversX resolver")
+			return 0, nil
+		},
+	}
+	tracker, _ := NewLatencyTracker(args)
+	tracker.getTimeHandler = createTimeHandler(&currentTime)
+
+	tracker.RecordBatchCreation(toMultiversX, 1, 100)
+	tracker.RecordStep(toMultiversX, 1, core.ProposedStep)
+	currentTime = 1030
+	tracker.RecordStep(toMultiversX, 1, core.QuorumReachedStep)
+	currentTime = 1100
+	tracker.RecordStep(toMultiversX, 1, core.ExecutedStep)
+
+	// the creation time is not yet known
+	assert.Equal(t, 30, statusHandler.GetIntMetric("ToMultiversX quorumReached last step duration in seconds"))
+	assert.Equal(t, 70, statusHandler.GetIntMetric("ToMultiversX executed last step duration in seconds"))
+	assert.Equal(t, 0, statusHandler.GetIntMetric("ToMultiversX transfer latency count"))
+
+	err := tracker.Execute(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, 60, statusHandler.GetIntMetric("ToMultiversX proposed last step duration in seconds"))
+	assert.Equal(t, 0, statusHandler.GetIntMetric("ToMultiversX transfer latency bucket le 60s"))
+	assert.Equal(t, 1, statusHandler.GetIntMetric("ToMultiversX transfer latency bucket le 300s"))
+	assert.Equal(t, 1, statusHandler.GetIntMetric("ToMultiversX transfer latency bucket le 900s"))
+	assert.Equal(t, 1, statusHandler.GetIntMetric("ToMultiversX transfer latency count"))
+	assert.Equal(t, 160, statusHandler.GetIntMetric("ToMultiversX transfer latency sum in seconds"))
+	assert.Equal(t, 160, statusHandler.GetIntMetric("ToMultiversX last transfer latency in seconds"))
+
+	expectedTimeline := &core.BatchTimeline{
+		Direction:        toMultiversX,
+		BatchID:          1,
+		BlockNumber:      100,
+		CreatedAt:        940,
+		ProposedAt:       1000,
+		QuorumReachedAt:  1030,
+		ExecutedAt:       1100,
+		LatencyInSeconds: 160,
+	}
+	assert.Equal(t, []*core.BatchTimeline{expectedTimeline}, tracker.GetBatchTimelines())
+
+	// a new Execute call should not observe the latency again
+	err = tracker.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, statusHandler.GetIntMetric("ToMultiversX transfer latency count"))
+}
+
+func TestLatencyTracker_FromMultiversXFlow(t *testing.T) {
+	t.Parallel()
+
+	currentTime := int64(2000)
+	args := createMockArgsLatencyTracker()
+	statusHandler := testsCommon.NewStatusHandlerMock("mock")
+	args.StatusHandler = statusHandler
+	args.MultiversXResolver = &testsCommon.BlockTimestampResolverStub{
+		GetBlockTimestampCalled: func(ctx context.Context, blockNumber uint64) (uint64, error) {
+			assert.Equal(t, uint64(300), blockNumber)
+			return 1990, nil
+		},
+	}
+	tracker, _ := NewLatencyTracker(args)
+	tracker.getTimeHandler = createTimeHandler(&currentTime)
+
+	tracker.RecordBatchCreation(fromMultiversX, 5, 300)
+	err := tracker.Execute(context.Background())
+	assert.Nil(t, err)
+
+	tracker.RecordStep(fromMultiversX, 5, core.ProposedStep)
+	currentTime = 2012
+	tracker.RecordStep(fromMultiversX, 5, core.QuorumReachedStep)
+	currentTime = 2040
+	tracker.RecordStep(fromMultiversX, 5, core.ExecutedStep)
+	currentTime = 2100
+	tracker.RecordStep(fromMultiversX, 5, core.StatusSetStep)
+
+	assert.Equal(t, 10, statusHandler.GetIntMetric("FromMultiversX proposed last step duration in seconds"))
+	assert.Equal(t, 12, statusHandler.GetIntMetric("FromMultiversX quorumReached last step duration in seconds"))
+	assert.Equal(t, 28, statusHandler.GetIntMetric("FromMultiversX executed last step duration in seconds"))
+	assert.Equal(t, 60, statusHandler.GetIntMetric("FromMultiversX statusSet last step duration in seconds"))
+	assert.Equal(t, 1, statusHandler.GetIntMetric("FromMultiversX transfer latency bucket le 60s"))
+	assert.Equal(t, 1, statusHandler.GetIntMetric("FromMultiversX transfer latency count"))
+	assert.Equal(t, 50, statusHandler.GetIntMetric("FromMultiversX last transfer latency in seconds"))
+	assert.Equal(t, 0, statusHandler.GetIntMetric("ToMultiversX transfer latency count"))
+}
+
+func TestLatencyTracker_ExecuteShouldRetryOnResolverErrors(t *testing.T) {
+	t.Parallel()
+
+	numCalls := 0
+	args := createMockArgsLatencyTracker()
+	args.EthereumResolver = &testsCommon.BlockTimestampResolverStub{
+		GetBlockTimestampCalled: func(ctx context.Context, blockNumber uint64) (uint64, error) {
+			numCalls++
+			if numCalls == 1 {
+				return 0, errors.New("expected error")
+			}
+
+			return 940, nil
+		},
+	}
+	tracker, _ := NewLatencyTracker(args)
+
+	// batches without a block number are not resolved
+	tracker.RecordStep(toMultiversX, 1, core.ProposedStep)
+	tracker.RecordBatchCreation(toMultiversX, 2, 100)
+
+	err := tracker.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), tracker.GetBatchTimelines()[1].CreatedAt)
+
+	err = tracker.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(940), tracker.GetBatchTimelines()[1].CreatedAt)
+
+	err = tracker.Execute(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, 2, numCalls)
+}
+
+func TestLatencyTracker_ExecuteWithClosedContextShouldError(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsLatencyTracker()
+	args.EthereumResolver = &testsCommon.BlockTimestampResolverStub{
+		GetBlockTimestampCalled: func(ctx context.Context, blockNumber uint64) (uint64, error) {
+			assert.Fail(t, "should have not called the resolver")
+			return 0, nil
+		},
+	}
+	tracker, _ := NewLatencyTracker(args)
+	tracker.RecordBatchCreation(toMultiversX, 1, 100)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := tracker.Execute(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestLatencyTracker_ShouldKeepOnlyTheMostRecentBatches(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsLatencyTracker()
+	args.MaxTrackedBatches = 2
+	tracker, _ := NewLatencyTracker(args)
+
+	tracker.RecordBatchCreation(toMultiversX, 1, 100)
+	tracker.RecordBatchCreation(fromMultiversX, 1, 200)
+	tracker.RecordBatchCreation(toMultiversX, 2, 300)
+
+	timelines := tracker.GetBatchTimelines()
+	require.Equal(t, 2, len(timelines))
+	assert.Equal(t, fromMultiversX, timelines[0].Direction)
+	assert.Equal(t, uint64(1), timelines[0].BatchID)
+	assert.Equal(t, toMultiversX, timelines[1].Direction)
+	assert.Equal(t, uint64(2), timelines[1].BatchID)
+}
+
+func TestLatencyTracker_GetBatchTimelinesShouldReturnCopies(t *testing.T) {
+	t.Parallel()
+
+	tracker, _ := NewLatencyTracker(createMockArgsLatencyTracker())
+	tracker.RecordBatchCreation(toMultiversX, 1, 100)
+
+	timelines := tracker.GetBatchTimelines()
+	timelines[0].BlockNumber = 0
+
+	assert.Equal(t, uint64(100), tracker.GetBatchTimelines()[0].BlockNumber)
+}
+
+func TestLatencyTracker_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	tracker, _ := NewLatencyTracker(createMockArgsLatencyTracker())
+
+	numCalls := 100
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 4 {
+			case 0:
+				tracker.RecordBatchCreation(toMultiversX, uint64(idx), uint64(idx+1))
+			case 1:
+				tracker.RecordStep(fromMultiversX, uint64(idx), core.ExecutedStep)
+			case 2:
+				_ = tracker.Execute(context.Background())
+			default:
+				_ = tracker.GetBatchTimelines()
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
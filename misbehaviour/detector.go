@@ -0,0 +1,267 @@
+package misbehaviour
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	evidenceStorerKey = "misbehaviour evidence"
+	minTrackedBatches = 1
+	unknownBatchID    = uint64(0)
+)
+
+// ArgsMisbehaviourDetector is the DTO used in the misbehaviour detector constructor
+type ArgsMisbehaviourDetector struct {
+	Log               logger.Logger
+	Storer            core.Storer
+	StatusHandler     core.StatusHandler
+	MaxTrackedBatches int
+}
+
+type signedHash struct {
+	messageHash []byte
+	message     *core.SignedMessage
+	peerID      string
+}
+
+type misbehaviourDetector struct {
+	log               logger.Logger
+	storer            core.Storer
+	statusHandler     core.StatusHandler
+	maxTrackedBatches int
+
+	mut             sync.RWMutex
+	signedHashes    map[uint64]map[string]*signedHash
+	localHashes     map[uint64][]byte
+	evidence        []*core.MisbehaviourEvidence
+	reportedReasons map[string]struct{}
+}
+
+// NewMisbehaviourDetector creates a new instance of the misbehaviour detector. It groups the received ethereum
+// signatures by relayer and batch, flagging the relayers that signed conflicting message hashes or message hashes
+// different from the one computed by the current relayer
+func NewMisbehaviourDetector(args ArgsMisbehaviourDetector) (*misbehaviourDetector, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	detector := &misbehaviourDetector{
+		log:               args.Log,
+		storer:            args.Storer,
+		statusHandler:     args.StatusHandler,
+		maxTrackedBatches: args.MaxTrackedBatches,
+		signedHashes:      make(map[uint64]map[string]*signedHash),
+		localHashes:       make(map[uint64][]byte),
+		evidence:          make([]*core.MisbehaviourEvidence, 0),
+		reportedReasons:   make(map[string]struct{}),
+	}
+	detector.tryLoadPersistedEvidence()
+
+	return detector, nil
+}
+
+func checkArgs(args ArgsMisbehaviourDetector) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.Storer) {
+		return ErrNilStorer
+	}
+	if check.IfNil(args.StatusHandler) {
+		return ErrNilStatusHandler
+	}
+	if args.MaxTrackedBatches < minTrackedBatches {
+		return fmt.Errorf("%w for MaxTrackedBatches, minimum %d, got %d", ErrInvalidValue, minTrackedBatches, args.MaxTrackedBatches)
+	}
+
+	return nil
+}
+
+// ProcessSignature will check the ethereum signature received from another relayer
+func (detector *misbehaviourDetector) ProcessSignature(msg *core.SignedMessage, ethMsg *core.EthereumSignature, peerID chainCore.PeerID) {
+	if msg == nil || ethMsg == nil || ethMsg.BatchId == unknownBatchID {
+		// legacy relayers do not send the batch ID
+		return
+	}
+
+	detector.mut.Lock()
+	defer detector.mut.Unlock()
+
+	current := &signedHash{
+		messageHash: ethMsg.MessageHash,
+		message:     msg,
+		peerID:      peerID.Pretty(),
+	}
+	signersOfBatch := detector.getOrCreateSignersOfBatch(ethMsg.BatchId)
+	existing, found := signersOfBatch[string(msg.PublicKeyBytes)]
+	if !found {
+		signersOfBatch[string(msg.PublicKeyBytes)] = current
+	} else if !bytes.Equal(existing.messageHash, current.messageHash) {
+		detector.addEvidence(core.ConflictingSignatures, ethMsg.BatchId, msg.PublicKeyBytes, nil, existing, current)
+	}
+
+	localHash, found := detector.localHashes[ethMsg.BatchId]
+	if found && !bytes.Equal(localHash, current.messageHash) {
+		detector.addEvidence(core.UnknownMessageHash, ethMsg.BatchId, msg.PublicKeyBytes, localHash, current)
+	}
+}
+
+// ProcessLocalSignature will store the message hash computed by the current relayer for the batch and will check
+// the already received signatures against it
+func (detector *misbehaviourDetector) ProcessLocalSignature(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+	if msg == nil || ethMsg == nil || ethMsg.BatchId == unknownBatchID {
+		return
+	}
+
+	detector.mut.Lock()
+	defer detector.mut.Unlock()
+
+	detector.localHashes[ethMsg.BatchId] = ethMsg.MessageHash
+	signersOfBatch := detector.getOrCreateSignersOfBatch(ethMsg.BatchId)
+	for _, signed := range signersOfBatch {
+		if bytes.Equal(signed.messageHash, ethMsg.MessageHash) {
+			continue
+		}
+
+		detector.addEvidence(core.UnknownMessageHash, ethMsg.BatchId, signed.message.PublicKeyBytes, ethMsg.MessageHash, signed)
+	}
+}
+
+func (detector *misbehaviourDetector) getOrCreateSignersOfBatch(batchID uint64) map[string]*signedHash {
+	signersOfBatch, found := detector.signedHashes[batchID]
+	if found {
+		return signersOfBatch
+	}
+
+	signersOfBatch = make(map[string]*signedHash)
+	detector.signedHashes[batchID] = signersOfBatch
+	detector.pruneOldBatches()
+
+	return signersOfBatch
+}
+
+func (detector *misbehaviourDetector) pruneOldBatches() {
+	if len(detector.signedHashes) <= detector.maxTrackedBatches {
+		return
+	}
+
+	batchIDs := make([]uint64, 0, len(detector.signedHashes))
+	for batchID := range detector.signedHashes {
+		batchIDs = append(batchIDs, batchID)
+	}
+	sort.Slice(batchIDs, func(i, j int) bool {
+		return batchIDs[i] < batchIDs[j]
+	})
+
+	numToRemove := len(batchIDs) - detector.maxTrackedBatches
+	for _, batchID := range batchIDs[:numToRemove] {
+		delete(detector.signedHashes, batchID)
+		delete(detector.localHashes, batchID)
+	}
+}
+
+func (detector *misbehaviourDetector) addEvidence(
+	misbehaviourType core.MisbehaviourType,
+	batchID uint64,
+	relayerPublicKey []byte,
+	expectedMessageHash []byte,
+	signedHashes ...*signedHash,
+) {
+	// one evidence of each type, for each relayer and batch is enough
+	reason := createReason(misbehaviourType, batchID, relayerPublicKey)
+	_, alreadyReported := detector.reportedReasons[reason]
+	if alreadyReported {
+		return
+	}
+	detector.reportedReasons[reason] = struct{}{}
+
+	evidence := &core.MisbehaviourEvidence{
+		Type:                misbehaviourType,
+		BatchID:             batchID,
+		RelayerPublicKey:    relayerPublicKey,
+		ExpectedMessageHash: expectedMessageHash,
+		SignedMessages:      make([]*core.SignedMessage, 0, len(signedHashes)),
+		PeerIDs:             make([]string, 0, len(signedHashes)),
+		Timestamp:           time.Now().Unix(),
+	}
+	for _, signed := range signedHashes {
+		evidence.SignedMessages = append(evidence.SignedMessages, signed.message)
+		evidence.PeerIDs = append(evidence.PeerIDs, signed.peerID)
+	}
+	detector.evidence = append(detector.evidence, evidence)
+
+	detector.log.Warn("relayer misbehaviour detected", "type", misbehaviourType, "batch ID", batchID,
+		"relayer public key", hex.EncodeToString(relayerPublicKey), "peer IDs", evidence.PeerIDs)
+	detector.statusHandler.SetIntMetric(core.MetricNumMisbehaviourEvidence, len(detector.evidence))
+	detector.statusHandler.SetStringMetric(core.MetricLastMisbehaviour,
+		fmt.Sprintf("%s for batch %d by %s", misbehaviourType, batchID, hex.EncodeToString(relayerPublicKey)))
+
+	detector.persistEvidence()
+}
+
+func createReason(misbehaviourType core.MisbehaviourType, batchID uint64, relayerPublicKey []byte) string {
+	return fmt.Sprintf("%s-%d-%x", misbehaviourType, batchID, relayerPublicKey)
+}
+
+func (detector *misbehaviourDetector) persistEvidence() {
+	buff, err := json.Marshal(detector.evidence)
+	if err != nil {
+		detector.log.Error("misbehaviourDetector.persistEvidence marshal", "error", err)
+		return
+	}
+
+	err = detector.storer.Put([]byte(evidenceStorerKey), buff)
+	if err != nil {
+		detector.log.Error("misbehaviourDetector.persistEvidence writing to storer", "error", err)
+	}
+}
+
+func (detector *misbehaviourDetector) tryLoadPersistedEvidence() {
+	buff, err := detector.storer.Get([]byte(evidenceStorerKey))
+	if err != nil {
+		detector.log.Debug("misbehaviourDetector.tryLoadPersistedEvidence reading from storer", "error", err)
+		return
+	}
+
+	evidence := make([]*core.MisbehaviourEvidence, 0)
+	err = json.Unmarshal(buff, &evidence)
+	if err != nil {
+		detector.log.Warn("misbehaviourDetector.tryLoadPersistedEvidence unmarshal", "error", err)
+		return
+	}
+
+	detector.evidence = evidence
+	for _, e := range evidence {
+		detector.reportedReasons[createReason(e.Type, e.BatchID, e.RelayerPublicKey)] = struct{}{}
+	}
+	detector.statusHandler.SetIntMetric(core.MetricNumMisbehaviourEvidence, len(detector.evidence))
+	detector.log.Debug("misbehaviourDetector.tryLoadPersistedEvidence loaded data", "num evidence", len(evidence))
+}
+
+// GetAllEvidence returns all the gathered misbehaviour evidence, including the persisted one
+func (detector *misbehaviourDetector) GetAllEvidence() []*core.MisbehaviourEvidence {
+	detector.mut.RLock()
+	defer detector.mut.RUnlock()
+
+	result := make([]*core.MisbehaviourEvidence, len(detector.evidence))
+	copy(result, detector.evidence)
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (detector *misbehaviourDetector) IsInterfaceNil() bool {
+	return detector == nil
+}
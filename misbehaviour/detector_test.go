@@ -0,0 +1,229 @@
+package misbehaviour
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	pid1 = chainCore.PeerID("pid1")
+	pid2 = chainCore.PeerID("pid2")
+)
+
+func createMockArgsMisbehaviourDetector() ArgsMisbehaviourDetector {
+	return ArgsMisbehaviourDetector{
+		Log:               &testsCommon.LoggerStub{},
+		Storer:            testsCommon.NewStorerMock(),
+		StatusHandler:     testsCommon.NewStatusHandlerMock("test"),
+		MaxTrackedBatches: 2,
+	}
+}
+
+func createSignature(pk string, messageHash string, batchID uint64) (*core.SignedMessage, *core.EthereumSignature) {
+	msg := &core.SignedMessage{
+		Payload:        []byte("payload " + messageHash),
+		PublicKeyBytes: []byte(pk),
+		Signature:      []byte("sig " + messageHash),
+	}
+	ethMsg := &core.EthereumSignature{
+		Signature:   []byte("eth sig " + messageHash),
+		MessageHash: []byte(messageHash),
+		BatchId:     batchID,
+	}
+
+	return msg, ethMsg
+}
+
+func TestNewMisbehaviourDetector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		args := createMockArgsMisbehaviourDetector()
+		args.Log = nil
+
+		detector, err := NewMisbehaviourDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil storer should error", func(t *testing.T) {
+		args := createMockArgsMisbehaviourDetector()
+		args.Storer = nil
+
+		detector, err := NewMisbehaviourDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("nil status handler should error", func(t *testing.T) {
+		args := createMockArgsMisbehaviourDetector()
+		args.StatusHandler = nil
+
+		detector, err := NewMisbehaviourDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.Equal(t, ErrNilStatusHandler, err)
+	})
+	t.Run("invalid max tracked batches should error", func(t *testing.T) {
+		args := createMockArgsMisbehaviourDetector()
+		args.MaxTrackedBatches = 0
+
+		detector, err := NewMisbehaviourDetector(args)
+		assert.True(t, check.IfNil(detector))
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		args := createMockArgsMisbehaviourDetector()
+
+		detector, err := NewMisbehaviourDetector(args)
+		assert.False(t, check.IfNil(detector))
+		assert.Nil(t, err)
+		assert.Empty(t, detector.GetAllEvidence())
+	})
+}
+
+func TestMisbehaviourDetector_ProcessSignature(t *testing.T) {
+	t.Parallel()
+
+	t.Run("legacy messages without batch ID should be ignored", func(t *testing.T) {
+		detector, _ := NewMisbehaviourDetector(createMockArgsMisbehaviourDetector())
+
+		msg1, ethMsg1 := createSignature("pk1", "hash1", 0)
+		msg2, ethMsg2 := createSignature("pk1", "hash2", 0)
+		detector.ProcessSignature(msg1, ethMsg1, pid1)
+		detector.ProcessSignature(msg2, ethMsg2, pid1)
+
+		assert.Empty(t, detector.GetAllEvidence())
+	})
+	t.Run("same hash signed twice should not be reported", func(t *testing.T) {
+		detector, _ := NewMisbehaviourDetector(createMockArgsMisbehaviourDetector())
+
+		msg1, ethMsg1 := createSignature("pk1", "hash1", 1)
+		detector.ProcessSignature(msg1, ethMsg1, pid1)
+		detector.ProcessSignature(msg1, ethMsg1, pid2)
+
+		assert.Empty(t, detector.GetAllEvidence())
+	})
+	t.Run("conflicting signatures should be reported once", func(t *testing.T) {
+		args := createMockArgsMisbehaviourDetector()
+		statusHandler := testsCommon.NewStatusHandlerMock("test")
+		args.StatusHandler = statusHandler
+		detector, _ := NewMisbehaviourDetector(args)
+
+		msg1, ethMsg1 := createSignature("pk1", "hash1", 1)
+		msg2, ethMsg2 := createSignature("pk1", "hash2", 1)
+		msg3, ethMsg3 := createSignature("pk1", "hash3", 1)
+		detector.ProcessSignature(msg1, ethMsg1, pid1)
+		detector.ProcessSignature(msg2, ethMsg2, pid2)
+		detector.ProcessSignature(msg3, ethMsg3, pid2)
+
+		evidence := detector.GetAllEvidence()
+		require.Equal(t, 1, len(evidence))
+		assert.Equal(t, core.ConflictingSignatures, evidence[0].Type)
+		assert.Equal(t, uint64(1), evidence[0].BatchID)
+		assert.Equal(t, []byte("pk1"), evidence[0].RelayerPublicKey)
+		assert.Equal(t, []*core.SignedMessage{msg1, msg2}, evidence[0].SignedMessages)
+		assert.Equal(t, []string{pid1.Pretty(), pid2.Pretty()}, evidence[0].PeerIDs)
+		assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumMisbehaviourEvidence))
+		assert.NotEmpty(t, statusHandler.GetStringMetric(core.MetricLastMisbehaviour))
+	})
+	t.Run("different batches should not conflict", func(t *testing.T) {
+		detector, _ := NewMisbehaviourDetector(createMockArgsMisbehaviourDetector())
+
+		msg1, ethMsg1 := createSignature("pk1", "hash1", 1)
+		msg2, ethMsg2 := createSignature("pk1", "hash2", 2)
+		detector.ProcessSignature(msg1, ethMsg1, pid1)
+		detector.ProcessSignature(msg2, ethMsg2, pid1)
+
+		assert.Empty(t, detector.GetAllEvidence())
+	})
+	t.Run("hash different from the local one should be reported", func(t *testing.T) {
+		detector, _ := NewMisbehaviourDetector(createMockArgsMisbehaviourDetector())
+
+		localMsg, localEthMsg := createSignature("local pk", "hash1", 1)
+		detector.ProcessLocalSignature(localMsg, localEthMsg)
+
+		msg1, ethMsg1 := createSignature("pk1", "hash1", 1)
+		msg2, ethMsg2 := createSignature("pk2", "hash2", 1)
+		detector.ProcessSignature(msg1, ethMsg1, pid1)
+		detector.ProcessSignature(msg2, ethMsg2, pid2)
+
+		evidence := detector.GetAllEvidence()
+		require.Equal(t, 1, len(evidence))
+		assert.Equal(t, core.UnknownMessageHash, evidence[0].Type)
+		assert.Equal(t, []byte("pk2"), evidence[0].RelayerPublicKey)
+		assert.Equal(t, []byte("hash1"), evidence[0].ExpectedMessageHash)
+		assert.Equal(t, []*core.SignedMessage{msg2}, evidence[0].SignedMessages)
+	})
+}
+
+func TestMisbehaviourDetector_ProcessLocalSignature(t *testing.T) {
+	t.Parallel()
+
+	detector, _ := NewMisbehaviourDetector(createMockArgsMisbehaviourDetector())
+
+	msg1, ethMsg1 := createSignature("pk1", "hash1", 1)
+	msg2, ethMsg2 := createSignature("pk2", "hash2", 1)
+	detector.ProcessSignature(msg1, ethMsg1, pid1)
+	detector.ProcessSignature(msg2, ethMsg2, pid2)
+	assert.Empty(t, detector.GetAllEvidence())
+
+	localMsg, localEthMsg := createSignature("local pk", "hash1", 1)
+	detector.ProcessLocalSignature(localMsg, localEthMsg)
+
+	evidence := detector.GetAllEvidence()
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, core.UnknownMessageHash, evidence[0].Type)
+	assert.Equal(t, []byte("pk2"), evidence[0].RelayerPublicKey)
+	assert.Equal(t, []string{pid2.Pretty()}, evidence[0].PeerIDs)
+}
+
+func TestMisbehaviourDetector_ShouldPruneOldBatches(t *testing.T) {
+	t.Parallel()
+
+	detector, _ := NewMisbehaviourDetector(createMockArgsMisbehaviourDetector())
+
+	for batchID := uint64(1); batchID <= 3; batchID++ {
+		msg, ethMsg := createSignature("pk1", "hash1", batchID)
+		detector.ProcessSignature(msg, ethMsg, pid1)
+	}
+
+	assert.Equal(t, 2, len(detector.signedHashes))
+	_, found := detector.signedHashes[1]
+	assert.False(t, found)
+
+	// batch 1 was pruned, so the conflict can not be detected anymore
+	msg, ethMsg := createSignature("pk1", "hash2", 1)
+	detector.ProcessSignature(msg, ethMsg, pid1)
+	assert.Empty(t, detector.GetAllEvidence())
+}
+
+func TestMisbehaviourDetector_ShouldReloadPersistedEvidence(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsMisbehaviourDetector()
+	detector, _ := NewMisbehaviourDetector(args)
+
+	msg1, ethMsg1 := createSignature("pk1", "hash1", 1)
+	msg2, ethMsg2 := createSignature("pk1", "hash2", 1)
+	detector.ProcessSignature(msg1, ethMsg1, pid1)
+	detector.ProcessSignature(msg2, ethMsg2, pid1)
+	require.Equal(t, 1, len(detector.GetAllEvidence()))
+
+	statusHandler := testsCommon.NewStatusHandlerMock("test")
+	args.StatusHandler = statusHandler
+	reloadedDetector, err := NewMisbehaviourDetector(args)
+	require.Nil(t, err)
+
+	assert.Equal(t, detector.GetAllEvidence(), reloadedDetector.GetAllEvidence())
+	assert.Equal(t, 1, statusHandler.GetIntMetric(core.MetricNumMisbehaviourEvidence))
+
+	// the same misbehaviour should not be reported twice
+	reloadedDetector.ProcessSignature(msg1, ethMsg1, pid1)
+	reloadedDetector.ProcessSignature(msg2, ethMsg2, pid1)
+	assert.Equal(t, 1, len(reloadedDetector.GetAllEvidence()))
+}
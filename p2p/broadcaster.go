@@ -35,11 +35,15 @@ type ArgsBroadcaster struct {
 	PrivateKey             crypto.PrivateKey
 	Name                   string
 	AntifloodComponents    *factory.AntiFloodComponents
+	Marshalizer            marshal.Marshalizer
+	PrivateNetworkEnabled  bool
+	MisbehaviourDetector   MisbehaviourDetector
 }
 
 type broadcaster struct {
 	*relayerMessageHandler
 	*noncesOfPublicKeys
+	*protocolVersionsOfPublicKeys
 	messenger             NetMessenger
 	log                   logger.Logger
 	multiversRoleProvider MultiversXRoleProvider
@@ -49,6 +53,8 @@ type broadcaster struct {
 	clients               []core.BroadcastClient
 	joinTopicName         string
 	signTopicName         string
+	privateNetworkEnabled bool
+	misbehaviourDetector  MisbehaviourDetector
 }
 
 // NewBroadcaster will create a new broadcaster able to pass messages and signatures
@@ -59,29 +65,33 @@ func NewBroadcaster(args ArgsBroadcaster) (*broadcaster, error) {
 	}
 
 	b := &broadcaster{
-		name:                  args.Name,
-		messenger:             args.Messenger,
-		noncesOfPublicKeys:    newNoncesOfPublicKeys(),
-		log:                   args.Log,
-		multiversRoleProvider: args.MultiversXRoleProvider,
-		signatureProcessor:    args.SignatureProcessor,
+		name:                         args.Name,
+		messenger:                    args.Messenger,
+		noncesOfPublicKeys:           newNoncesOfPublicKeys(),
+		protocolVersionsOfPublicKeys: newProtocolVersionsOfPublicKeys(),
+		log:                          args.Log,
+		multiversRoleProvider:        args.MultiversXRoleProvider,
+		signatureProcessor:           args.SignatureProcessor,
 		relayerMessageHandler: &relayerMessageHandler{
-			marshalizer:         &marshal.JsonMarshalizer{},
+			marshalizer:         args.Marshalizer,
 			keyGen:              args.KeyGen,
 			singleSigner:        args.SingleSigner,
 			counter:             uint64(time.Now().UnixNano()),
 			privateKey:          args.PrivateKey,
 			antifloodComponents: args.AntifloodComponents,
 		},
-		clients:       make([]core.BroadcastClient, 0),
-		joinTopicName: args.Name + joinTopicSuffix,
-		signTopicName: args.Name + signTopicSuffix,
+		clients:               make([]core.BroadcastClient, 0),
+		joinTopicName:         args.Name + joinTopicSuffix,
+		signTopicName:         args.Name + signTopicSuffix,
+		privateNetworkEnabled: args.PrivateNetworkEnabled,
+		misbehaviourDetector:  args.MisbehaviourDetector,
 	}
 	pk := b.privateKey.GeneratePublic()
 	b.publicKeyBytes, err = pk.ToByteArray()
 	if err != nil {
 		return nil, err
 	}
+	b.setProtocolVersion(b.publicKeyBytes, currentProtocolVersion)
 
 	return b, err
 }
@@ -114,6 +124,12 @@ func checkArgs(args ArgsBroadcaster) error {
 	if args.AntifloodComponents == nil {
 		return ErrNilAntifloodComponents
 	}
+	if check.IfNil(args.Marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(args.MisbehaviourDetector) {
+		return ErrNilMisbehaviourDetector
+	}
 
 	return nil
 }
@@ -149,6 +165,10 @@ func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnect
 	addr := data.NewAddressFromBytes(msg.PublicKeyBytes)
 	hexPkBytes := hex.EncodeToString(msg.PublicKeyBytes)
 	if !b.multiversRoleProvider.IsWhitelisted(addr) {
+		if b.privateNetworkEnabled {
+			// in a private network only the relayers are allowed to be connected
+			b.blacklistPeers(message, fromConnectedPeer, "not a whitelisted relayer on topic "+message.Topic())
+		}
 		return fmt.Errorf("%w for peer: %s", ErrPeerNotWhitelisted, hexPkBytes)
 	}
 
@@ -172,24 +192,43 @@ func (b *broadcaster) ProcessReceivedMessage(message p2p.MessageP2P, fromConnect
 
 	switch message.Topic() {
 	case b.joinTopicName:
-		b.processJoinMessage(message)
+		b.processJoinMessage(message, msg)
 	case b.signTopicName:
-		b.processSignMessage(msg)
+		b.processSignMessage(message, msg)
 	}
 
 	return nil
 }
 
-func (b *broadcaster) processJoinMessage(message p2p.MessageP2P) {
-	err := b.broadcastCurrentSignatures(message.Peer())
+func (b *broadcaster) processJoinMessage(message p2p.MessageP2P, msg *core.SignedMessage) {
+	version := b.getAdvertisedProtocolVersion(msg)
+	b.setProtocolVersion(msg.PublicKeyBytes, version)
+
+	err := b.broadcastCurrentSignatures(message.Peer(), version)
 	if err != nil {
 		b.log.Error(err.Error())
 	}
 }
 
+func (b *broadcaster) getAdvertisedProtocolVersion(msg *core.SignedMessage) uint32 {
+	if string(msg.Payload) == joinTopicMessage {
+		return legacyProtocolVersion
+	}
+
+	joinMessage := &core.JoinTopicMessage{}
+	err := b.unmarshal(joinMessage, msg.Payload)
+	if err != nil || joinMessage.ProtocolVersion < legacyProtocolVersion {
+		b.log.Debug("received join message with an unknown payload, considering it a legacy relayer",
+			"public key", hex.EncodeToString(msg.PublicKeyBytes), "error", err)
+		return legacyProtocolVersion
+	}
+
+	return joinMessage.ProtocolVersion
+}
+
 func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage) (*core.EthereumSignature, error) {
 	ethSignature := &core.EthereumSignature{}
-	err := b.marshalizer.Unmarshal(ethSignature, msg.Payload)
+	err := b.unmarshal(ethSignature, msg.Payload)
 	if err != nil {
 		return nil, err
 	}
@@ -202,13 +241,15 @@ func (b *broadcaster) getEthereumSignature(msg *core.SignedMessage) (*core.Ether
 	return ethSignature, nil
 }
 
-func (b *broadcaster) processSignMessage(msg *core.SignedMessage) {
+func (b *broadcaster) processSignMessage(message p2p.MessageP2P, msg *core.SignedMessage) {
 	ethSignature, err := b.getEthereumSignature(msg)
 	if err != nil {
 		b.log.Debug("received message does not contain a valid signature", "error", err)
 		return
 	}
 
+	b.misbehaviourDetector.ProcessSignature(msg, ethSignature, message.Peer())
+
 	b.notifyClients(msg, ethSignature)
 }
 
@@ -221,11 +262,17 @@ func (b *broadcaster) notifyClients(msg *core.SignedMessage, ethMsg *core.Ethere
 	}
 }
 
-func (b *broadcaster) broadcastCurrentSignatures(peerId chainCore.PeerID) error {
+func (b *broadcaster) broadcastCurrentSignatures(peerId chainCore.PeerID, version uint32) error {
 	allMessages := b.retrieveUniqueMessages()
+	marshalizer := b.marshalizerForProtocolVersion(version)
 
 	for _, msg := range allMessages {
-		err := b.sendSignedMessageToPeer(msg, peerId)
+		if version < currentProtocolVersion && !isLegacyFormat(msg.Payload) {
+			// the payload is signed, so it can not be re-encoded for a legacy relayer
+			continue
+		}
+
+		err := b.sendSignedMessageToPeer(msg, peerId, marshalizer)
 		if err != nil {
 			b.log.Debug("error sending current stored signatures",
 				"error", err.Error(), "peer", peerId.Pretty())
@@ -247,8 +294,8 @@ func (b *broadcaster) retrieveUniqueMessages() map[string]*core.SignedMessage {
 	return allMessages
 }
 
-func (b *broadcaster) sendSignedMessageToPeer(msg *core.SignedMessage, peerId chainCore.PeerID) error {
-	buff, err := b.marshalizer.Marshal(msg)
+func (b *broadcaster) sendSignedMessageToPeer(msg *core.SignedMessage, peerId chainCore.PeerID, marshalizer marshal.Marshalizer) error {
+	buff, err := marshalizer.Marshal(msg)
 	if err != nil {
 		return err
 	}
@@ -258,46 +305,72 @@ func (b *broadcaster) sendSignedMessageToPeer(msg *core.SignedMessage, peerId ch
 
 // BroadcastSignature will send the provided signature as payload in a wrapped signed message to the other peers.
 // It will broadcast the message to all available peers
-func (b *broadcaster) BroadcastSignature(signature []byte, messageHash []byte) {
+func (b *broadcaster) BroadcastSignature(signature []byte, messageHash []byte, batchID uint64) {
 	ethSig := &core.EthereumSignature{
 		Signature:   signature,
 		MessageHash: messageHash,
+		BatchId:     batchID,
 	}
 
-	payload, err := b.marshalizer.Marshal(ethSig)
+	marshalizer := b.getBroadcastMarshalizer()
+	payload, err := marshalizer.Marshal(ethSig)
 	if err != nil {
 		b.log.Error("error creating signature payload", "error", err)
 	}
 
-	err = b.broadcastMessage(payload, b.signTopicName)
+	msg, err := b.broadcastMessage(payload, b.signTopicName, marshalizer)
 	if err != nil {
 		b.log.Error("error sending signature", "error", err)
+		return
 	}
+
+	b.misbehaviourDetector.ProcessLocalSignature(msg, ethSig)
 }
 
-// BroadcastJoinTopic will send the provided signature as payload in a wrapped signed message to the other peers.
+// BroadcastJoinTopic will send the current protocol version as payload in a wrapped signed message to the other peers.
 // It will broadcast the message to all available peers
 func (b *broadcaster) BroadcastJoinTopic() {
-	err := b.broadcastMessage([]byte(joinTopicMessage), b.joinTopicName)
+	joinMessage := &core.JoinTopicMessage{
+		ProtocolVersion: currentProtocolVersion,
+	}
+
+	marshalizer := b.getBroadcastMarshalizer()
+	payload, err := marshalizer.Marshal(joinMessage)
+	if err != nil {
+		b.log.Error("error creating join payload", "error", err)
+		return
+	}
+
+	_, err = b.broadcastMessage(payload, b.joinTopicName, marshalizer)
 	if err != nil {
 		b.log.Error("error sending signature", "error", err)
 	}
 }
 
-func (b *broadcaster) broadcastMessage(payload []byte, topic string) error {
+// getBroadcastMarshalizer returns the configured marshalizer only if all whitelisted relayers advertised
+// the current protocol version, otherwise the legacy marshalizer is returned
+func (b *broadcaster) getBroadcastMarshalizer() marshal.Marshalizer {
+	if b.allSupportProtocolVersion(b.multiversRoleProvider.SortedPublicKeys(), currentProtocolVersion) {
+		return b.marshalizer
+	}
+
+	return legacyMarshalizer
+}
+
+func (b *broadcaster) broadcastMessage(payload []byte, topic string, marshalizer marshal.Marshalizer) (*core.SignedMessage, error) {
 	msg, err := b.createMessage(payload)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	buff, err := b.marshalizer.Marshal(msg)
+	buff, err := marshalizer.Marshal(msg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	b.messenger.Broadcast(topic, buff)
 
-	return nil
+	return msg, nil
 }
 
 // AddBroadcastClient will add a client to the list so it can be notified of the newly received
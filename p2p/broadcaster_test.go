@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-bridge-eth-go/core"
 	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
@@ -14,9 +15,11 @@ import (
 	roleProvidersMock "github.com/multiversx/mx-bridge-eth-go/testsCommon/roleProviders"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	chainConfig "github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
 	"github.com/multiversx/mx-chain-go/testscommon/statusHandler"
 	logger "github.com/multiversx/mx-chain-logger-go"
@@ -40,6 +43,8 @@ func createMockArgsBroadcaster() ArgsBroadcaster {
 		SignatureProcessor:     &testsCommon.SignatureProcessorStub{},
 		Name:                   "test",
 		AntifloodComponents:    ac,
+		Marshalizer:            &testsCommon.MarshalizerMock{},
+		MisbehaviourDetector:   &p2pMocks.MisbehaviourDetectorStub{},
 	}
 }
 
@@ -135,6 +140,22 @@ func TestNewBroadcaster(t *testing.T) {
 		assert.True(t, check.IfNil(b))
 		assert.Equal(t, ErrNilAntifloodComponents, err)
 	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.Marshalizer = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil misbehaviour detector should error", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.MisbehaviourDetector = nil
+
+		b, err := NewBroadcaster(args)
+		assert.True(t, check.IfNil(b))
+		assert.Equal(t, ErrNilMisbehaviourDetector, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 
@@ -238,6 +259,37 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 		assert.True(t, errors.Is(err, ErrPeerNotWhitelisted))
 		assert.True(t, isWhiteListedCalled)
 	})
+	t.Run("public key not whitelisted in private network should blacklist the peers", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.PrivateNetworkEnabled = true
+		_, buff := createSignedMessageAndMarshaledBytes(0)
+
+		args.MultiversXRoleProvider = &roleProvidersMock.MultiversXRoleProviderStub{
+			IsWhitelistedCalled: func(address sdkCore.AddressHandler) bool {
+				return false
+			},
+		}
+		blackList := make(map[chainCore.PeerID]string)
+		args.AntifloodComponents = &factory.AntiFloodComponents{
+			AntiFloodHandler: &mock.P2PAntifloodHandlerStub{
+				BlacklistPeerCalled: func(peer chainCore.PeerID, reason string, duration time.Duration) {
+					blackList[peer] = reason
+				},
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField: buff,
+			PeerField: pid,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, fromPeer, nil)
+		assert.True(t, errors.Is(err, ErrPeerNotWhitelisted))
+		assert.Equal(t, 2, len(blackList))
+		assert.True(t, strings.Contains(blackList[pid], "not a whitelisted relayer"))
+		assert.True(t, strings.Contains(blackList[fromPeer], "not a whitelisted relayer"))
+	})
 	t.Run("invalid nonce should error", func(t *testing.T) {
 		args := createMockArgsBroadcaster()
 		msg, buff := createSignedMessageAndMarshaledBytes(0)
@@ -465,6 +517,30 @@ func TestBroadcaster_ProcessReceivedMessage(t *testing.T) {
 		assert.Equal(t, [][]byte{msg1.PublicKeyBytes, msg2.PublicKeyBytes}, b.SortedPublicKeys())
 		assert.Equal(t, []*core.SignedMessage{msg2, msg1}, processedMessages)
 	})
+	t.Run("sign should notify the misbehaviour detector", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		msg1, buff1 := createSignedMessageForEthSig(0)
+		args.Messenger = &p2pMocks.MessengerStub{}
+
+		processedMessages := make([]*core.SignedMessage, 0)
+		args.MisbehaviourDetector = &p2pMocks.MisbehaviourDetectorStub{
+			ProcessSignatureCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature, peerID chainCore.PeerID) {
+				assert.Equal(t, pid, peerID)
+				processedMessages = append(processedMessages, msg)
+			},
+		}
+
+		b, _ := NewBroadcaster(args)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff1,
+			TopicField: args.Name + signTopicSuffix,
+			PeerField:  pid,
+		}
+
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, []*core.SignedMessage{msg1}, processedMessages)
+	})
 }
 
 func TestBroadcaster_BroadcastJoinTopic(t *testing.T) {
@@ -487,7 +563,11 @@ func TestBroadcaster_BroadcastJoinTopic(t *testing.T) {
 			err := marshalizer.Unmarshal(msg, buff)
 			require.Nil(t, err)
 			assert.Equal(t, sig, msg.Signature)
-			assert.Equal(t, []byte(joinTopicMessage), msg.Payload)
+
+			joinMessage := &core.JoinTopicMessage{}
+			err = marshalizer.Unmarshal(joinMessage, msg.Payload)
+			require.Nil(t, err)
+			assert.Equal(t, currentProtocolVersion, joinMessage.ProtocolVersion)
 		},
 	}
 	b, _ := NewBroadcaster(args)
@@ -525,12 +605,187 @@ func TestBroadcaster_BroadcastSignature(t *testing.T) {
 
 			assert.Equal(t, ethSig, ethMsgInstance.Signature)
 			assert.Equal(t, ethMsg, ethMsgInstance.MessageHash)
+			assert.Equal(t, uint64(37), ethMsgInstance.BatchId)
+		},
+	}
+	localSignatureProcessed := false
+	args.MisbehaviourDetector = &p2pMocks.MisbehaviourDetectorStub{
+		ProcessLocalSignatureCalled: func(msg *core.SignedMessage, ethMsgInstance *core.EthereumSignature) {
+			localSignatureProcessed = true
+			assert.Equal(t, sig, msg.Signature)
+			assert.Equal(t, ethMsg, ethMsgInstance.MessageHash)
+			assert.Equal(t, uint64(37), ethMsgInstance.BatchId)
 		},
 	}
 	b, _ := NewBroadcaster(args)
 
-	b.BroadcastSignature(ethSig, ethMsg)
+	b.BroadcastSignature(ethSig, ethMsg, 37)
 	assert.True(t, broadcastCalled)
+	assert.True(t, localSignatureProcessed)
+}
+
+func TestBroadcaster_ProtocolVersionNegotiation(t *testing.T) {
+	t.Parallel()
+
+	protoMarshalizer := &marshal.GogoProtoMarshalizer{}
+	otherRelayerPk := []byte("pk 1")
+	createJoinMessageBytes := func(msgMarshalizer marshal.Marshalizer, version uint32) []byte {
+		payload, _ := msgMarshalizer.Marshal(&core.JoinTopicMessage{ProtocolVersion: version})
+		msg := &core.SignedMessage{
+			Payload:        payload,
+			PublicKeyBytes: otherRelayerPk,
+			Signature:      []byte("sig"),
+			Nonce:          34,
+		}
+		buff, _ := msgMarshalizer.Marshal(msg)
+
+		return buff
+	}
+	createArgs := func(broadcastedBuffs *[][]byte) ArgsBroadcaster {
+		args := createMockArgsBroadcaster()
+		args.Marshalizer = protoMarshalizer
+		args.Messenger = &p2pMocks.MessengerStub{
+			BroadcastCalled: func(topic string, buff []byte) {
+				*broadcastedBuffs = append(*broadcastedBuffs, buff)
+			},
+		}
+
+		return args
+	}
+
+	t.Run("not all whitelisted relayers advertised the current version should broadcast legacy messages", func(t *testing.T) {
+		broadcastedBuffs := make([][]byte, 0)
+		args := createArgs(&broadcastedBuffs)
+		b, _ := NewBroadcaster(args)
+		args.MultiversXRoleProvider.(*roleProvidersMock.MultiversXRoleProviderStub).SortedPublicKeysCalled = func() [][]byte {
+			return [][]byte{b.publicKeyBytes, otherRelayerPk}
+		}
+
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  createJoinMessageBytes(marshalizer, legacyProtocolVersion),
+			TopicField: args.Name + joinTopicSuffix,
+		}
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		require.Nil(t, err)
+
+		b.BroadcastSignature([]byte("eth sig"), []byte("eth msg"), 1)
+		require.Equal(t, 1, len(broadcastedBuffs))
+		assert.True(t, isLegacyFormat(broadcastedBuffs[0]))
+
+		msg := &core.SignedMessage{}
+		err = marshalizer.Unmarshal(msg, broadcastedBuffs[0])
+		require.Nil(t, err)
+		assert.True(t, isLegacyFormat(msg.Payload))
+	})
+	t.Run("all whitelisted relayers advertised the current version should broadcast using the configured marshalizer", func(t *testing.T) {
+		broadcastedBuffs := make([][]byte, 0)
+		args := createArgs(&broadcastedBuffs)
+		b, _ := NewBroadcaster(args)
+		args.MultiversXRoleProvider.(*roleProvidersMock.MultiversXRoleProviderStub).SortedPublicKeysCalled = func() [][]byte {
+			return [][]byte{b.publicKeyBytes, otherRelayerPk}
+		}
+
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  createJoinMessageBytes(protoMarshalizer, currentProtocolVersion),
+			TopicField: args.Name + joinTopicSuffix,
+		}
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		require.Nil(t, err)
+		assert.True(t, b.allSupportProtocolVersion([][]byte{otherRelayerPk}, currentProtocolVersion))
+
+		b.BroadcastSignature([]byte("eth sig"), []byte("eth msg"), 1)
+		require.Equal(t, 1, len(broadcastedBuffs))
+		assert.False(t, isLegacyFormat(broadcastedBuffs[0]))
+
+		msg := &core.SignedMessage{}
+		err = protoMarshalizer.Unmarshal(msg, broadcastedBuffs[0])
+		require.Nil(t, err)
+
+		ethSig := &core.EthereumSignature{}
+		err = protoMarshalizer.Unmarshal(ethSig, msg.Payload)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("eth sig"), ethSig.Signature)
+		assert.Equal(t, []byte("eth msg"), ethSig.MessageHash)
+	})
+	t.Run("legacy join message should receive only the legacy stored messages", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.Marshalizer = protoMarshalizer
+		legacyMsg, _ := createSignedMessageForEthSig(0)
+		ethSigPayload, _ := protoMarshalizer.Marshal(&core.EthereumSignature{Signature: []byte("eth sig")})
+		protoMsg := &core.SignedMessage{
+			Payload:        ethSigPayload,
+			PublicKeyBytes: []byte("pk 2"),
+			Signature:      []byte("sig"),
+			Nonce:          34,
+		}
+
+		sentBuffs := make([][]byte, 0)
+		args.Messenger = &p2pMocks.MessengerStub{
+			SendToConnectedPeerCalled: func(topic string, buff []byte, peerID chainCore.PeerID) error {
+				sentBuffs = append(sentBuffs, buff)
+				return nil
+			},
+		}
+		b, _ := NewBroadcaster(args)
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			AllStoredSignaturesCalled: func() []*core.SignedMessage {
+				return []*core.SignedMessage{legacyMsg, protoMsg}
+			},
+		})
+
+		joinMsg := &core.SignedMessage{
+			Payload:        []byte(joinTopicMessage),
+			PublicKeyBytes: otherRelayerPk,
+			Signature:      []byte("sig"),
+			Nonce:          34,
+		}
+		buff, _ := marshalizer.Marshal(joinMsg)
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + joinTopicSuffix,
+			PeerField:  pid,
+		}
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		require.Nil(t, err)
+		assert.False(t, b.allSupportProtocolVersion([][]byte{otherRelayerPk}, currentProtocolVersion))
+
+		require.Equal(t, 1, len(sentBuffs))
+		sentMsg := &core.SignedMessage{}
+		err = marshalizer.Unmarshal(sentMsg, sentBuffs[0])
+		require.Nil(t, err)
+		assert.Equal(t, legacyMsg, sentMsg)
+	})
+	t.Run("protobuf sign message should be processed", func(t *testing.T) {
+		args := createMockArgsBroadcaster()
+		args.Marshalizer = protoMarshalizer
+		b, _ := NewBroadcaster(args)
+
+		var processedEthSig *core.EthereumSignature
+		_ = b.AddBroadcastClient(&testsCommon.BroadcastClientStub{
+			ProcessNewMessageCalled: func(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+				processedEthSig = ethMsg
+			},
+		})
+
+		ethSig := &core.EthereumSignature{
+			Signature:   []byte("eth sig"),
+			MessageHash: []byte("eth msg"),
+		}
+		payload, _ := protoMarshalizer.Marshal(ethSig)
+		buff, _ := protoMarshalizer.Marshal(&core.SignedMessage{
+			Payload:        payload,
+			PublicKeyBytes: otherRelayerPk,
+			Signature:      []byte("sig"),
+			Nonce:          34,
+		})
+		p2pMsg := &p2pMocks.P2PMessageMock{
+			DataField:  buff,
+			TopicField: args.Name + signTopicSuffix,
+		}
+		err := b.ProcessReceivedMessage(p2pMsg, "", nil)
+		require.Nil(t, err)
+		assert.Equal(t, ethSig, processedEthSig)
+	})
 }
 
 func TestBroadcaster_Close(t *testing.T) {
@@ -52,3 +52,18 @@ var ErrNilBlackListIDsCache = errors.New("nil blacklist cache")
 
 // ErrNilBlackListedPublicKeysCache signals that a nil blacklist public keys cache was provided
 var ErrNilBlackListedPublicKeysCache = errors.New("nil blacklist public keys cache")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilMisbehaviourDetector signals that a nil misbehaviour detector was provided
+var ErrNilMisbehaviourDetector = errors.New("nil misbehaviour detector")
+
+// ErrNilPeerDenialEvaluator signals that a nil peer denial evaluator was provided
+var ErrNilPeerDenialEvaluator = errors.New("nil peer denial evaluator")
+
+// ErrEmptyStaticPeers signals that an empty static peers list was provided
+var ErrEmptyStaticPeers = errors.New("empty static peers list")
+
+// ErrMissingPeerIDInAddress signals that the provided address does not contain the /p2p/<peer ID> component
+var ErrMissingPeerIDInAddress = errors.New("missing peer ID in address")
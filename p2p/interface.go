@@ -3,6 +3,7 @@ package p2p
 import (
 	"time"
 
+	"github.com/multiversx/mx-bridge-eth-go/core"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/p2p"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
@@ -27,6 +28,7 @@ type NetMessenger interface {
 // MultiversXRoleProvider defines the operations for an MultiversX role provider
 type MultiversXRoleProvider interface {
 	IsWhitelisted(address sdkCore.AddressHandler) bool
+	SortedPublicKeys() [][]byte
 	IsInterfaceNil() bool
 }
 
@@ -36,6 +38,13 @@ type SignatureProcessor interface {
 	IsInterfaceNil() bool
 }
 
+// MisbehaviourDetector defines the operations of a component able to detect the relayers misbehaviour
+type MisbehaviourDetector interface {
+	ProcessSignature(msg *core.SignedMessage, ethMsg *core.EthereumSignature, peerID chainCore.PeerID)
+	ProcessLocalSignature(msg *core.SignedMessage, ethMsg *core.EthereumSignature)
+	IsInterfaceNil() bool
+}
+
 // PeerDenialEvaluator defines the behavior of a component that is able to decide if a peer ID is black listed or not
 type PeerDenialEvaluator interface {
 	IsDenied(pid chainCore.PeerID) bool
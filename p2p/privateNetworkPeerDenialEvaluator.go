@@ -0,0 +1,90 @@
+package p2p
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+const peerIDProtocolMarker = "/p2p/"
+
+// ArgsPrivateNetworkPeerDenialEvaluator is the DTO used in the private network peer denial evaluator constructor
+type ArgsPrivateNetworkPeerDenialEvaluator struct {
+	PeerDenialEvaluator PeerDenialEvaluator
+	StaticPeers         []string
+}
+
+type privateNetworkPeerDenialEvaluator struct {
+	peerDenialEvaluator PeerDenialEvaluator
+	allowedPeers        map[chainCore.PeerID]struct{}
+}
+
+// NewPrivateNetworkPeerDenialEvaluator creates a peer denial evaluator that denies all the peers that are not pinned
+// in the static peers list. The provided peer denial evaluator is still consulted for the pinned peers.
+func NewPrivateNetworkPeerDenialEvaluator(args ArgsPrivateNetworkPeerDenialEvaluator) (*privateNetworkPeerDenialEvaluator, error) {
+	if check.IfNil(args.PeerDenialEvaluator) {
+		return nil, ErrNilPeerDenialEvaluator
+	}
+	if len(args.StaticPeers) == 0 {
+		return nil, ErrEmptyStaticPeers
+	}
+
+	allowedPeers := make(map[chainCore.PeerID]struct{})
+	for _, address := range args.StaticPeers {
+		pid, err := ExtractPeerIDFromAddress(address)
+		if err != nil {
+			return nil, err
+		}
+
+		allowedPeers[pid] = struct{}{}
+	}
+
+	return &privateNetworkPeerDenialEvaluator{
+		peerDenialEvaluator: args.PeerDenialEvaluator,
+		allowedPeers:        allowedPeers,
+	}, nil
+}
+
+// ExtractPeerIDFromAddress returns the pinned peer ID from a multiaddress like
+// /ip4/127.0.0.1/tcp/10010/p2p/16Uiu2HAm...
+func ExtractPeerIDFromAddress(address string) (chainCore.PeerID, error) {
+	idx := strings.LastIndex(address, peerIDProtocolMarker)
+	if idx < 0 {
+		return "", fmt.Errorf("%w for address %s", ErrMissingPeerIDInAddress, address)
+	}
+
+	prettyPid := strings.Trim(address[idx+len(peerIDProtocolMarker):], "/")
+	if len(prettyPid) == 0 || strings.Contains(prettyPid, "/") {
+		return "", fmt.Errorf("%w for address %s", ErrMissingPeerIDInAddress, address)
+	}
+
+	pid, err := chainCore.NewPeerID(prettyPid)
+	if err != nil {
+		return "", fmt.Errorf("%w for address %s", err, address)
+	}
+
+	return pid, nil
+}
+
+// IsDenied returns true if the provided peer id is not a pinned peer or if it is denied by the wrapped evaluator
+func (evaluator *privateNetworkPeerDenialEvaluator) IsDenied(pid chainCore.PeerID) bool {
+	_, isAllowed := evaluator.allowedPeers[pid]
+	if !isAllowed {
+		return true
+	}
+
+	return evaluator.peerDenialEvaluator.IsDenied(pid)
+}
+
+// UpsertPeerID will update or insert the provided peer id in the wrapped evaluator
+func (evaluator *privateNetworkPeerDenialEvaluator) UpsertPeerID(pid chainCore.PeerID, duration time.Duration) error {
+	return evaluator.peerDenialEvaluator.UpsertPeerID(pid, duration)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (evaluator *privateNetworkPeerDenialEvaluator) IsInterfaceNil() bool {
+	return evaluator == nil
+}
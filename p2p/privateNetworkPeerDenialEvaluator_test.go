@@ -0,0 +1,155 @@
+package p2p
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const prettyPinnedPid = "16Uiu2HAmAzokH1ozUF52Vy3RKqRfCMr9ZdNDkUQFEkXRs9DqvmKf"
+
+func createMockArgsPrivateNetworkPeerDenialEvaluator() ArgsPrivateNetworkPeerDenialEvaluator {
+	pde, _ := NewPeerDenialEvaluator(&mock.PeerBlackListHandlerStub{}, &testscommon.TimeCacheStub{})
+
+	return ArgsPrivateNetworkPeerDenialEvaluator{
+		PeerDenialEvaluator: pde,
+		StaticPeers:         []string{"/ip4/127.0.0.1/tcp/10010/p2p/" + prettyPinnedPid},
+	}
+}
+
+func TestNewPrivateNetworkPeerDenialEvaluator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil peer denial evaluator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPrivateNetworkPeerDenialEvaluator()
+		args.PeerDenialEvaluator = nil
+
+		evaluator, err := NewPrivateNetworkPeerDenialEvaluator(args)
+		assert.True(t, check.IfNil(evaluator))
+		assert.Equal(t, ErrNilPeerDenialEvaluator, err)
+	})
+	t.Run("empty static peers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPrivateNetworkPeerDenialEvaluator()
+		args.StaticPeers = nil
+
+		evaluator, err := NewPrivateNetworkPeerDenialEvaluator(args)
+		assert.True(t, check.IfNil(evaluator))
+		assert.Equal(t, ErrEmptyStaticPeers, err)
+	})
+	t.Run("static peer without peer ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPrivateNetworkPeerDenialEvaluator()
+		args.StaticPeers = append(args.StaticPeers, "/ip4/127.0.0.1/tcp/10011")
+
+		evaluator, err := NewPrivateNetworkPeerDenialEvaluator(args)
+		assert.True(t, check.IfNil(evaluator))
+		assert.True(t, errors.Is(err, ErrMissingPeerIDInAddress))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPrivateNetworkPeerDenialEvaluator()
+
+		evaluator, err := NewPrivateNetworkPeerDenialEvaluator(args)
+		assert.False(t, check.IfNil(evaluator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestExtractPeerIDFromAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing peer ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		addresses := []string{
+			"/ip4/127.0.0.1/tcp/10010",
+			"/ip4/127.0.0.1/tcp/10010/p2p/",
+			"/ip4/127.0.0.1/tcp/10010/p2p/" + prettyPinnedPid + "/tcp",
+		}
+		for _, address := range addresses {
+			_, err := ExtractPeerIDFromAddress(address)
+			assert.True(t, errors.Is(err, ErrMissingPeerIDInAddress), address)
+		}
+	})
+	t.Run("invalid peer ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ExtractPeerIDFromAddress("/ip4/127.0.0.1/tcp/10010/p2p/invalid0OIl")
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pid, err := ExtractPeerIDFromAddress("/ip4/127.0.0.1/tcp/10010/p2p/" + prettyPinnedPid)
+		require.Nil(t, err)
+		assert.Equal(t, prettyPinnedPid, pid.Pretty())
+	})
+}
+
+func TestPrivateNetworkPeerDenialEvaluator_IsDenied(t *testing.T) {
+	t.Parallel()
+
+	pinnedPid, _ := chainCore.NewPeerID(prettyPinnedPid)
+
+	t.Run("not pinned peer should be denied", func(t *testing.T) {
+		t.Parallel()
+
+		evaluator, _ := NewPrivateNetworkPeerDenialEvaluator(createMockArgsPrivateNetworkPeerDenialEvaluator())
+		assert.True(t, evaluator.IsDenied("not pinned"))
+	})
+	t.Run("pinned peer should not be denied", func(t *testing.T) {
+		t.Parallel()
+
+		evaluator, _ := NewPrivateNetworkPeerDenialEvaluator(createMockArgsPrivateNetworkPeerDenialEvaluator())
+		assert.False(t, evaluator.IsDenied(pinnedPid))
+	})
+	t.Run("pinned but blacklisted peer should be denied", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPrivateNetworkPeerDenialEvaluator()
+		args.PeerDenialEvaluator, _ = NewPeerDenialEvaluator(
+			&mock.PeerBlackListHandlerStub{
+				HasCalled: func(pid chainCore.PeerID) bool {
+					return pid == pinnedPid
+				},
+			},
+			&testscommon.TimeCacheStub{},
+		)
+		evaluator, _ := NewPrivateNetworkPeerDenialEvaluator(args)
+		assert.True(t, evaluator.IsDenied(pinnedPid))
+	})
+}
+
+func TestPrivateNetworkPeerDenialEvaluator_UpsertPeerID(t *testing.T) {
+	t.Parallel()
+
+	upsertCalled := false
+	args := createMockArgsPrivateNetworkPeerDenialEvaluator()
+	args.PeerDenialEvaluator, _ = NewPeerDenialEvaluator(
+		&mock.PeerBlackListHandlerStub{
+			UpsertCalled: func(pid chainCore.PeerID, span time.Duration) error {
+				upsertCalled = true
+				return nil
+			},
+		},
+		&testscommon.TimeCacheStub{},
+	)
+	evaluator, _ := NewPrivateNetworkPeerDenialEvaluator(args)
+
+	err := evaluator.UpsertPeerID("pid", time.Second)
+	assert.Nil(t, err)
+	assert.True(t, upsertCalled)
+}
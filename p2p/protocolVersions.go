@@ -0,0 +1,48 @@
+package p2p
+
+import (
+	"sync"
+)
+
+const (
+	// legacyProtocolVersion is the version of the relayers that only understand the JSON wire format
+	legacyProtocolVersion = uint32(1)
+	// currentProtocolVersion is the version of the relayers that understand the configured (protobuf) wire format
+	currentProtocolVersion = uint32(2)
+)
+
+type protocolVersionsOfPublicKeys struct {
+	mut      sync.RWMutex
+	versions map[string]uint32
+}
+
+func newProtocolVersionsOfPublicKeys() *protocolVersionsOfPublicKeys {
+	return &protocolVersionsOfPublicKeys{
+		versions: make(map[string]uint32),
+	}
+}
+
+func (holder *protocolVersionsOfPublicKeys) setProtocolVersion(publicKey []byte, version uint32) {
+	holder.mut.Lock()
+	holder.versions[string(publicKey)] = version
+	holder.mut.Unlock()
+}
+
+// allSupportProtocolVersion returns true if all provided public keys advertised at least the provided version.
+// An empty list is not considered as supporting the version
+func (holder *protocolVersionsOfPublicKeys) allSupportProtocolVersion(publicKeys [][]byte, version uint32) bool {
+	if len(publicKeys) == 0 {
+		return false
+	}
+
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	for _, pk := range publicKeys {
+		if holder.versions[string(pk)] < version {
+			return false
+		}
+	}
+
+	return true
+}
@@ -15,7 +15,13 @@ import (
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
 )
 
-const absolutMaxSliceSize = 1024
+const (
+	absolutMaxSliceSize = 1024
+	legacyFormatMarker  = byte('{')
+)
+
+// legacyMarshalizer is the marshalizer used by the relayers that did not advertise any protocol version
+var legacyMarshalizer = &marshal.JsonMarshalizer{}
 
 type relayerMessageHandler struct {
 	marshalizer         marshal.Marshalizer
@@ -46,11 +52,9 @@ func (rmh *relayerMessageHandler) canProcessMessage(message p2p.MessageP2P, from
 // preProcessMessage is able to preprocess the received p2p message
 func (rmh *relayerMessageHandler) preProcessMessage(message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID) (*core.SignedMessage, error) {
 	msg := &core.SignedMessage{}
-	err := rmh.marshalizer.Unmarshal(msg, message.Data())
+	err := rmh.unmarshal(msg, message.Data())
 	if err != nil {
-		reason := "unmarshalable data got on request topic " + message.Topic()
-		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(message.Peer(), reason, common.InvalidMessageBlacklistDuration)
-		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(fromConnectedPeer, reason, common.InvalidMessageBlacklistDuration)
+		rmh.blacklistPeers(message, fromConnectedPeer, "unmarshalable data got on request topic "+message.Topic())
 		return nil, err
 	}
 
@@ -70,15 +74,19 @@ func (rmh *relayerMessageHandler) preProcessMessage(message p2p.MessageP2P, from
 
 	err = rmh.singleSigner.Verify(pk, msgWithNonce, msg.Signature)
 	if err != nil {
-		reason := "unverifiable signature on request topic " + message.Topic()
-		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(message.Peer(), reason, common.InvalidMessageBlacklistDuration)
-		rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(fromConnectedPeer, reason, common.InvalidMessageBlacklistDuration)
+		rmh.blacklistPeers(message, fromConnectedPeer, "unverifiable signature on request topic "+message.Topic())
 		return nil, err
 	}
 
 	return msg, nil
 }
 
+// blacklistPeers will blacklist both the originator and the connected peer that relayed the message
+func (rmh *relayerMessageHandler) blacklistPeers(message p2p.MessageP2P, fromConnectedPeer chainCore.PeerID, reason string) {
+	rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(message.Peer(), reason, common.InvalidMessageBlacklistDuration)
+	rmh.antifloodComponents.AntiFloodHandler.BlacklistPeer(fromConnectedPeer, reason, common.InvalidMessageBlacklistDuration)
+}
+
 func checkLengths(msg *core.SignedMessage) error {
 	if len(msg.PublicKeyBytes) > absolutMaxSliceSize {
 		return fmt.Errorf("%w for PublicKeyBytes field", ErrInvalidSize)
@@ -113,3 +121,27 @@ func (rmh *relayerMessageHandler) createMessage(payload []byte) (*core.SignedMes
 		Nonce:          nonce,
 	}, nil
 }
+
+// unmarshal will decode the provided buffer using the legacy (JSON) marshalizer if the buffer is a JSON object,
+// otherwise the configured marshalizer is used. A valid protobuf message will never start with the '{' byte as it
+// would translate into the start group wire type of the 15th field
+func (rmh *relayerMessageHandler) unmarshal(obj interface{}, buff []byte) error {
+	if isLegacyFormat(buff) {
+		return legacyMarshalizer.Unmarshal(obj, buff)
+	}
+
+	return rmh.marshalizer.Unmarshal(obj, buff)
+}
+
+func isLegacyFormat(buff []byte) bool {
+	return len(buff) > 0 && buff[0] == legacyFormatMarker
+}
+
+// marshalizerForProtocolVersion returns the marshalizer that can be understood by a relayer with the provided version
+func (rmh *relayerMessageHandler) marshalizerForProtocolVersion(version uint32) marshal.Marshalizer {
+	if version >= currentProtocolVersion {
+		return rmh.marshalizer
+	}
+
+	return legacyMarshalizer
+}
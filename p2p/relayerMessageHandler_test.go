@@ -14,6 +14,7 @@ import (
 	cryptoMocks "github.com/multiversx/mx-bridge-eth-go/testsCommon/crypto"
 	p2pMocks "github.com/multiversx/mx-bridge-eth-go/testsCommon/p2p"
 	chainCore "github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process/mock"
@@ -120,6 +121,7 @@ func TestRelayerMessageHandler_preProcess(t *testing.T) {
 	t.Run("preProcess errors if keygen fails", preProcessKeygenFails)
 	t.Run("preProcess errors if verify fails", preProcessVerifyFails)
 	t.Run("preProcess should work", preProcessShouldWork)
+	t.Run("preProcess with protobuf message should work", preProcessProtobufShouldWork)
 }
 
 func preProcessUnmarshal(t *testing.T) {
@@ -292,6 +294,41 @@ func preProcessShouldWork(t *testing.T) {
 	assert.True(t, verifyCalled)
 }
 
+func preProcessProtobufShouldWork(t *testing.T) {
+	protoMarshalizer := &marshal.GogoProtoMarshalizer{}
+	originalMsg := &core.SignedMessage{
+		Payload:        []byte("payload"),
+		PublicKeyBytes: []byte("pk"),
+		Signature:      []byte("sig"),
+		Nonce:          34,
+	}
+	buff, _ := protoMarshalizer.Marshal(originalMsg)
+	require.False(t, isLegacyFormat(buff))
+
+	rmh := &relayerMessageHandler{
+		marshalizer:  protoMarshalizer,
+		singleSigner: &cryptoMocks.SingleSignerStub{},
+		keyGen:       &cryptoMocks.KeyGenStub{},
+	}
+
+	p2pmsg := &p2pMocks.P2PMessageMock{
+		DataField: buff,
+	}
+
+	msg, err := rmh.preProcessMessage(p2pmsg, fromPeer)
+	assert.Equal(t, originalMsg, msg)
+	assert.Nil(t, err)
+
+	// legacy messages are still accepted
+	_, buff = createSignedMessageAndMarshaledBytes(0)
+	p2pmsg = &p2pMocks.P2PMessageMock{
+		DataField: buff,
+	}
+	msg, err = rmh.preProcessMessage(p2pmsg, fromPeer)
+	assert.NotNil(t, msg)
+	assert.Nil(t, err)
+}
+
 func TestRelayerMessageHandler_createMessage(t *testing.T) {
 	t.Parallel()
 
@@ -13,4 +13,7 @@ var (
 	errBufferTooShortForMvxAddress = errors.New("buffer too short for MultiversX address")
 	errBufferTooShortForBigInt     = errors.New("buffer too short while extracting the big.Int value")
 	errBufferLenMismatch           = errors.New("buffer length mismatch")
+	errTrailingBytes               = errors.New("unexpected trailing bytes")
+
+	errUnexpectedDataForMissingMarker = errors.New("unexpected call data for the missing data protocol indicator")
 )
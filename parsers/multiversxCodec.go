@@ -17,30 +17,120 @@ const lenMvxAddress = 32
 type MultiversxCodec struct {
 }
 
+// markerPosition is the position of the first byte after the protocol marker in the raw call data
+const markerPosition = 1
+
 func partiallyDecodeCallData(buff []byte, marker byte) (CallData, error) {
+	_, callData, err := decodeCallDataHeader(buff, marker)
+
+	return callData, err
+}
+
+// decodeCallDataHeader decodes the length of the call data, the function and the gas limit. The provided buffer must
+// not contain the protocol marker. It returns the buffer remaining after the gas limit
+func decodeCallDataHeader(buff []byte, marker byte) ([]byte, CallData, error) {
+	tracker := newPositionTracker(buff, markerPosition)
+
+	position := tracker.position(buff)
 	buff, numChars, err := ExtractUint32(buff)
 	if err != nil {
-		return CallData{}, fmt.Errorf("%w for len of call data", err)
+		return nil, CallData{}, fmt.Errorf("%w for len of call data %s", err, position)
 	}
 	if numChars != len(buff) {
-		return CallData{}, fmt.Errorf("%w: actual %d, declared %d", errBufferLenMismatch, len(buff), numChars)
+		return nil, CallData{}, fmt.Errorf("%w: actual %d, declared %d", errBufferLenMismatch, len(buff), numChars)
 	}
 
+	position = tracker.position(buff)
 	buff, function, err := ExtractString(buff)
 	if err != nil {
-		return CallData{}, fmt.Errorf("%w for function", err)
+		return nil, CallData{}, fmt.Errorf("%w for function %s", err, position)
 	}
 
-	_, gasLimit, err := ExtractUint64(buff)
+	position = tracker.position(buff)
+	buff, gasLimit, err := ExtractUint64(buff)
 	if err != nil {
-		return CallData{}, fmt.Errorf("%w for gas limit", err)
+		return nil, CallData{}, fmt.Errorf("%w for gas limit %s", err, position)
 	}
 
-	return CallData{
+	callData := CallData{
 		Type:     marker,
 		Function: function,
 		GasLimit: gasLimit,
-	}, nil
+	}
+
+	return buff, callData, nil
+}
+
+// decodeArguments decodes the arguments marker, the number of arguments and each argument. The whole buffer
+// should be consumed
+func decodeArguments(buff []byte, tracker *positionTracker) ([]string, error) {
+	position := tracker.position(buff)
+	if len(buff) == 0 {
+		return nil, fmt.Errorf("%w for arguments %s", errBufferTooShortForMarker, position)
+	}
+
+	marker := buff[0]
+	buff = buff[1:]
+	switch marker {
+	case bridgeCore.MissingDataProtocolMarker:
+		if len(buff) > 0 {
+			return nil, fmt.Errorf("%w: %d %s", errTrailingBytes, len(buff), tracker.position(buff))
+		}
+
+		return make([]string, 0), nil
+	case bridgeCore.DataPresentProtocolMarker:
+	default:
+		return nil, fmt.Errorf("%w for arguments: %d %s", errUnexpectedMarker, marker, position)
+	}
+
+	position = tracker.position(buff)
+	buff, numArguments, err := ExtractUint32(buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the number of arguments %s", err, position)
+	}
+
+	// the number of arguments is not trusted for the preallocation, each argument needs at least its 4 bytes length
+	maxNumArguments := len(buff) / bridgeCore.Uint32ArgBytes
+	arguments := make([]string, 0, minInt(numArguments, maxNumArguments))
+	for i := 0; i < numArguments; i++ {
+		position = tracker.position(buff)
+		var argument string
+		buff, argument, err = ExtractString(buff)
+		if err != nil {
+			return nil, fmt.Errorf("%w for argument %d %s", err, i, position)
+		}
+
+		arguments = append(arguments, argument)
+	}
+
+	if len(buff) > 0 {
+		return nil, fmt.Errorf("%w: %d %s", errTrailingBytes, len(buff), tracker.position(buff))
+	}
+
+	return arguments, nil
+}
+
+func minInt(a int, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// positionTracker computes the position of a sub-slice in the raw call data, used for precise error messages
+type positionTracker struct {
+	totalLen int
+}
+
+func newPositionTracker(buff []byte, offset int) *positionTracker {
+	return &positionTracker{
+		totalLen: len(buff) + offset,
+	}
+}
+
+func (tracker *positionTracker) position(remaining []byte) string {
+	return fmt.Sprintf("at position %d", tracker.totalLen-len(remaining))
 }
 
 // ExtractString will return the string value after extracting the length of the string from the buffer.
@@ -170,6 +260,112 @@ func (codec *MultiversxCodec) ExtractGasLimitFromRawCallData(buff []byte) (uint6
 	return callData.GasLimit, nil
 }
 
+// ExtractCallDataFromRawCallData will try to extract the function and the gas limit from the provided buffer.
+// The arguments are not decoded. A buffer marked as missing data will return an empty CallData
+func (codec *MultiversxCodec) ExtractCallDataFromRawCallData(buff []byte) (CallData, error) {
+	if len(buff) == 0 {
+		return CallData{}, errBufferTooShortForMarker
+	}
+
+	marker := buff[0]
+	buff = buff[1:]
+
+	switch marker {
+	case bridgeCore.MissingDataProtocolMarker:
+		return CallData{
+			Type: marker,
+		}, nil
+	case bridgeCore.DataPresentProtocolMarker:
+		return partiallyDecodeCallData(buff, marker)
+	default:
+		return CallData{}, fmt.Errorf("%w: %d", errUnexpectedMarker, marker)
+	}
+}
+
+// DecodeCallData will try to fully decode the provided raw call data, including the arguments. A buffer marked as
+// missing data will return an empty CallData. The errors contain the position of the malformed field
+func (codec *MultiversxCodec) DecodeCallData(buff []byte) (CallData, error) {
+	if len(buff) == 0 {
+		return CallData{}, errBufferTooShortForMarker
+	}
+
+	marker := buff[0]
+	buff = buff[1:]
+
+	switch marker {
+	case bridgeCore.MissingDataProtocolMarker:
+		return CallData{
+			Type: marker,
+		}, nil
+	case bridgeCore.DataPresentProtocolMarker:
+	default:
+		return CallData{}, fmt.Errorf("%w: %d at position 0", errUnexpectedMarker, marker)
+	}
+
+	tracker := newPositionTracker(buff, markerPosition)
+	buff, callData, err := decodeCallDataHeader(buff, marker)
+	if err != nil {
+		return CallData{}, err
+	}
+
+	callData.Arguments, err = decodeArguments(buff, tracker)
+	if err != nil {
+		return CallData{}, err
+	}
+
+	return callData, nil
+}
+
+// EncodeCallData will encode the provided call data, including the protocol marker and the length of the call data.
+// A call data of type MissingDataProtocolMarker should not contain any other field
+func (codec *MultiversxCodec) EncodeCallData(callData CallData) ([]byte, error) {
+	switch callData.Type {
+	case bridgeCore.MissingDataProtocolMarker:
+		if len(callData.Function) > 0 || callData.GasLimit > 0 || len(callData.Arguments) > 0 {
+			return nil, errUnexpectedDataForMissingMarker
+		}
+
+		return []byte{bridgeCore.MissingDataProtocolMarker}, nil
+	case bridgeCore.DataPresentProtocolMarker:
+	default:
+		return nil, fmt.Errorf("%w: %d", errUnexpectedMarker, callData.Type)
+	}
+
+	callDataBuff := codec.EncodeCallDataStrict(callData)
+
+	result := make([]byte, 0, 1+bridgeCore.Uint32ArgBytes+len(callDataBuff))
+	result = append(result, bridgeCore.DataPresentProtocolMarker)
+	result = binary.BigEndian.AppendUint32(result, uint32(len(callDataBuff)))
+	result = append(result, callDataBuff...)
+
+	return result, nil
+}
+
+// EncodeCallDataStrict will encode just the function, the gas limit and the arguments. No length or protocol marker
+// will be added
+func (codec *MultiversxCodec) EncodeCallDataStrict(callData CallData) []byte {
+	result := make([]byte, 0)
+
+	result = binary.BigEndian.AppendUint32(result, uint32(len(callData.Function)))
+	result = append(result, callData.Function...)
+	result = binary.BigEndian.AppendUint64(result, callData.GasLimit)
+
+	if len(callData.Arguments) == 0 {
+		// in case of no arguments, the contract requires that the missing data protocol marker should be provided, not
+		// a 0 encoded on 4 bytes.
+		return append(result, bridgeCore.MissingDataProtocolMarker)
+	}
+
+	result = append(result, bridgeCore.DataPresentProtocolMarker)
+	result = binary.BigEndian.AppendUint32(result, uint32(len(callData.Arguments)))
+	for _, arg := range callData.Arguments {
+		result = binary.BigEndian.AppendUint32(result, uint32(len(arg)))
+		result = append(result, arg...)
+	}
+
+	return result
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (codec *MultiversxCodec) IsInterfaceNil() bool {
 	return codec == nil
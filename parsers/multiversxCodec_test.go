@@ -3,6 +3,7 @@ package parsers
 import (
 	"bytes"
 	"encoding/hex"
+	"math"
 	"math/big"
 	"testing"
 
@@ -149,6 +150,368 @@ func TestMultiversxCodec_ExtractGasLimitFromRawCallData(t *testing.T) {
 	})
 }
 
+func TestMultiversxCodec_ExtractCallDataFromRawCallData(t *testing.T) {
+	t.Parallel()
+
+	codec := &MultiversxCodec{}
+
+	t.Run("empty buffer should error", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.ExtractCallDataFromRawCallData(nil)
+		assert.Equal(t, errBufferTooShortForMarker, err)
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("unexpected marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.ExtractCallDataFromRawCallData([]byte{0x03})
+		assert.ErrorIs(t, err, errUnexpectedMarker)
+		assert.Contains(t, err.Error(), ": 3")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("malformed call data should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 4,
+			0, 0, 0, 5}
+
+		callData, err := codec.ExtractCallDataFromRawCallData(buff)
+		assert.ErrorIs(t, err, errBufferTooShortForString)
+		assert.Contains(t, err.Error(), "for function")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("missing data marker should return empty call data", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.ExtractCallDataFromRawCallData([]byte{0})
+		assert.Nil(t, err)
+		assert.Equal(t, CallData{Type: 0}, callData)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 15,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 1, 2, 3, 4, 5, 6, // gas limit is 1108152157446
+		}
+
+		callData, err := codec.ExtractCallDataFromRawCallData(buff)
+		assert.Nil(t, err)
+		expectedCallData := CallData{
+			Type:     1,
+			Function: "abc",
+			GasLimit: 1108152157446,
+		}
+		assert.Equal(t, expectedCallData, callData)
+	})
+}
+
+func TestMultiversxCodec_DecodeCallData(t *testing.T) {
+	t.Parallel()
+
+	codec := &MultiversxCodec{}
+
+	t.Run("empty buffer should error", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.DecodeCallData(nil)
+		assert.Equal(t, errBufferTooShortForMarker, err)
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("unexpected marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.DecodeCallData([]byte{0x03})
+		assert.ErrorIs(t, err, errUnexpectedMarker)
+		assert.Contains(t, err.Error(), ": 3 at position 0")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("missing data marker should return empty call data", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.DecodeCallData([]byte{0})
+		assert.Nil(t, err)
+		assert.Equal(t, CallData{Type: 0}, callData)
+	})
+	t.Run("buffer to short for call data length should error", func(t *testing.T) {
+		t.Parallel()
+
+		callData, err := codec.DecodeCallData([]byte{1, 0, 0})
+		assert.ErrorIs(t, err, errBufferTooShortForUint32)
+		assert.Contains(t, err.Error(), "for len of call data at position 1")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("buffer to short for gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 14,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, // malformed gas limit (7 bytes for an uint64)
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errBufferTooShortForUint64)
+		assert.Contains(t, err.Error(), "for gas limit at position 12")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("missing arguments marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 15,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, 5,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errBufferTooShortForMarker)
+		assert.Contains(t, err.Error(), "for arguments at position 20")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("unexpected arguments marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 16,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, 5,
+			2,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errUnexpectedMarker)
+		assert.Contains(t, err.Error(), "for arguments: 2 at position 20")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("trailing bytes after the missing arguments marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 17,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, 5,
+			0, 7,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errTrailingBytes)
+		assert.Contains(t, err.Error(), ": 1 at position 21")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("buffer to short for the number of arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 18,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, 5,
+			1, 0, 0,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errBufferTooShortForUint32)
+		assert.Contains(t, err.Error(), "for the number of arguments at position 21")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("buffer to short for an argument should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 30,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, 5,
+			1, 0, 0, 0, 2,
+			0, 0, 0, 1, 'd',
+			0, 0, 0, 2, 'e',
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errBufferTooShortForString)
+		assert.Contains(t, err.Error(), "for argument 1 at position 30")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("huge number of arguments should error without preallocating", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 17,
+			0, 0, 0, 0,
+			0, 0, 0, 0, 0, 0, 0, 5,
+			1, 0xFF, 0xFF, 0xFF, 0xFF,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errBufferTooShortForLength)
+		assert.Contains(t, err.Error(), "for argument 0 at position 22")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("trailing bytes after the arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 27,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, 5,
+			1, 0, 0, 0, 1,
+			0, 0, 0, 1, 'd',
+			9, 9,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.ErrorIs(t, err, errTrailingBytes)
+		assert.Contains(t, err.Error(), ": 2 at position 30")
+		assert.Equal(t, CallData{}, callData)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff := []byte{
+			1,
+			0, 0, 0, 29,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, 5,
+			1, 0, 0, 0, 2,
+			0, 0, 0, 1, 'd',
+			0, 0, 0, 0,
+		}
+
+		callData, err := codec.DecodeCallData(buff)
+		assert.Nil(t, err)
+		expectedCallData := CallData{
+			Type:      1,
+			Function:  "abc",
+			GasLimit:  5,
+			Arguments: []string{"d", ""},
+		}
+		assert.Equal(t, expectedCallData, callData)
+	})
+}
+
+func TestMultiversxCodec_EncodeCallData(t *testing.T) {
+	t.Parallel()
+
+	codec := &MultiversxCodec{}
+
+	t.Run("unexpected marker should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := codec.EncodeCallData(CallData{Type: 3})
+		assert.ErrorIs(t, err, errUnexpectedMarker)
+		assert.Nil(t, buff)
+	})
+	t.Run("missing data marker with call data should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := codec.EncodeCallData(CallData{Type: 0, Function: "abc"})
+		assert.Equal(t, errUnexpectedDataForMissingMarker, err)
+		assert.Nil(t, buff)
+	})
+	t.Run("missing data marker should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := codec.EncodeCallData(CallData{Type: 0})
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{0}, buff)
+	})
+	t.Run("no arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := codec.EncodeCallData(CallData{
+			Type:     1,
+			Function: "abc",
+			GasLimit: 5,
+		})
+		assert.Nil(t, err)
+		expectedBuff := []byte{
+			1,
+			0, 0, 0, 16,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, 5,
+			0,
+		}
+		assert.Equal(t, expectedBuff, buff)
+	})
+	t.Run("with arguments should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := codec.EncodeCallData(CallData{
+			Type:      1,
+			Function:  "abc",
+			GasLimit:  5,
+			Arguments: []string{"d", ""},
+		})
+		assert.Nil(t, err)
+		expectedBuff := []byte{
+			1,
+			0, 0, 0, 29,
+			0, 0, 0, 3, 'a', 'b', 'c',
+			0, 0, 0, 0, 0, 0, 0, 5,
+			1, 0, 0, 0, 2,
+			0, 0, 0, 1, 'd',
+			0, 0, 0, 0,
+		}
+		assert.Equal(t, expectedBuff, buff)
+	})
+}
+
+func TestMultiversxCodec_EncodeDecodeRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	codec := &MultiversxCodec{}
+	testCases := []CallData{
+		{
+			Type: 0,
+		},
+		{
+			Type:      1,
+			Function:  "",
+			GasLimit:  0,
+			Arguments: make([]string, 0),
+		},
+		{
+			Type:      1,
+			Function:  "callMe",
+			GasLimit:  50000000,
+			Arguments: make([]string, 0),
+		},
+		{
+			Type:      1,
+			Function:  "callMe",
+			GasLimit:  math.MaxUint64,
+			Arguments: []string{"arg1", "", string([]byte{0, 1, 2, 255})},
+		},
+	}
+
+	for _, callData := range testCases {
+		buff, err := codec.EncodeCallData(callData)
+		require.Nil(t, err)
+
+		decoded, err := codec.DecodeCallData(buff)
+		require.Nil(t, err)
+		assert.Equal(t, callData, decoded)
+
+		if callData.Type == 1 {
+			gasLimit, errExtract := codec.ExtractGasLimitFromRawCallData(buff)
+			require.Nil(t, errExtract)
+			assert.Equal(t, callData.GasLimit, gasLimit)
+		}
+	}
+}
+
 func TestMultiversxCodec_DecodeProxySCCompleteCallData(t *testing.T) {
 	t.Parallel()
 
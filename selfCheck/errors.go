@@ -0,0 +1,27 @@
+package selfCheck
+
+import "errors"
+
+// ErrNilLogger signals that a nil logger was provided
+var ErrNilLogger = errors.New("nil logger")
+
+// ErrNilEthereumClientWrapper signals that a nil Ethereum client wrapper was provided
+var ErrNilEthereumClientWrapper = errors.New("nil Ethereum client wrapper")
+
+// ErrNilEthContractsVersionHandler signals that a nil Ethereum contracts version handler was provided
+var ErrNilEthContractsVersionHandler = errors.New("nil Ethereum contracts version handler")
+
+// ErrNilMultiversXDataGetter signals that a nil MultiversX data getter was provided
+var ErrNilMultiversXDataGetter = errors.New("nil MultiversX data getter")
+
+// ErrNilMultiversXProxy signals that a nil MultiversX proxy was provided
+var ErrNilMultiversXProxy = errors.New("nil MultiversX proxy")
+
+// ErrNilAddressHandler signals that a nil address handler was provided
+var ErrNilAddressHandler = errors.New("nil address handler")
+
+// ErrNilNTPQueryHandler signals that a nil NTP query handler was provided
+var ErrNilNTPQueryHandler = errors.New("nil NTP query handler")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
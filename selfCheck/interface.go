@@ -0,0 +1,36 @@
+package selfCheck
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// EthereumClientWrapper defines the Ethereum chain operations used by the self-checker
+type EthereumClientWrapper interface {
+	ChainID(ctx context.Context) (*big.Int, error)
+	GetRelayers(ctx context.Context) ([]common.Address, error)
+	Quorum(ctx context.Context) (*big.Int, error)
+	IsPaused(ctx context.Context) (bool, error)
+	WhitelistedTokens(ctx context.Context, token common.Address) (bool, error)
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXDataGetter defines the MultiversX contracts views used by the self-checker
+type MultiversXDataGetter interface {
+	GetQuorum(ctx context.Context) (uint64, error)
+	IsPaused(ctx context.Context) (bool, error)
+	GetLastMvxBatchID(ctx context.Context) (uint64, error)
+	GetAllStakedRelayers(ctx context.Context) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// MultiversXProxy defines the MultiversX proxy operations used by the self-checker
+type MultiversXProxy interface {
+	GetAccount(ctx context.Context, address core.AddressHandler) (*data.Account, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,20 @@
+package selfCheck
+
+import (
+	"time"
+
+	"github.com/beevik/ntp"
+)
+
+// QueryNTPClockOffset queries the provided NTP host and returns the offset of the local clock
+func QueryNTPClockOffset(host string, port int, timeout time.Duration) (time.Duration, error) {
+	response, err := ntp.QueryWithOptions(host, ntp.QueryOptions{
+		Timeout: timeout,
+		Port:    port,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return response.ClockOffset, nil
+}
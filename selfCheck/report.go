@@ -0,0 +1,70 @@
+package selfCheck
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CheckStatus defines the outcome of a single check
+type CheckStatus string
+
+const (
+	// StatusOK signals that the check passed
+	StatusOK CheckStatus = "OK"
+	// StatusWarning signals a problem that does not prevent the relayer from working
+	StatusWarning CheckStatus = "WARN"
+	// StatusFailed signals a problem that prevents the relayer from working
+	StatusFailed CheckStatus = "FAIL"
+)
+
+// CheckResult holds the outcome of a single check
+type CheckResult struct {
+	Name    string
+	Status  CheckStatus
+	Details string
+}
+
+// Report holds the outcome of all the checks
+type Report struct {
+	Results []CheckResult
+}
+
+// NumFailed returns the number of failed checks
+func (report *Report) NumFailed() int {
+	return report.numWithStatus(StatusFailed)
+}
+
+// NumWarnings returns the number of checks that ended with a warning
+func (report *Report) NumWarnings() int {
+	return report.numWithStatus(StatusWarning)
+}
+
+func (report *Report) numWithStatus(status CheckStatus) int {
+	num := 0
+	for _, result := range report.Results {
+		if result.Status == status {
+			num++
+		}
+	}
+
+	return num
+}
+
+// String returns the human-readable form of the report, one line for each check
+func (report *Report) String() string {
+	maxNameLen := 0
+	for _, result := range report.Results {
+		if len(result.Name) > maxNameLen {
+			maxNameLen = len(result.Name)
+		}
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString("relayer self-check report:\n")
+	for _, result := range report.Results {
+		builder.WriteString(fmt.Sprintf("  %-6s %-*s  %s\n", "["+string(result.Status)+"]", maxNameLen, result.Name, result.Details))
+	}
+	builder.WriteString(fmt.Sprintf("%d check(s), %d failed, %d warning(s)", len(report.Results), report.NumFailed(), report.NumWarnings()))
+
+	return builder.String()
+}
@@ -0,0 +1,29 @@
+package selfCheck
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	report := &Report{
+		Results: []CheckResult{
+			{Name: "first", Status: StatusOK, Details: "all good"},
+			{Name: "second check", Status: StatusFailed, Details: "broken"},
+			{Name: "third", Status: StatusWarning, Details: "not great"},
+		},
+	}
+
+	assert.Equal(t, 1, report.NumFailed())
+	assert.Equal(t, 1, report.NumWarnings())
+
+	expected := "relayer self-check report:\n" +
+		"  [OK]   first         all good\n" +
+		"  [FAIL] second check  broken\n" +
+		"  [WARN] third         not great\n" +
+		"3 check(s), 1 failed, 1 warning(s)"
+	assert.Equal(t, expected, report.String())
+}
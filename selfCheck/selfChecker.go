@@ -0,0 +1,415 @@
+package selfCheck
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+const (
+	minTimeoutInSeconds      = 1
+	minNTPTimeoutInMillis    = 1
+	nativeTokenDecimals      = 18
+	randomP2PPort            = "0"
+	p2pPortRangeSeparator    = "-"
+	ethChainIDCheck          = "Ethereum chain ID"
+	ethContractsVersionCheck = "Ethereum contracts version"
+	ethMultisigCheck         = "Ethereum multisig contract"
+	ethSafeCheck             = "Ethereum safe contract"
+	ethRelayerCheck          = "Ethereum relayer whitelisted"
+	ethFundsCheck            = "Ethereum relayer funds"
+	multiversXMultisigCheck  = "MultiversX multisig contract"
+	multiversXSafeCheck      = "MultiversX safe contract"
+	multiversXRelayerCheck   = "MultiversX relayer staked"
+	multiversXFundsCheck     = "MultiversX relayer funds"
+	ntpCheck                 = "NTP"
+	p2pPortCheck             = "P2P port"
+	notEnoughFundsForTxsInfo = "the relayer can not pay the transactions fees"
+)
+
+// NTPQueryHandler returns the local clock offset reported by the provided NTP host
+type NTPQueryHandler func(host string, port int, timeout time.Duration) (time.Duration, error)
+
+// EthContractsVersionHandler returns the configured Ethereum contracts version or detects it from the deployed contracts
+type EthContractsVersionHandler func(ctx context.Context) (bridgeCore.ContractsVersion, error)
+
+// ArgsSelfChecker is the DTO used in the self-checker constructor
+type ArgsSelfChecker struct {
+	Log                        logger.Logger
+	Config                     config.SelfCheckConfig
+	Chain                      chain.Chain
+	EthClientWrapper           EthereumClientWrapper
+	EthRelayerAddress          common.Address
+	EthContractsVersionHandler EthContractsVersionHandler
+	MultiversXDataGetter       MultiversXDataGetter
+	MultiversXProxy            MultiversXProxy
+	MultiversXRelayerAddress   core.AddressHandler
+	P2PPort                    string
+	NTPQueryHandler            NTPQueryHandler
+}
+
+type selfChecker struct {
+	log                        logger.Logger
+	config                     config.SelfCheckConfig
+	chain                      chain.Chain
+	ethClientWrapper           EthereumClientWrapper
+	ethRelayerAddress          common.Address
+	ethContractsVersionHandler EthContractsVersionHandler
+	multiversXDataGetter       MultiversXDataGetter
+	multiversXProxy            MultiversXProxy
+	multiversXRelayerAddress   core.AddressHandler
+	p2pPort                    string
+	ntpQueryHandler            NTPQueryHandler
+}
+
+// NewSelfChecker creates a new instance of the self-checker. It verifies that the configured chains, contracts
+// and relayer accounts match what is deployed and that the local environment allows the relayer to run
+func NewSelfChecker(args ArgsSelfChecker) (*selfChecker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &selfChecker{
+		log:                        args.Log,
+		config:                     args.Config,
+		chain:                      args.Chain,
+		ethClientWrapper:           args.EthClientWrapper,
+		ethRelayerAddress:          args.EthRelayerAddress,
+		ethContractsVersionHandler: args.EthContractsVersionHandler,
+		multiversXDataGetter:       args.MultiversXDataGetter,
+		multiversXProxy:            args.MultiversXProxy,
+		multiversXRelayerAddress:   args.MultiversXRelayerAddress,
+		p2pPort:                    args.P2PPort,
+		ntpQueryHandler:            args.NTPQueryHandler,
+	}, nil
+}
+
+func checkArgs(args ArgsSelfChecker) error {
+	if check.IfNil(args.Log) {
+		return ErrNilLogger
+	}
+	if check.IfNil(args.EthClientWrapper) {
+		return ErrNilEthereumClientWrapper
+	}
+	if args.EthContractsVersionHandler == nil {
+		return ErrNilEthContractsVersionHandler
+	}
+	if check.IfNil(args.MultiversXDataGetter) {
+		return ErrNilMultiversXDataGetter
+	}
+	if check.IfNil(args.MultiversXProxy) {
+		return ErrNilMultiversXProxy
+	}
+	if check.IfNil(args.MultiversXRelayerAddress) {
+		return fmt.Errorf("%w for the MultiversXRelayerAddress argument", ErrNilAddressHandler)
+	}
+	if args.NTPQueryHandler == nil {
+		return ErrNilNTPQueryHandler
+	}
+	if args.Config.TimeoutInSeconds < minTimeoutInSeconds {
+		return fmt.Errorf("%w for TimeoutInSeconds, got %d, minimum %d",
+			ErrInvalidValue, args.Config.TimeoutInSeconds, minTimeoutInSeconds)
+	}
+	if len(args.Config.NTPHosts) > 0 && args.Config.NTPTimeoutInMilliseconds < minNTPTimeoutInMillis {
+		return fmt.Errorf("%w for NTPTimeoutInMilliseconds, got %d, minimum %d",
+			ErrInvalidValue, args.Config.NTPTimeoutInMilliseconds, minNTPTimeoutInMillis)
+	}
+
+	return nil
+}
+
+// Check runs all the checks and returns the report. A failed check does not stop the remaining ones
+func (checker *selfChecker) Check() *Report {
+	checks := []struct {
+		name    string
+		handler func(ctx context.Context) (CheckStatus, string)
+	}{
+		{ethChainIDCheck, checker.checkEthChainID},
+		{ethContractsVersionCheck, checker.checkEthContractsVersion},
+		{ethMultisigCheck, checker.checkEthMultisig},
+		{ethSafeCheck, checker.checkEthSafe},
+		{ethRelayerCheck, checker.checkEthRelayer},
+		{ethFundsCheck, checker.checkEthFunds},
+		{multiversXMultisigCheck, checker.checkMultiversXMultisig},
+		{multiversXSafeCheck, checker.checkMultiversXSafe},
+		{multiversXRelayerCheck, checker.checkMultiversXRelayer},
+		{multiversXFundsCheck, checker.checkMultiversXFunds},
+		{ntpCheck, checker.checkNTP},
+		{p2pPortCheck, checker.checkP2PPort},
+	}
+
+	report := &Report{
+		Results: make([]CheckResult, 0, len(checks)),
+	}
+	for _, c := range checks {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Duration(checker.config.TimeoutInSeconds)*time.Second)
+		status, details := c.handler(ctx)
+		cancel()
+
+		checker.log.Debug("self-check", "check", c.name, "status", status, "details", details)
+		report.Results = append(report.Results, CheckResult{
+			Name:    c.name,
+			Status:  status,
+			Details: details,
+		})
+	}
+
+	return report
+}
+
+func (checker *selfChecker) checkEthChainID(ctx context.Context) (CheckStatus, string) {
+	chainID, err := checker.ethClientWrapper.ChainID(ctx)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("can not fetch the chain ID: %s", err.Error())
+	}
+
+	expectedChainIDs := checker.config.EthChainIDs
+	if len(expectedChainIDs) == 0 {
+		expectedChainIDs = checker.chain.KnownChainIDs()
+	}
+	if len(expectedChainIDs) == 0 {
+		return StatusFailed, fmt.Sprintf("no known chain IDs for the %s chain, the RPC reported %s. Set SelfCheck.EthChainIDs to allow it",
+			checker.chain, chainID.String())
+	}
+
+	for _, expectedChainID := range expectedChainIDs {
+		if chainID.IsUint64() && chainID.Uint64() == expectedChainID {
+			return StatusOK, fmt.Sprintf("chain ID %s matches the %s chain", chainID.String(), checker.chain)
+		}
+	}
+
+	return StatusFailed, fmt.Sprintf("the RPC reported chain ID %s, expected one of %v for the %s chain",
+		chainID.String(), expectedChainIDs, checker.chain)
+}
+
+func (checker *selfChecker) checkEthContractsVersion(ctx context.Context) (CheckStatus, string) {
+	contractsVersion, err := checker.ethContractsVersionHandler(ctx)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("can not get the contracts version: %s", err.Error())
+	}
+
+	return StatusOK, fmt.Sprintf("contracts version %s", contractsVersion)
+}
+
+func (checker *selfChecker) checkEthMultisig(ctx context.Context) (CheckStatus, string) {
+	quorum, err := checker.ethClientWrapper.Quorum(ctx)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("quorum view failed: %s", err.Error())
+	}
+	isPaused, err := checker.ethClientWrapper.IsPaused(ctx)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("paused view failed: %s", err.Error())
+	}
+
+	return pausedStatus(isPaused), fmt.Sprintf("quorum %s, paused %v", quorum.String(), isPaused)
+}
+
+func (checker *selfChecker) checkEthSafe(ctx context.Context) (CheckStatus, string) {
+	_, err := checker.ethClientWrapper.WhitelistedTokens(ctx, common.Address{})
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("whitelisted tokens view failed: %s", err.Error())
+	}
+
+	return StatusOK, "whitelisted tokens view answered"
+}
+
+func (checker *selfChecker) checkEthRelayer(ctx context.Context) (CheckStatus, string) {
+	relayers, err := checker.ethClientWrapper.GetRelayers(ctx)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("relayers view failed: %s", err.Error())
+	}
+
+	for _, relayer := range relayers {
+		if relayer == checker.ethRelayerAddress {
+			return StatusOK, fmt.Sprintf("%s is one of the %d relayers", checker.ethRelayerAddress.String(), len(relayers))
+		}
+	}
+
+	return StatusFailed, fmt.Sprintf("%s is not in the relayers list of the multisig contract", checker.ethRelayerAddress.String())
+}
+
+func (checker *selfChecker) checkEthFunds(ctx context.Context) (CheckStatus, string) {
+	balance, err := checker.ethClientWrapper.BalanceAt(ctx, checker.ethRelayerAddress, nil)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("can not fetch the balance: %s", err.Error())
+	}
+
+	return fundsStatus(balance)
+}
+
+func (checker *selfChecker) checkMultiversXMultisig(ctx context.Context) (CheckStatus, string) {
+	quorum, err := checker.multiversXDataGetter.GetQuorum(ctx)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("quorum view failed: %s", err.Error())
+	}
+	isPaused, err := checker.multiversXDataGetter.IsPaused(ctx)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("paused view failed: %s", err.Error())
+	}
+
+	return pausedStatus(isPaused), fmt.Sprintf("quorum %d, paused %v", quorum, isPaused)
+}
+
+func (checker *selfChecker) checkMultiversXSafe(ctx context.Context) (CheckStatus, string) {
+	lastBatchID, err := checker.multiversXDataGetter.GetLastMvxBatchID(ctx)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("last batch ID view failed: %s", err.Error())
+	}
+
+	return StatusOK, fmt.Sprintf("last batch ID %d", lastBatchID)
+}
+
+func (checker *selfChecker) checkMultiversXRelayer(ctx context.Context) (CheckStatus, string) {
+	bech32Address, _ := checker.multiversXRelayerAddress.AddressAsBech32String()
+	relayers, err := checker.multiversXDataGetter.GetAllStakedRelayers(ctx)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("staked relayers view failed: %s", err.Error())
+	}
+
+	for _, relayer := range relayers {
+		if bytes.Equal(relayer, checker.multiversXRelayerAddress.AddressBytes()) {
+			return StatusOK, fmt.Sprintf("%s is one of the %d staked relayers", bech32Address, len(relayers))
+		}
+	}
+
+	return StatusFailed, fmt.Sprintf("%s is not in the staked relayers list of the multisig contract", bech32Address)
+}
+
+func (checker *selfChecker) checkMultiversXFunds(ctx context.Context) (CheckStatus, string) {
+	account, err := checker.multiversXProxy.GetAccount(ctx, checker.multiversXRelayerAddress)
+	if err != nil {
+		return StatusFailed, fmt.Sprintf("can not fetch the account: %s", err.Error())
+	}
+
+	balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+	if !ok {
+		return StatusFailed, fmt.Sprintf("invalid balance %s", account.Balance)
+	}
+
+	return fundsStatus(balance)
+}
+
+func (checker *selfChecker) checkNTP(_ context.Context) (CheckStatus, string) {
+	if len(checker.config.NTPHosts) == 0 {
+		return StatusWarning, "no NTP hosts configured"
+	}
+
+	timeout := time.Duration(checker.config.NTPTimeoutInMilliseconds) * time.Millisecond
+	maxClockOffset := time.Duration(checker.config.MaxClockOffsetInMilliseconds) * time.Millisecond
+	for _, host := range checker.config.NTPHosts {
+		clockOffset, err := checker.ntpQueryHandler(host, checker.config.NTPPort, timeout)
+		if err != nil {
+			checker.log.Debug("NTP query failed", "host", host, "error", err)
+			continue
+		}
+
+		if clockOffset.Abs() > maxClockOffset {
+			return StatusWarning, fmt.Sprintf("the local clock is off by %s according to %s, maximum allowed %s",
+				clockOffset.String(), host, maxClockOffset.String())
+		}
+
+		return StatusOK, fmt.Sprintf("%s reachable, clock offset %s", host, clockOffset.String())
+	}
+
+	return StatusWarning, fmt.Sprintf("none of the NTP hosts %v is reachable", checker.config.NTPHosts)
+}
+
+func (checker *selfChecker) checkP2PPort(_ context.Context) (CheckStatus, string) {
+	if checker.p2pPort == randomP2PPort {
+		return StatusOK, "a random port will be used"
+	}
+
+	startPort, endPort, err := parsePortRange(checker.p2pPort)
+	if err != nil {
+		return StatusFailed, err.Error()
+	}
+
+	var lastErr error
+	for port := startPort; port <= endPort; port++ {
+		listener, errListen := net.Listen("tcp", fmt.Sprintf(":%d", port))
+		if errListen != nil {
+			lastErr = errListen
+			continue
+		}
+
+		_ = listener.Close()
+		return StatusOK, fmt.Sprintf("port %d is available", port)
+	}
+
+	// the check is also run with --check-config next to a running relayer that already holds the port, so a busy
+	// port is not an error. The relayer start fails anyway if the port is still busy at that time
+	return StatusWarning, fmt.Sprintf("no port available in %s, expected only if a relayer is already running: %s",
+		checker.p2pPort, lastErr.Error())
+}
+
+func parsePortRange(portConfig string) (int, int, error) {
+	parts := strings.Split(portConfig, p2pPortRangeSeparator)
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf("invalid port configuration %s", portConfig)
+	}
+
+	startPort, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port configuration %s: %w", portConfig, err)
+	}
+	endPort := startPort
+	if len(parts) == 2 {
+		endPort, err = strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid port configuration %s: %w", portConfig, err)
+		}
+	}
+	if startPort <= 0 || startPort > endPort {
+		return 0, 0, fmt.Errorf("invalid port configuration %s", portConfig)
+	}
+
+	return startPort, endPort, nil
+}
+
+func pausedStatus(isPaused bool) CheckStatus {
+	if isPaused {
+		return StatusWarning
+	}
+
+	return StatusOK
+}
+
+func fundsStatus(balance *big.Int) (CheckStatus, string) {
+	details := fmt.Sprintf("balance %s", formatAmount(balance, nativeTokenDecimals))
+	if balance.Sign() <= 0 {
+		return StatusFailed, details + ", " + notEnoughFundsForTxsInfo
+	}
+
+	return StatusOK, details
+}
+
+func formatAmount(value *big.Int, decimals int) string {
+	denomination := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+	integerPart, fractionalPart := big.NewInt(0).QuoRem(value, denomination, big.NewInt(0))
+	if fractionalPart.Sign() == 0 {
+		return integerPart.String()
+	}
+
+	fractional := fmt.Sprintf("%0*s", decimals, fractionalPart.String())
+
+	return integerPart.String() + "." + strings.TrimRight(fractional, "0")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (checker *selfChecker) IsInterfaceNil() bool {
+	return checker == nil
+}
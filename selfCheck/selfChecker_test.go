@@ -0,0 +1,566 @@
+package selfCheck
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/multiversx/mx-bridge-eth-go/clients/chain"
+	"github.com/multiversx/mx-bridge-eth-go/config"
+	bridgeCore "github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon"
+	bridgeTests "github.com/multiversx/mx-bridge-eth-go/testsCommon/bridge"
+	"github.com/multiversx/mx-bridge-eth-go/testsCommon/interactors"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ethRelayer        = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	otherEthRelayer   = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	mvxRelayer        = data.NewAddressFromBytes(make([]byte, 32))
+	otherMvxRelayer   = data.NewAddressFromBytes(append(make([]byte, 31), 1))
+	expectedErr       = errors.New("expected error")
+	oneEthInBaseUnits = big.NewInt(0).Exp(big.NewInt(10), big.NewInt(18), nil)
+)
+
+func createMockArgsSelfChecker() ArgsSelfChecker {
+	return ArgsSelfChecker{
+		Log: &testsCommon.LoggerStub{},
+		Config: config.SelfCheckConfig{
+			Enabled:                      true,
+			TimeoutInSeconds:             1,
+			NTPHosts:                     []string{"host1", "host2"},
+			NTPPort:                      123,
+			NTPTimeoutInMilliseconds:     100,
+			MaxClockOffsetInMilliseconds: 500,
+		},
+		Chain: chain.Ethereum,
+		EthClientWrapper: &bridgeTests.EthereumClientWrapperStub{
+			ChainIDCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+			QuorumCalled: func(ctx context.Context) (*big.Int, error) {
+				return big.NewInt(3), nil
+			},
+			GetRelayersCalled: func(ctx context.Context) ([]common.Address, error) {
+				return []common.Address{otherEthRelayer, ethRelayer}, nil
+			},
+			BalanceAtCalled: func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+				return big.NewInt(0).Set(oneEthInBaseUnits), nil
+			},
+		},
+		EthRelayerAddress: ethRelayer,
+		EthContractsVersionHandler: func(ctx context.Context) (bridgeCore.ContractsVersion, error) {
+			return bridgeCore.ContractsVersionV3, nil
+		},
+		MultiversXDataGetter: &bridgeTests.DataGetterStub{
+			GetQuorumCalled: func(ctx context.Context) (uint64, error) {
+				return 3, nil
+			},
+			GetAllStakedRelayersCalled: func(ctx context.Context) ([][]byte, error) {
+				return [][]byte{otherMvxRelayer.AddressBytes(), mvxRelayer.AddressBytes()}, nil
+			},
+			GetLastMvxBatchIDCalled: func(ctx context.Context) (uint64, error) {
+				return 37, nil
+			},
+		},
+		MultiversXProxy: &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Balance: "1500000000000000000"}, nil
+			},
+		},
+		MultiversXRelayerAddress: mvxRelayer,
+		P2PPort:                  "0",
+		NTPQueryHandler: func(host string, port int, timeout time.Duration) (time.Duration, error) {
+			return time.Millisecond * 10, nil
+		},
+	}
+}
+
+func getResult(t *testing.T, report *Report, name string) CheckResult {
+	for _, result := range report.Results {
+		if result.Name == name {
+			return result
+		}
+	}
+
+	require.Fail(t, "check not found in report: "+name)
+	return CheckResult{}
+}
+
+func TestNewSelfChecker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil logger should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.Log = nil
+
+		checker, err := NewSelfChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrNilLogger, err)
+	})
+	t.Run("nil Ethereum client wrapper should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.EthClientWrapper = nil
+
+		checker, err := NewSelfChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrNilEthereumClientWrapper, err)
+	})
+	t.Run("nil Ethereum contracts version handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.EthContractsVersionHandler = nil
+
+		checker, err := NewSelfChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrNilEthContractsVersionHandler, err)
+	})
+	t.Run("nil MultiversX data getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.MultiversXDataGetter = nil
+
+		checker, err := NewSelfChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrNilMultiversXDataGetter, err)
+	})
+	t.Run("nil MultiversX proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.MultiversXProxy = nil
+
+		checker, err := NewSelfChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrNilMultiversXProxy, err)
+	})
+	t.Run("nil MultiversX relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.MultiversXRelayerAddress = nil
+
+		checker, err := NewSelfChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.ErrorIs(t, err, ErrNilAddressHandler)
+		assert.Contains(t, err.Error(), "MultiversXRelayerAddress")
+	})
+	t.Run("nil NTP query handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.NTPQueryHandler = nil
+
+		checker, err := NewSelfChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.Equal(t, ErrNilNTPQueryHandler, err)
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.Config.TimeoutInSeconds = 0
+
+		checker, err := NewSelfChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "TimeoutInSeconds")
+	})
+	t.Run("invalid NTP timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.Config.NTPTimeoutInMilliseconds = 0
+
+		checker, err := NewSelfChecker(args)
+		assert.True(t, check.IfNil(checker))
+		assert.ErrorIs(t, err, ErrInvalidValue)
+		assert.Contains(t, err.Error(), "NTPTimeoutInMilliseconds")
+	})
+	t.Run("no NTP hosts does not require the NTP timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.Config.NTPHosts = nil
+		args.Config.NTPTimeoutInMilliseconds = 0
+
+		checker, err := NewSelfChecker(args)
+		assert.False(t, check.IfNil(checker))
+		assert.Nil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		checker, err := NewSelfChecker(createMockArgsSelfChecker())
+		assert.False(t, check.IfNil(checker))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSelfChecker_CheckAllPassing(t *testing.T) {
+	t.Parallel()
+
+	checker, _ := NewSelfChecker(createMockArgsSelfChecker())
+	report := checker.Check()
+
+	assert.Equal(t, 12, len(report.Results))
+	assert.Equal(t, 0, report.NumFailed())
+	assert.Equal(t, 0, report.NumWarnings())
+	assert.Equal(t, "balance 1", getResult(t, report, ethFundsCheck).Details)
+	assert.Equal(t, "balance 1.5", getResult(t, report, multiversXFundsCheck).Details)
+	assert.Equal(t, "last batch ID 37", getResult(t, report, multiversXSafeCheck).Details)
+	assert.Equal(t, "contracts version v3", getResult(t, report, ethContractsVersionCheck).Details)
+}
+
+func TestSelfChecker_EthereumChecks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("chain ID fetch errors", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.EthClientWrapper.(*bridgeTests.EthereumClientWrapperStub).ChainIDCalled = func(ctx context.Context) (*big.Int, error) {
+			return nil, expectedErr
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ethChainIDCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.Contains(t, result.Details, expectedErr.Error())
+	})
+	t.Run("chain ID of another chain", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.Chain = chain.Bsc
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ethChainIDCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.Equal(t, "the RPC reported chain ID 1, expected one of [56 97] for the Bsc chain", result.Details)
+	})
+	t.Run("chain ID from config", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.Config.EthChainIDs = []uint64{1337}
+		args.EthClientWrapper.(*bridgeTests.EthereumClientWrapperStub).ChainIDCalled = func(ctx context.Context) (*big.Int, error) {
+			return big.NewInt(1337), nil
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ethChainIDCheck)
+		assert.Equal(t, StatusOK, result.Status)
+	})
+	t.Run("unknown chain without configured chain IDs", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.Chain = "Unknown"
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ethChainIDCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.Contains(t, result.Details, "SelfCheck.EthChainIDs")
+	})
+	t.Run("contracts version detection errors", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.EthContractsVersionHandler = func(ctx context.Context) (bridgeCore.ContractsVersion, error) {
+			return "", expectedErr
+		}
+		checker, _ := NewSelfChecker(args)
+		report := checker.Check()
+
+		result := getResult(t, report, ethContractsVersionCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.Contains(t, result.Details, expectedErr.Error())
+		assert.Equal(t, 1, report.NumFailed())
+	})
+	t.Run("multisig views error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.EthClientWrapper.(*bridgeTests.EthereumClientWrapperStub).QuorumCalled = func(ctx context.Context) (*big.Int, error) {
+			return nil, expectedErr
+		}
+		args.EthClientWrapper.(*bridgeTests.EthereumClientWrapperStub).WhitelistedTokensCalled = func(ctx context.Context, account common.Address) (bool, error) {
+			return false, expectedErr
+		}
+		checker, _ := NewSelfChecker(args)
+		report := checker.Check()
+
+		assert.Equal(t, StatusFailed, getResult(t, report, ethMultisigCheck).Status)
+		assert.Equal(t, StatusFailed, getResult(t, report, ethSafeCheck).Status)
+		assert.Equal(t, 2, report.NumFailed())
+	})
+	t.Run("paused multisig should warn", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.EthClientWrapper.(*bridgeTests.EthereumClientWrapperStub).IsPausedCalled = func(ctx context.Context) (bool, error) {
+			return true, nil
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ethMultisigCheck)
+		assert.Equal(t, StatusWarning, result.Status)
+		assert.Equal(t, "quorum 3, paused true", result.Details)
+	})
+	t.Run("relayer not whitelisted", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.EthClientWrapper.(*bridgeTests.EthereumClientWrapperStub).GetRelayersCalled = func(ctx context.Context) ([]common.Address, error) {
+			return []common.Address{otherEthRelayer}, nil
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ethRelayerCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.Contains(t, result.Details, ethRelayer.String())
+	})
+	t.Run("no funds", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.EthClientWrapper.(*bridgeTests.EthereumClientWrapperStub).BalanceAtCalled = func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+			assert.Equal(t, ethRelayer, account)
+			return big.NewInt(0), nil
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ethFundsCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.Equal(t, "balance 0, "+notEnoughFundsForTxsInfo, result.Details)
+	})
+}
+
+func TestSelfChecker_MultiversXChecks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("contracts views error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		dataGetter := args.MultiversXDataGetter.(*bridgeTests.DataGetterStub)
+		dataGetter.IsPausedCalled = func(ctx context.Context) (bool, error) {
+			return false, expectedErr
+		}
+		dataGetter.GetLastMvxBatchIDCalled = func(ctx context.Context) (uint64, error) {
+			return 0, expectedErr
+		}
+		dataGetter.GetAllStakedRelayersCalled = func(ctx context.Context) ([][]byte, error) {
+			return nil, expectedErr
+		}
+		checker, _ := NewSelfChecker(args)
+		report := checker.Check()
+
+		assert.Equal(t, StatusFailed, getResult(t, report, multiversXMultisigCheck).Status)
+		assert.Equal(t, StatusFailed, getResult(t, report, multiversXSafeCheck).Status)
+		assert.Equal(t, StatusFailed, getResult(t, report, multiversXRelayerCheck).Status)
+		assert.Equal(t, 3, report.NumFailed())
+	})
+	t.Run("relayer not staked", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.MultiversXDataGetter.(*bridgeTests.DataGetterStub).GetAllStakedRelayersCalled = func(ctx context.Context) ([][]byte, error) {
+			return [][]byte{otherMvxRelayer.AddressBytes()}, nil
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), multiversXRelayerCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+		bech32Address, _ := mvxRelayer.AddressAsBech32String()
+		assert.Contains(t, result.Details, bech32Address)
+	})
+	t.Run("account fetch errors", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.MultiversXProxy = &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return nil, expectedErr
+			},
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), multiversXFundsCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+		assert.Contains(t, result.Details, expectedErr.Error())
+	})
+	t.Run("no funds", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.MultiversXProxy = &interactors.ProxyStub{
+			GetAccountCalled: func(ctx context.Context, address core.AddressHandler) (*data.Account, error) {
+				return &data.Account{Balance: "0"}, nil
+			},
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), multiversXFundsCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+	})
+}
+
+func TestSelfChecker_NTP(t *testing.T) {
+	t.Parallel()
+
+	t.Run("first host unreachable should query the next one", func(t *testing.T) {
+		t.Parallel()
+
+		queriedHosts := make([]string, 0)
+		args := createMockArgsSelfChecker()
+		args.NTPQueryHandler = func(host string, port int, timeout time.Duration) (time.Duration, error) {
+			assert.Equal(t, 123, port)
+			assert.Equal(t, time.Millisecond*100, timeout)
+			queriedHosts = append(queriedHosts, host)
+			if host == "host1" {
+				return 0, expectedErr
+			}
+
+			return -time.Millisecond * 20, nil
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ntpCheck)
+		assert.Equal(t, StatusOK, result.Status)
+		assert.Equal(t, "host2 reachable, clock offset -20ms", result.Details)
+		assert.Equal(t, []string{"host1", "host2"}, queriedHosts)
+	})
+	t.Run("no reachable host should warn", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.NTPQueryHandler = func(host string, port int, timeout time.Duration) (time.Duration, error) {
+			return 0, expectedErr
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ntpCheck)
+		assert.Equal(t, StatusWarning, result.Status)
+	})
+	t.Run("clock offset too large should warn", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.NTPQueryHandler = func(host string, port int, timeout time.Duration) (time.Duration, error) {
+			return -time.Second, nil
+		}
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ntpCheck)
+		assert.Equal(t, StatusWarning, result.Status)
+		assert.True(t, strings.HasPrefix(result.Details, "the local clock is off by -1s"))
+	})
+	t.Run("no hosts should warn", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.Config.NTPHosts = nil
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), ntpCheck)
+		assert.Equal(t, StatusWarning, result.Status)
+	})
+}
+
+func TestSelfChecker_P2PPort(t *testing.T) {
+	t.Parallel()
+
+	t.Run("port in use should warn", func(t *testing.T) {
+		t.Parallel()
+
+		listener, err := net.Listen("tcp", ":0")
+		require.Nil(t, err)
+		defer func() {
+			_ = listener.Close()
+		}()
+
+		args := createMockArgsSelfChecker()
+		args.P2PPort = fmt.Sprintf("%d", listener.Addr().(*net.TCPAddr).Port)
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), p2pPortCheck)
+		assert.Equal(t, StatusWarning, result.Status)
+		assert.Contains(t, result.Details, "a relayer is already running")
+	})
+	t.Run("free port should work", func(t *testing.T) {
+		t.Parallel()
+
+		listener, err := net.Listen("tcp", ":0")
+		require.Nil(t, err)
+		port := listener.Addr().(*net.TCPAddr).Port
+		_ = listener.Close()
+
+		args := createMockArgsSelfChecker()
+		args.P2PPort = fmt.Sprintf("%d", port)
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), p2pPortCheck)
+		assert.Equal(t, StatusOK, result.Status)
+	})
+	t.Run("invalid port should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSelfChecker()
+		args.P2PPort = "abc"
+		checker, _ := NewSelfChecker(args)
+
+		result := getResult(t, checker.Check(), p2pPortCheck)
+		assert.Equal(t, StatusFailed, result.Status)
+	})
+}
+
+func TestParsePortRange(t *testing.T) {
+	t.Parallel()
+
+	start, end, err := parsePortRange("10010")
+	assert.Nil(t, err)
+	assert.Equal(t, 10010, start)
+	assert.Equal(t, 10010, end)
+
+	start, end, err = parsePortRange("10010-10020")
+	assert.Nil(t, err)
+	assert.Equal(t, 10010, start)
+	assert.Equal(t, 10020, end)
+
+	_, _, err = parsePortRange("10020-10010")
+	assert.NotNil(t, err)
+
+	_, _, err = parsePortRange("1-2-3")
+	assert.NotNil(t, err)
+
+	_, _, err = parsePortRange("-1")
+	assert.NotNil(t, err)
+}
+
+func TestFormatAmount(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0", formatAmount(big.NewInt(0), 18))
+	assert.Equal(t, "2", formatAmount(big.NewInt(2000), 3))
+	assert.Equal(t, "2.05", formatAmount(big.NewInt(2050), 3))
+	assert.Equal(t, "0.001", formatAmount(big.NewInt(1), 3))
+}
@@ -0,0 +1,52 @@
+package status
+
+import (
+	"sync"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+type signaturesProgressHolder struct {
+	mut      sync.RWMutex
+	progress map[string]*core.SignaturesProgress
+}
+
+// NewSignaturesProgressHolder returns a new instance of the component able to hold the signatures collection
+// progress of each half-bridge
+func NewSignaturesProgressHolder() *signaturesProgressHolder {
+	return &signaturesProgressHolder{
+		progress: make(map[string]*core.SignaturesProgress),
+	}
+}
+
+// SetSignaturesProgress stores the provided progress for the provided half-bridge name. A nil progress will
+// remove the existing entry
+func (holder *signaturesProgressHolder) SetSignaturesProgress(name string, progress *core.SignaturesProgress) {
+	holder.mut.Lock()
+	defer holder.mut.Unlock()
+
+	if progress == nil {
+		delete(holder.progress, name)
+		return
+	}
+
+	holder.progress[name] = progress
+}
+
+// GetAllSignaturesProgress returns the stored progress of all half-bridges
+func (holder *signaturesProgressHolder) GetAllSignaturesProgress() map[string]*core.SignaturesProgress {
+	holder.mut.RLock()
+	defer holder.mut.RUnlock()
+
+	result := make(map[string]*core.SignaturesProgress, len(holder.progress))
+	for name, progress := range holder.progress {
+		result[name] = progress
+	}
+
+	return result
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holder *signaturesProgressHolder) IsInterfaceNil() bool {
+	return holder == nil
+}
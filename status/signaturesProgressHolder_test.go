@@ -0,0 +1,39 @@
+package status
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSignaturesProgressHolder(t *testing.T) {
+	t.Parallel()
+
+	holder := NewSignaturesProgressHolder()
+	assert.False(t, check.IfNil(holder))
+	assert.Empty(t, holder.GetAllSignaturesProgress())
+}
+
+func TestSignaturesProgressHolder_SetSignaturesProgress(t *testing.T) {
+	t.Parallel()
+
+	holder := NewSignaturesProgressHolder()
+	progress1 := &core.SignaturesProgress{BatchID: 1, Quorum: 3, NumSignatures: 2}
+	progress2 := &core.SignaturesProgress{BatchID: 2, Quorum: 3, NumSignatures: 1}
+
+	holder.SetSignaturesProgress("half-bridge 1", progress1)
+	holder.SetSignaturesProgress("half-bridge 2", progress2)
+	expected := map[string]*core.SignaturesProgress{
+		"half-bridge 1": progress1,
+		"half-bridge 2": progress2,
+	}
+	assert.Equal(t, expected, holder.GetAllSignaturesProgress())
+
+	holder.SetSignaturesProgress("half-bridge 1", nil)
+	expected = map[string]*core.SignaturesProgress{
+		"half-bridge 2": progress2,
+	}
+	assert.Equal(t, expected, holder.GetAllSignaturesProgress())
+}
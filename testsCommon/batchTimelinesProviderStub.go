@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// BatchTimelinesProviderStub -
+type BatchTimelinesProviderStub struct {
+	GetBatchTimelinesCalled func() []*core.BatchTimeline
+}
+
+// GetBatchTimelines -
+func (stub *BatchTimelinesProviderStub) GetBatchTimelines() []*core.BatchTimeline {
+	if stub.GetBatchTimelinesCalled != nil {
+		return stub.GetBatchTimelinesCalled()
+	}
+
+	return make([]*core.BatchTimeline, 0)
+}
+
+// IsInterfaceNil -
+func (stub *BatchTimelinesProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
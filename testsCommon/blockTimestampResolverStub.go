@@ -0,0 +1,22 @@
+package testsCommon
+
+import "context"
+
+// BlockTimestampResolverStub -
+type BlockTimestampResolverStub struct {
+	GetBlockTimestampCalled func(ctx context.Context, blockNumber uint64) (uint64, error)
+}
+
+// GetBlockTimestamp -
+func (stub *BlockTimestampResolverStub) GetBlockTimestamp(ctx context.Context, blockNumber uint64) (uint64, error) {
+	if stub.GetBlockTimestampCalled != nil {
+		return stub.GetBlockTimestampCalled(ctx, blockNumber)
+	}
+
+	return 0, nil
+}
+
+// IsInterfaceNil -
+func (stub *BlockTimestampResolverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -2,6 +2,7 @@ package bridge
 
 import (
 	"context"
+	"math/big"
 )
 
 // DataGetterStub -
@@ -10,6 +11,17 @@ type DataGetterStub struct {
 	GetERC20AddressForTokenIdCalled func(ctx context.Context, tokenId []byte) ([][]byte, error)
 	GetAllStakedRelayersCalled      func(ctx context.Context) ([][]byte, error)
 	GetAllKnownTokensCalled         func(ctx context.Context) ([][]byte, error)
+	IsMintBurnTokenCalled           func(ctx context.Context, token []byte) (bool, error)
+	IsNativeTokenCalled             func(ctx context.Context, token []byte) (bool, error)
+	GetTotalBalancesCalled          func(ctx context.Context, token []byte) (*big.Int, error)
+	GetMintBalancesCalled           func(ctx context.Context, token []byte) (*big.Int, error)
+	GetBurnBalancesCalled           func(ctx context.Context, token []byte) (*big.Int, error)
+	GetLastExecutedEthBatchIDCalled func(ctx context.Context) (uint64, error)
+	GetLastExecutedEthTxIDCalled    func(ctx context.Context) (uint64, error)
+	GetQuorumCalled                 func(ctx context.Context) (uint64, error)
+	IsPausedCalled                  func(ctx context.Context) (bool, error)
+	GetLastMvxBatchIDCalled         func(ctx context.Context) (uint64, error)
+	IsSafePausedCalled              func(ctx context.Context) (bool, error)
 }
 
 // GetTokenIdForErc20Address -
@@ -46,6 +58,105 @@ func (stub *DataGetterStub) GetAllKnownTokens(ctx context.Context) ([][]byte, er
 	return make([][]byte, 0), nil
 }
 
+// IsMintBurnToken -
+func (stub *DataGetterStub) IsMintBurnToken(ctx context.Context, token []byte) (bool, error) {
+	if stub.IsMintBurnTokenCalled != nil {
+		return stub.IsMintBurnTokenCalled(ctx, token)
+	}
+
+	return false, nil
+}
+
+// IsNativeToken -
+func (stub *DataGetterStub) IsNativeToken(ctx context.Context, token []byte) (bool, error) {
+	if stub.IsNativeTokenCalled != nil {
+		return stub.IsNativeTokenCalled(ctx, token)
+	}
+
+	return false, nil
+}
+
+// GetTotalBalances -
+func (stub *DataGetterStub) GetTotalBalances(ctx context.Context, token []byte) (*big.Int, error) {
+	if stub.GetTotalBalancesCalled != nil {
+		return stub.GetTotalBalancesCalled(ctx, token)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// GetMintBalances -
+func (stub *DataGetterStub) GetMintBalances(ctx context.Context, token []byte) (*big.Int, error) {
+	if stub.GetMintBalancesCalled != nil {
+		return stub.GetMintBalancesCalled(ctx, token)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// GetBurnBalances -
+func (stub *DataGetterStub) GetBurnBalances(ctx context.Context, token []byte) (*big.Int, error) {
+	if stub.GetBurnBalancesCalled != nil {
+		return stub.GetBurnBalancesCalled(ctx, token)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// GetLastExecutedEthBatchID -
+func (stub *DataGetterStub) GetLastExecutedEthBatchID(ctx context.Context) (uint64, error) {
+	if stub.GetLastExecutedEthBatchIDCalled != nil {
+		return stub.GetLastExecutedEthBatchIDCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// GetLastExecutedEthTxID -
+func (stub *DataGetterStub) GetLastExecutedEthTxID(ctx context.Context) (uint64, error) {
+	if stub.GetLastExecutedEthTxIDCalled != nil {
+		return stub.GetLastExecutedEthTxIDCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// GetQuorum -
+func (stub *DataGetterStub) GetQuorum(ctx context.Context) (uint64, error) {
+	if stub.GetQuorumCalled != nil {
+		return stub.GetQuorumCalled(ctx)
+	}
+
+	return 0, nil
+}
+
+// IsPaused -
+func (stub *DataGetterStub) IsPaused(ctx context.Context) (bool, error) {
+	if stub.IsPausedCalled != nil {
+		return stub.IsPausedCalled(ctx)
+	}
+
+	return false, nil
+}
+
+// IsSafePaused -
+func (stub *DataGetterStub) IsSafePaused(ctx context.Context) (bool, error) {
+	if stub.IsSafePausedCalled != nil {
+		return stub.IsSafePausedCalled(ctx)
+	}
+
+	return false, nil
+}
+
+// GetLastMvxBatchID -
+func (stub *DataGetterStub) GetLastMvxBatchID(ctx context.Context) (uint64, error) {
+	if stub.GetLastMvxBatchIDCalled != nil {
+		return stub.GetLastMvxBatchIDCalled(ctx)
+	}
+
+	return 0, nil
+}
+
 // IsInterfaceNil -
 func (stub *DataGetterStub) IsInterfaceNil() bool {
 	return stub == nil
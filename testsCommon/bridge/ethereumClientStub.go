@@ -15,12 +15,13 @@ type EthereumClientStub struct {
 	GetBatchCalled                         func(ctx context.Context, nonce uint64) (*bridgeCore.TransferBatch, bool, error)
 	WasExecutedCalled                      func(ctx context.Context, batchID uint64) (bool, error)
 	GenerateMessageHashCalled              func(batch *batchProcessor.ArgListsBatch, batchID uint64) (common.Hash, error)
-	BroadcastSignatureForMessageHashCalled func(msgHash common.Hash)
+	BroadcastSignatureForMessageHashCalled func(msgHash common.Hash, batchID uint64)
 	ExecuteTransferCalled                  func(ctx context.Context, msgHash common.Hash, batch *batchProcessor.ArgListsBatch, batchId uint64, quorum int) (string, error)
 	CheckClientAvailabilityCalled          func(ctx context.Context) error
 	GetTransactionsStatusesCalled          func(ctx context.Context, batchId uint64) ([]byte, error)
 	GetQuorumSizeCalled                    func(ctx context.Context) (*big.Int, error)
 	IsQuorumReachedCalled                  func(ctx context.Context, msgHash common.Hash) (bool, error)
+	GetSignaturesProgressCalled            func(ctx context.Context, msgHash common.Hash) (*bridgeCore.SignaturesProgress, error)
 	GetBatchSCMetadataCalled               func(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error)
 	CheckRequiredBalanceCalled             func(ctx context.Context, erc20Address common.Address, value *big.Int) error
 	TotalBalancesCalled                    func(ctx context.Context, account common.Address) (*big.Int, error)
@@ -59,9 +60,9 @@ func (stub *EthereumClientStub) GenerateMessageHash(batch *batchProcessor.ArgLis
 }
 
 // BroadcastSignatureForMessageHash -
-func (stub *EthereumClientStub) BroadcastSignatureForMessageHash(msgHash common.Hash) {
+func (stub *EthereumClientStub) BroadcastSignatureForMessageHash(msgHash common.Hash, batchID uint64) {
 	if stub.BroadcastSignatureForMessageHashCalled != nil {
-		stub.BroadcastSignatureForMessageHashCalled(msgHash)
+		stub.BroadcastSignatureForMessageHashCalled(msgHash, batchID)
 	}
 }
 
@@ -110,6 +111,15 @@ func (stub *EthereumClientStub) IsQuorumReached(ctx context.Context, msgHash com
 	return false, errNotImplemented
 }
 
+// GetSignaturesProgress -
+func (stub *EthereumClientStub) GetSignaturesProgress(ctx context.Context, msgHash common.Hash) (*bridgeCore.SignaturesProgress, error) {
+	if stub.GetSignaturesProgressCalled != nil {
+		return stub.GetSignaturesProgressCalled(ctx, msgHash)
+	}
+
+	return nil, errNotImplemented
+}
+
 // GetBatchSCMetadata -
 func (stub *EthereumClientStub) GetBatchSCMetadata(ctx context.Context, nonce uint64, blockNumber int64) ([]*contract.ERC20SafeERC20SCDeposit, error) {
 	if stub.GetBatchSCMetadataCalled != nil {
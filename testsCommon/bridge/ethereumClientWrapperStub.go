@@ -25,6 +25,8 @@ type EthereumClientWrapperStub struct {
 	NonceAtCalled          func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
 	ExecuteTransferCalled  func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address,
 		amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error)
+	SimulateExecuteTransferCalled func(ctx context.Context, from common.Address, tokens []common.Address, recipients []common.Address,
+		amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) error
 	QuorumCalled                    func(ctx context.Context) (*big.Int, error)
 	GetStatusesAfterExecutionCalled func(ctx context.Context, batchID *big.Int) ([]byte, bool, error)
 	BalanceAtCalled                 func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -42,6 +44,9 @@ type EthereumClientWrapperStub struct {
 	NameCalled            func() string
 	IsPausedCalled        func(ctx context.Context) (bool, error)
 	FilterLogsCalled      func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+
+	TransactionReceiptCalled func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	HeaderByNumberCalled     func(ctx context.Context, number *big.Int) (*types.Header, error)
 }
 
 // SetIntMetric -
@@ -170,6 +175,15 @@ func (stub *EthereumClientWrapperStub) ExecuteTransfer(opts *bind.TransactOpts,
 	return nil, errors.New("not implemented")
 }
 
+// SimulateExecuteTransfer -
+func (stub *EthereumClientWrapperStub) SimulateExecuteTransfer(ctx context.Context, from common.Address, tokens []common.Address, recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) error {
+	if stub.SimulateExecuteTransferCalled != nil {
+		return stub.SimulateExecuteTransferCalled(ctx, from, tokens, recipients, amounts, nonces, batchNonce, signatures)
+	}
+
+	return nil
+}
+
 // Quorum -
 func (stub *EthereumClientWrapperStub) Quorum(ctx context.Context) (*big.Int, error) {
 	if stub.QuorumCalled != nil {
@@ -206,6 +220,24 @@ func (stub *EthereumClientWrapperStub) FilterLogs(ctx context.Context, q ethereu
 	return []types.Log{}, nil
 }
 
+// TransactionReceipt -
+func (stub *EthereumClientWrapperStub) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if stub.TransactionReceiptCalled != nil {
+		return stub.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return nil, errNotImplemented
+}
+
+// HeaderByNumber -
+func (stub *EthereumClientWrapperStub) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if stub.HeaderByNumberCalled != nil {
+		return stub.HeaderByNumberCalled(ctx, number)
+	}
+
+	return nil, errNotImplemented
+}
+
 // IsPaused -
 func (stub *EthereumClientWrapperStub) IsPaused(ctx context.Context) (bool, error) {
 	if stub.IsPausedCalled != nil {
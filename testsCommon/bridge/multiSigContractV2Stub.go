@@ -0,0 +1,117 @@
+package bridge
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	contractV2 "github.com/multiversx/mx-bridge-eth-go/executors/ethereum/bridgeV2Wrappers/contract"
+)
+
+// MultiSigContractV2Stub -
+type MultiSigContractV2Stub struct {
+	GetBatchCalled         func(opts *bind.CallOpts, batchNonce *big.Int) (contractV2.Batch, error)
+	GetBatchDepositsCalled func(opts *bind.CallOpts, batchNonce *big.Int) ([]contractV2.Deposit, error)
+	GetRelayersCalled      func(opts *bind.CallOpts) ([]common.Address, error)
+	WasBatchExecutedCalled func(opts *bind.CallOpts, batchNonce *big.Int) (bool, error)
+	ExecuteTransferCalled  func(opts *bind.TransactOpts, tokens []common.Address, recipients []common.Address,
+		amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int, signatures [][]byte) (*types.Transaction, error)
+	QuorumCalled                    func(opts *bind.CallOpts) (*big.Int, error)
+	GetStatusesAfterExecutionCalled func(opts *bind.CallOpts, batchID *big.Int) ([]byte, error)
+	PausedCalled                    func(opts *bind.CallOpts) (bool, error)
+	BatchSettleBlockCountCalled     func(opts *bind.CallOpts) (*big.Int, error)
+	CrossTransferStatusesCalled     func(opts *bind.CallOpts, batchID *big.Int) (*big.Int, error)
+}
+
+// GetBatch -
+func (stub *MultiSigContractV2Stub) GetBatch(opts *bind.CallOpts, batchNonce *big.Int) (contractV2.Batch, error) {
+	if stub.GetBatchCalled != nil {
+		return stub.GetBatchCalled(opts, batchNonce)
+	}
+
+	return contractV2.Batch{}, nil
+}
+
+// GetBatchDeposits -
+func (stub *MultiSigContractV2Stub) GetBatchDeposits(opts *bind.CallOpts, batchNonce *big.Int) ([]contractV2.Deposit, error) {
+	if stub.GetBatchDepositsCalled != nil {
+		return stub.GetBatchDepositsCalled(opts, batchNonce)
+	}
+
+	return make([]contractV2.Deposit, 0), nil
+}
+
+// GetRelayers -
+func (stub *MultiSigContractV2Stub) GetRelayers(opts *bind.CallOpts) ([]common.Address, error) {
+	if stub.GetRelayersCalled != nil {
+		return stub.GetRelayersCalled(opts)
+	}
+
+	return make([]common.Address, 0), nil
+}
+
+// WasBatchExecuted -
+func (stub *MultiSigContractV2Stub) WasBatchExecuted(opts *bind.CallOpts, batchNonce *big.Int) (bool, error) {
+	if stub.WasBatchExecutedCalled != nil {
+		return stub.WasBatchExecutedCalled(opts, batchNonce)
+	}
+
+	return false, nil
+}
+
+// ExecuteTransfer -
+func (stub *MultiSigContractV2Stub) ExecuteTransfer(opts *bind.TransactOpts, tokens []common.Address,
+	recipients []common.Address, amounts []*big.Int, nonces []*big.Int, batchNonce *big.Int,
+	signatures [][]byte) (*types.Transaction, error) {
+	if stub.ExecuteTransferCalled != nil {
+		return stub.ExecuteTransferCalled(opts, tokens, recipients, amounts, nonces, batchNonce, signatures)
+	}
+
+	return nil, nil
+}
+
+// Quorum -
+func (stub *MultiSigContractV2Stub) Quorum(opts *bind.CallOpts) (*big.Int, error) {
+	if stub.QuorumCalled != nil {
+		return stub.QuorumCalled(opts)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// GetStatusesAfterExecution -
+func (stub *MultiSigContractV2Stub) GetStatusesAfterExecution(opts *bind.CallOpts, batchID *big.Int) ([]byte, error) {
+	if stub.GetStatusesAfterExecutionCalled != nil {
+		return stub.GetStatusesAfterExecutionCalled(opts, batchID)
+	}
+
+	return make([]byte, 0), nil
+}
+
+// Paused -
+func (stub *MultiSigContractV2Stub) Paused(opts *bind.CallOpts) (bool, error) {
+	if stub.PausedCalled != nil {
+		return stub.PausedCalled(opts)
+	}
+
+	return false, nil
+}
+
+// BatchSettleBlockCount -
+func (stub *MultiSigContractV2Stub) BatchSettleBlockCount(opts *bind.CallOpts) (*big.Int, error) {
+	if stub.BatchSettleBlockCountCalled != nil {
+		return stub.BatchSettleBlockCountCalled(opts)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// CrossTransferStatuses -
+func (stub *MultiSigContractV2Stub) CrossTransferStatuses(opts *bind.CallOpts, batchID *big.Int) (*big.Int, error) {
+	if stub.CrossTransferStatusesCalled != nil {
+		return stub.CrossTransferStatusesCalled(opts, batchID)
+	}
+
+	return big.NewInt(0), nil
+}
@@ -30,6 +30,7 @@ type MultiversXClientStub struct {
 	ProposeTransferCalled                          func(ctx context.Context, batch *bridgeCore.TransferBatch) (string, error)
 	SignCalled                                     func(ctx context.Context, actionID uint64) (string, error)
 	WasSignedCalled                                func(ctx context.Context, actionID uint64) (bool, error)
+	GetSignaturesProgressCalled                    func(ctx context.Context, actionID uint64) (*bridgeCore.SignaturesProgress, error)
 	PerformActionCalled                            func(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error)
 	CheckClientAvailabilityCalled                  func(ctx context.Context) error
 	IsMintBurnTokenCalled                          func(ctx context.Context, token []byte) (bool, error)
@@ -195,6 +196,15 @@ func (stub *MultiversXClientStub) WasSigned(ctx context.Context, actionID uint64
 	return false, nil
 }
 
+// GetSignaturesProgress -
+func (stub *MultiversXClientStub) GetSignaturesProgress(ctx context.Context, actionID uint64) (*bridgeCore.SignaturesProgress, error) {
+	if stub.GetSignaturesProgressCalled != nil {
+		return stub.GetSignaturesProgressCalled(ctx, actionID)
+	}
+
+	return &bridgeCore.SignaturesProgress{}, nil
+}
+
 // PerformAction -
 func (stub *MultiversXClientStub) PerformAction(ctx context.Context, actionID uint64, batch *bridgeCore.TransferBatch) (string, error) {
 	if stub.PerformActionCalled != nil {
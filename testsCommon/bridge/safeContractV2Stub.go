@@ -0,0 +1,42 @@
+package bridge
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SafeContractV2Stub -
+type SafeContractV2Stub struct {
+	TokenBalancesCalled     func(opts *bind.CallOpts, token common.Address) (*big.Int, error)
+	WhitelistedTokensCalled func(opts *bind.CallOpts, token common.Address) (bool, error)
+	BatchSettleLimitCalled  func(opts *bind.CallOpts) (uint8, error)
+}
+
+// TokenBalances -
+func (stub *SafeContractV2Stub) TokenBalances(opts *bind.CallOpts, token common.Address) (*big.Int, error) {
+	if stub.TokenBalancesCalled != nil {
+		return stub.TokenBalancesCalled(opts, token)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// WhitelistedTokens -
+func (stub *SafeContractV2Stub) WhitelistedTokens(opts *bind.CallOpts, token common.Address) (bool, error) {
+	if stub.WhitelistedTokensCalled != nil {
+		return stub.WhitelistedTokensCalled(opts, token)
+	}
+
+	return false, nil
+}
+
+// BatchSettleLimit -
+func (stub *SafeContractV2Stub) BatchSettleLimit(opts *bind.CallOpts) (uint8, error) {
+	if stub.BatchSettleLimitCalled != nil {
+		return stub.BatchSettleLimitCalled(opts)
+	}
+
+	return 0, nil
+}
@@ -6,6 +6,7 @@ import "github.com/ethereum/go-ethereum/accounts/abi/bind"
 type SafeContractWrapperStub struct {
 	DepositsCountCalled func(opts *bind.CallOpts) (uint64, error)
 	BatchesCountCalled  func(opts *bind.CallOpts) (uint64, error)
+	PausedCalled        func(opts *bind.CallOpts) (bool, error)
 }
 
 // DepositsCount -
@@ -25,3 +26,12 @@ func (stub *SafeContractWrapperStub) BatchesCount(opts *bind.CallOpts) (uint64,
 
 	return 0, nil
 }
+
+// Paused -
+func (stub *SafeContractWrapperStub) Paused(opts *bind.CallOpts) (bool, error) {
+	if stub.PausedCalled != nil {
+		return stub.PausedCalled(opts)
+	}
+
+	return false, nil
+}
@@ -0,0 +1,22 @@
+package bridge
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TransactionReceiptGetterStub -
+type TransactionReceiptGetterStub struct {
+	TransactionReceiptCalled func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// TransactionReceipt -
+func (stub *TransactionReceiptGetterStub) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if stub.TransactionReceiptCalled != nil {
+		return stub.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return &types.Receipt{}, nil
+}
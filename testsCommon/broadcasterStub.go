@@ -4,7 +4,7 @@ import "github.com/multiversx/mx-bridge-eth-go/core"
 
 // BroadcasterStub -
 type BroadcasterStub struct {
-	BroadcastSignatureCalled func(signature []byte, messageHash []byte)
+	BroadcastSignatureCalled func(signature []byte, messageHash []byte, batchID uint64)
 	BroadcastJoinTopicCalled func()
 	SortedPublicKeysCalled   func() [][]byte
 	RegisterOnTopicsCalled   func() error
@@ -13,9 +13,9 @@ type BroadcasterStub struct {
 }
 
 // BroadcastSignature -
-func (bs *BroadcasterStub) BroadcastSignature(signature []byte, messageHash []byte) {
+func (bs *BroadcasterStub) BroadcastSignature(signature []byte, messageHash []byte, batchID uint64) {
 	if bs.BroadcastSignatureCalled != nil {
-		bs.BroadcastSignatureCalled(signature, messageHash)
+		bs.BroadcastSignatureCalled(signature, messageHash, batchID)
 	}
 }
 
@@ -0,0 +1,20 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// DryRunReportHandlerStub -
+type DryRunReportHandlerStub struct {
+	HandleDryRunReportCalled func(report *core.ScCallDryRunReport)
+}
+
+// HandleDryRunReport -
+func (stub *DryRunReportHandlerStub) HandleDryRunReport(report *core.ScCallDryRunReport) {
+	if stub.HandleDryRunReportCalled != nil {
+		stub.HandleDryRunReportCalled(report)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *DryRunReportHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
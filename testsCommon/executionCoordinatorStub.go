@@ -0,0 +1,20 @@
+package testsCommon
+
+// ExecutionCoordinatorStub -
+type ExecutionCoordinatorStub struct {
+	ShouldExecuteCalled func(id uint64) bool
+}
+
+// ShouldExecute -
+func (stub *ExecutionCoordinatorStub) ShouldExecute(id uint64) bool {
+	if stub.ShouldExecuteCalled != nil {
+		return stub.ShouldExecuteCalled(id)
+	}
+
+	return true
+}
+
+// IsInterfaceNil -
+func (stub *ExecutionCoordinatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
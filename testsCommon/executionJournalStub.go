@@ -0,0 +1,36 @@
+package testsCommon
+
+// ExecutionJournalStub -
+type ExecutionJournalStub struct {
+	ShouldExecuteCalled func(id uint64) bool
+	RecordAttemptCalled func(id uint64, txHash string, checkResults bool)
+	RecordResultCalled  func(id uint64, err error)
+}
+
+// ShouldExecute -
+func (stub *ExecutionJournalStub) ShouldExecute(id uint64) bool {
+	if stub.ShouldExecuteCalled != nil {
+		return stub.ShouldExecuteCalled(id)
+	}
+
+	return true
+}
+
+// RecordAttempt -
+func (stub *ExecutionJournalStub) RecordAttempt(id uint64, txHash string, checkResults bool) {
+	if stub.RecordAttemptCalled != nil {
+		stub.RecordAttemptCalled(id, txHash, checkResults)
+	}
+}
+
+// RecordResult -
+func (stub *ExecutionJournalStub) RecordResult(id uint64, err error) {
+	if stub.RecordResultCalled != nil {
+		stub.RecordResultCalled(id, err)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *ExecutionJournalStub) IsInterfaceNil() bool {
+	return stub == nil
+}
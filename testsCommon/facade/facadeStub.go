@@ -0,0 +1,62 @@
+package facade
+
+import (
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// FacadeStub -
+type FacadeStub struct {
+	GetMetricsCalled           func(name string) (core.GeneralMetrics, error)
+	GetMetricsListCalled       func() core.GeneralMetrics
+	GetPrometheusMetricsCalled func() string
+	RestApiInterfaceCalled     func() string
+	PprofEnabledCalled         func() bool
+}
+
+// GetMetrics -
+func (stub *FacadeStub) GetMetrics(name string) (core.GeneralMetrics, error) {
+	if stub.GetMetricsCalled != nil {
+		return stub.GetMetricsCalled(name)
+	}
+
+	return make(core.GeneralMetrics), nil
+}
+
+// GetMetricsList -
+func (stub *FacadeStub) GetMetricsList() core.GeneralMetrics {
+	if stub.GetMetricsListCalled != nil {
+		return stub.GetMetricsListCalled()
+	}
+
+	return make(core.GeneralMetrics)
+}
+
+// GetPrometheusMetrics -
+func (stub *FacadeStub) GetPrometheusMetrics() string {
+	if stub.GetPrometheusMetricsCalled != nil {
+		return stub.GetPrometheusMetricsCalled()
+	}
+
+	return ""
+}
+
+// RestApiInterface -
+func (stub *FacadeStub) RestApiInterface() string {
+	if stub.RestApiInterfaceCalled != nil {
+		return stub.RestApiInterfaceCalled()
+	}
+	return "localhost:8080"
+}
+
+// PprofEnabled -
+func (stub *FacadeStub) PprofEnabled() bool {
+	if stub.PprofEnabledCalled != nil {
+		return stub.PprofEnabledCalled()
+	}
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (stub *FacadeStub) IsInterfaceNil() bool {
+	return stub == nil
+}
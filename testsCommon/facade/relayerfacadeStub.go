@@ -6,10 +6,17 @@ import (
 
 // RelayerFacadeStub -
 type RelayerFacadeStub struct {
-	GetMetricsCalled       func(name string) (core.GeneralMetrics, error)
-	GetMetricsListCalled   func() core.GeneralMetrics
-	RestApiInterfaceCalled func() string
-	PprofEnabledCalled     func() bool
+	GetMetricsCalled           func(name string) (core.GeneralMetrics, error)
+	GetMetricsListCalled       func() core.GeneralMetrics
+	GetPrometheusMetricsCalled func() string
+	RestApiInterfaceCalled     func() string
+	PprofEnabledCalled         func() bool
+
+	GetMisbehaviourEvidenceCalled func() []*core.MisbehaviourEvidence
+	GetSignaturesProgressCalled   func() map[string]*core.SignaturesProgress
+	GetFeeAccountingReportCalled  func() *core.FeeAccountingReport
+	GetDailyFeesCSVCalled         func() ([]byte, error)
+	GetBatchTimelinesCalled       func() []*core.BatchTimeline
 }
 
 // GetMetrics -
@@ -30,6 +37,15 @@ func (stub *RelayerFacadeStub) GetMetricsList() core.GeneralMetrics {
 	return make(core.GeneralMetrics)
 }
 
+// GetPrometheusMetrics -
+func (stub *RelayerFacadeStub) GetPrometheusMetrics() string {
+	if stub.GetPrometheusMetricsCalled != nil {
+		return stub.GetPrometheusMetricsCalled()
+	}
+
+	return ""
+}
+
 // RestApiInterface -
 func (stub *RelayerFacadeStub) RestApiInterface() string {
 	if stub.RestApiInterfaceCalled != nil {
@@ -46,6 +62,51 @@ func (stub *RelayerFacadeStub) PprofEnabled() bool {
 	return false
 }
 
+// GetMisbehaviourEvidence -
+func (stub *RelayerFacadeStub) GetMisbehaviourEvidence() []*core.MisbehaviourEvidence {
+	if stub.GetMisbehaviourEvidenceCalled != nil {
+		return stub.GetMisbehaviourEvidenceCalled()
+	}
+
+	return make([]*core.MisbehaviourEvidence, 0)
+}
+
+// GetFeeAccountingReport -
+func (stub *RelayerFacadeStub) GetFeeAccountingReport() *core.FeeAccountingReport {
+	if stub.GetFeeAccountingReportCalled != nil {
+		return stub.GetFeeAccountingReportCalled()
+	}
+
+	return &core.FeeAccountingReport{}
+}
+
+// GetDailyFeesCSV -
+func (stub *RelayerFacadeStub) GetDailyFeesCSV() ([]byte, error) {
+	if stub.GetDailyFeesCSVCalled != nil {
+		return stub.GetDailyFeesCSVCalled()
+	}
+
+	return make([]byte, 0), nil
+}
+
+// GetBatchTimelines -
+func (stub *RelayerFacadeStub) GetBatchTimelines() []*core.BatchTimeline {
+	if stub.GetBatchTimelinesCalled != nil {
+		return stub.GetBatchTimelinesCalled()
+	}
+
+	return make([]*core.BatchTimeline, 0)
+}
+
+// GetSignaturesProgress -
+func (stub *RelayerFacadeStub) GetSignaturesProgress() map[string]*core.SignaturesProgress {
+	if stub.GetSignaturesProgressCalled != nil {
+		return stub.GetSignaturesProgressCalled()
+	}
+
+	return make(map[string]*core.SignaturesProgress)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (stub *RelayerFacadeStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -0,0 +1,67 @@
+package facade
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// ScCallsExecutorFacadeStub -
+type ScCallsExecutorFacadeStub struct {
+	FacadeStub
+	GetPendingOperationsCalled  func() []*core.ScCallPendingOperation
+	GetRecentExecutionsCalled   func() []*core.ScCallExecution
+	GetSendersStatusCalled      func(ctx context.Context) ([]*core.ScCallsSenderStatus, error)
+	GetQuarantinedCalled        func() []uint64
+	ReleaseFromQuarantineCalled func(request core.ScCallsQuarantineRelease) []uint64
+}
+
+// GetPendingOperations -
+func (stub *ScCallsExecutorFacadeStub) GetPendingOperations() []*core.ScCallPendingOperation {
+	if stub.GetPendingOperationsCalled != nil {
+		return stub.GetPendingOperationsCalled()
+	}
+
+	return make([]*core.ScCallPendingOperation, 0)
+}
+
+// GetRecentExecutions -
+func (stub *ScCallsExecutorFacadeStub) GetRecentExecutions() []*core.ScCallExecution {
+	if stub.GetRecentExecutionsCalled != nil {
+		return stub.GetRecentExecutionsCalled()
+	}
+
+	return make([]*core.ScCallExecution, 0)
+}
+
+// GetSendersStatus -
+func (stub *ScCallsExecutorFacadeStub) GetSendersStatus(ctx context.Context) ([]*core.ScCallsSenderStatus, error) {
+	if stub.GetSendersStatusCalled != nil {
+		return stub.GetSendersStatusCalled(ctx)
+	}
+
+	return make([]*core.ScCallsSenderStatus, 0), nil
+}
+
+// GetQuarantined -
+func (stub *ScCallsExecutorFacadeStub) GetQuarantined() []uint64 {
+	if stub.GetQuarantinedCalled != nil {
+		return stub.GetQuarantinedCalled()
+	}
+
+	return make([]uint64, 0)
+}
+
+// ReleaseFromQuarantine -
+func (stub *ScCallsExecutorFacadeStub) ReleaseFromQuarantine(request core.ScCallsQuarantineRelease) []uint64 {
+	if stub.ReleaseFromQuarantineCalled != nil {
+		return stub.ReleaseFromQuarantineCalled(request)
+	}
+
+	return make([]uint64, 0)
+}
+
+// IsInterfaceNil -
+func (stub *ScCallsExecutorFacadeStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,36 @@
+package testsCommon
+
+import (
+	"io"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// FeeAccountingProviderStub -
+type FeeAccountingProviderStub struct {
+	GetFeeAccountingReportCalled func() *core.FeeAccountingReport
+	WriteDailyFeesCSVCalled      func(writer io.Writer) error
+}
+
+// GetFeeAccountingReport -
+func (stub *FeeAccountingProviderStub) GetFeeAccountingReport() *core.FeeAccountingReport {
+	if stub.GetFeeAccountingReportCalled != nil {
+		return stub.GetFeeAccountingReportCalled()
+	}
+
+	return &core.FeeAccountingReport{}
+}
+
+// WriteDailyFeesCSV -
+func (stub *FeeAccountingProviderStub) WriteDailyFeesCSV(writer io.Writer) error {
+	if stub.WriteDailyFeesCSVCalled != nil {
+		return stub.WriteDailyFeesCSVCalled(writer)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *FeeAccountingProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -11,11 +11,15 @@ import (
 
 // BlockchainClientStub -
 type BlockchainClientStub struct {
-	BlockNumberCalled func(ctx context.Context) (uint64, error)
-	NonceAtCalled     func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
-	ChainIDCalled     func(ctx context.Context) (*big.Int, error)
-	BalanceAtCalled   func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
-	FilterLogsCalled  func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	BlockNumberCalled         func(ctx context.Context) (uint64, error)
+	NonceAtCalled             func(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
+	ChainIDCalled             func(ctx context.Context) (*big.Int, error)
+	BalanceAtCalled           func(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	FilterLogsCalled          func(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error)
+	PendingCallContractCalled func(ctx context.Context, call ethereum.CallMsg) ([]byte, error)
+	CallContractCalled        func(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+	TransactionReceiptCalled  func(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+	HeaderByNumberCalled      func(ctx context.Context, number *big.Int) (*types.Header, error)
 }
 
 // BlockNumber -
@@ -63,7 +67,43 @@ func (bcs *BlockchainClientStub) FilterLogs(ctx context.Context, q ethereum.Filt
 	return nil, nil
 }
 
+// TransactionReceipt -
+func (bcs *BlockchainClientStub) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	if bcs.TransactionReceiptCalled != nil {
+		return bcs.TransactionReceiptCalled(ctx, txHash)
+	}
+
+	return nil, nil
+}
+
+// HeaderByNumber -
+func (bcs *BlockchainClientStub) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	if bcs.HeaderByNumberCalled != nil {
+		return bcs.HeaderByNumberCalled(ctx, number)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (bcs *BlockchainClientStub) IsInterfaceNil() bool {
 	return bcs == nil
 }
+
+// PendingCallContract -
+func (bcs *BlockchainClientStub) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	if bcs.PendingCallContractCalled != nil {
+		return bcs.PendingCallContractCalled(ctx, call)
+	}
+
+	return nil, nil
+}
+
+// CallContract -
+func (bcs *BlockchainClientStub) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if bcs.CallContractCalled != nil {
+		return bcs.CallContractCalled(ctx, call, blockNumber)
+	}
+
+	return nil, nil
+}
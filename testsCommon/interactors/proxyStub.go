@@ -3,6 +3,7 @@ package interactors
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -22,6 +23,8 @@ type ProxyStub struct {
 	GetESDTTokenDataCalled              func(ctx context.Context, address core.AddressHandler, tokenIdentifier string, queryOptions api.AccountQueryOptions) (*data.ESDTFungibleTokenData, error)
 	GetTransactionInfoWithResultsCalled func(_ context.Context, _ string) (*data.TransactionInfo, error)
 	ProcessTransactionStatusCalled      func(ctx context.Context, hexTxHash string) (transaction.TxStatus, error)
+	RequestTransactionCostCalled        func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
+	GetHTTPCalled                       func(ctx context.Context, endpoint string) ([]byte, int, error)
 }
 
 // GetNetworkConfig -
@@ -44,7 +47,7 @@ func (eps *ProxyStub) SendTransaction(ctx context.Context, transaction *transact
 
 // SendTransactions -
 func (eps *ProxyStub) SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error) {
-	if eps.SendTransactionCalled != nil {
+	if eps.SendTransactionsCalled != nil {
 		return eps.SendTransactionsCalled(ctx, txs)
 	}
 
@@ -114,6 +117,24 @@ func (eps *ProxyStub) ProcessTransactionStatus(ctx context.Context, hexTxHash st
 	return "", nil
 }
 
+// GetHTTP -
+func (eps *ProxyStub) GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error) {
+	if eps.GetHTTPCalled != nil {
+		return eps.GetHTTPCalled(ctx, endpoint)
+	}
+
+	return nil, http.StatusOK, nil
+}
+
+// RequestTransactionCost -
+func (eps *ProxyStub) RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+	if eps.RequestTransactionCostCalled != nil {
+		return eps.RequestTransactionCostCalled(ctx, tx)
+	}
+
+	return nil, fmt.Errorf("not implemented")
+}
+
 // IsInterfaceNil -
 func (eps *ProxyStub) IsInterfaceNil() bool {
 	return eps == nil
@@ -0,0 +1,28 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// LatencyRecorderStub -
+type LatencyRecorderStub struct {
+	RecordBatchCreationCalled func(direction string, batchID uint64, blockNumber uint64)
+	RecordStepCalled          func(direction string, batchID uint64, step core.TransferStep)
+}
+
+// RecordBatchCreation -
+func (stub *LatencyRecorderStub) RecordBatchCreation(direction string, batchID uint64, blockNumber uint64) {
+	if stub.RecordBatchCreationCalled != nil {
+		stub.RecordBatchCreationCalled(direction, batchID, blockNumber)
+	}
+}
+
+// RecordStep -
+func (stub *LatencyRecorderStub) RecordStep(direction string, batchID uint64, step core.TransferStep) {
+	if stub.RecordStepCalled != nil {
+		stub.RecordStepCalled(direction, batchID, step)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *LatencyRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
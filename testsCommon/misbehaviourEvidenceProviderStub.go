@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// MisbehaviourEvidenceProviderStub -
+type MisbehaviourEvidenceProviderStub struct {
+	GetAllEvidenceCalled func() []*core.MisbehaviourEvidence
+}
+
+// GetAllEvidence -
+func (stub *MisbehaviourEvidenceProviderStub) GetAllEvidence() []*core.MisbehaviourEvidence {
+	if stub.GetAllEvidenceCalled != nil {
+		return stub.GetAllEvidenceCalled()
+	}
+
+	return make([]*core.MisbehaviourEvidence, 0)
+}
+
+// IsInterfaceNil -
+func (stub *MisbehaviourEvidenceProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,31 @@
+package p2p
+
+import (
+	"github.com/multiversx/mx-bridge-eth-go/core"
+	chainCore "github.com/multiversx/mx-chain-core-go/core"
+)
+
+// MisbehaviourDetectorStub -
+type MisbehaviourDetectorStub struct {
+	ProcessSignatureCalled      func(msg *core.SignedMessage, ethMsg *core.EthereumSignature, peerID chainCore.PeerID)
+	ProcessLocalSignatureCalled func(msg *core.SignedMessage, ethMsg *core.EthereumSignature)
+}
+
+// ProcessSignature -
+func (stub *MisbehaviourDetectorStub) ProcessSignature(msg *core.SignedMessage, ethMsg *core.EthereumSignature, peerID chainCore.PeerID) {
+	if stub.ProcessSignatureCalled != nil {
+		stub.ProcessSignatureCalled(msg, ethMsg, peerID)
+	}
+}
+
+// ProcessLocalSignature -
+func (stub *MisbehaviourDetectorStub) ProcessLocalSignature(msg *core.SignedMessage, ethMsg *core.EthereumSignature) {
+	if stub.ProcessLocalSignatureCalled != nil {
+		stub.ProcessLocalSignatureCalled(msg, ethMsg)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *MisbehaviourDetectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,35 @@
+package testsCommon
+
+import (
+	"context"
+	"math/big"
+)
+
+// RelayerFundsHandlerStub -
+type RelayerFundsHandlerStub struct {
+	GetRelayerBalanceCalled func(ctx context.Context) (*big.Int, error)
+	EstimateBatchFeeCalled  func(ctx context.Context, numDeposits uint64) (*big.Int, error)
+}
+
+// GetRelayerBalance -
+func (stub *RelayerFundsHandlerStub) GetRelayerBalance(ctx context.Context) (*big.Int, error) {
+	if stub.GetRelayerBalanceCalled != nil {
+		return stub.GetRelayerBalanceCalled(ctx)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// EstimateBatchFee -
+func (stub *RelayerFundsHandlerStub) EstimateBatchFee(ctx context.Context, numDeposits uint64) (*big.Int, error) {
+	if stub.EstimateBatchFeeCalled != nil {
+		return stub.EstimateBatchFeeCalled(ctx, numDeposits)
+	}
+
+	return big.NewInt(0), nil
+}
+
+// IsInterfaceNil -
+func (stub *RelayerFundsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
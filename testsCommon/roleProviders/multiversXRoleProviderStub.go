@@ -4,7 +4,8 @@ import "github.com/multiversx/mx-sdk-go/core"
 
 // MultiversXRoleProviderStub -
 type MultiversXRoleProviderStub struct {
-	IsWhitelistedCalled func(address core.AddressHandler) bool
+	IsWhitelistedCalled    func(address core.AddressHandler) bool
+	SortedPublicKeysCalled func() [][]byte
 }
 
 // IsWhitelisted -
@@ -16,6 +17,15 @@ func (stub *MultiversXRoleProviderStub) IsWhitelisted(address core.AddressHandle
 	return true
 }
 
+// SortedPublicKeys -
+func (stub *MultiversXRoleProviderStub) SortedPublicKeys() [][]byte {
+	if stub.SortedPublicKeysCalled != nil {
+		return stub.SortedPublicKeysCalled()
+	}
+
+	return make([][]byte, 0)
+}
+
 // IsInterfaceNil -
 func (stub *MultiversXRoleProviderStub) IsInterfaceNil() bool {
 	return stub == nil
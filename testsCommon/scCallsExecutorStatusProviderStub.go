@@ -0,0 +1,66 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-bridge-eth-go/core"
+)
+
+// ScCallsExecutorStatusProviderStub -
+type ScCallsExecutorStatusProviderStub struct {
+	GetPendingOperationsCalled  func() []*core.ScCallPendingOperation
+	GetRecentExecutionsCalled   func() []*core.ScCallExecution
+	GetSendersStatusCalled      func(ctx context.Context) ([]*core.ScCallsSenderStatus, error)
+	GetQuarantinedCalled        func() []uint64
+	ReleaseFromQuarantineCalled func(ids []uint64) []uint64
+}
+
+// GetPendingOperations -
+func (stub *ScCallsExecutorStatusProviderStub) GetPendingOperations() []*core.ScCallPendingOperation {
+	if stub.GetPendingOperationsCalled != nil {
+		return stub.GetPendingOperationsCalled()
+	}
+
+	return make([]*core.ScCallPendingOperation, 0)
+}
+
+// GetRecentExecutions -
+func (stub *ScCallsExecutorStatusProviderStub) GetRecentExecutions() []*core.ScCallExecution {
+	if stub.GetRecentExecutionsCalled != nil {
+		return stub.GetRecentExecutionsCalled()
+	}
+
+	return make([]*core.ScCallExecution, 0)
+}
+
+// GetSendersStatus -
+func (stub *ScCallsExecutorStatusProviderStub) GetSendersStatus(ctx context.Context) ([]*core.ScCallsSenderStatus, error) {
+	if stub.GetSendersStatusCalled != nil {
+		return stub.GetSendersStatusCalled(ctx)
+	}
+
+	return make([]*core.ScCallsSenderStatus, 0), nil
+}
+
+// GetQuarantined -
+func (stub *ScCallsExecutorStatusProviderStub) GetQuarantined() []uint64 {
+	if stub.GetQuarantinedCalled != nil {
+		return stub.GetQuarantinedCalled()
+	}
+
+	return make([]uint64, 0)
+}
+
+// ReleaseFromQuarantine -
+func (stub *ScCallsExecutorStatusProviderStub) ReleaseFromQuarantine(ids []uint64) []uint64 {
+	if stub.ReleaseFromQuarantineCalled != nil {
+		return stub.ReleaseFromQuarantineCalled(ids)
+	}
+
+	return make([]uint64, 0)
+}
+
+// IsInterfaceNil -
+func (stub *ScCallsExecutorStatusProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -73,6 +73,20 @@ func (mock *SignaturesHolderMock) ClearStoredSignatures() {
 	mock.ethMessages = make([]*core.EthereumSignature, 0)
 }
 
+// PruneExecutedBatch -
+func (mock *SignaturesHolderMock) PruneExecutedBatch(batchID uint64) {
+	mock.mut.Lock()
+	defer mock.mut.Unlock()
+
+	ethMessages := make([]*core.EthereumSignature, 0, len(mock.ethMessages))
+	for _, ethMsg := range mock.ethMessages {
+		if ethMsg.BatchId == 0 || ethMsg.BatchId > batchID {
+			ethMessages = append(ethMessages, ethMsg)
+		}
+	}
+	mock.ethMessages = ethMessages
+}
+
 // IsInterfaceNil -
 func (mock *SignaturesHolderMock) IsInterfaceNil() bool {
 	return mock == nil
@@ -4,6 +4,7 @@ package testsCommon
 type SignaturesHolderStub struct {
 	SignaturesCalled            func(messageHash []byte) [][]byte
 	ClearStoredSignaturesCalled func()
+	PruneExecutedBatchCalled    func(batchID uint64)
 }
 
 // Signatures -
@@ -22,6 +23,13 @@ func (stub *SignaturesHolderStub) ClearStoredSignatures() {
 	}
 }
 
+// PruneExecutedBatch -
+func (stub *SignaturesHolderStub) PruneExecutedBatch(batchID uint64) {
+	if stub.PruneExecutedBatchCalled != nil {
+		stub.PruneExecutedBatchCalled(batchID)
+	}
+}
+
 // IsInterfaceNil -
 func (stub *SignaturesHolderStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -41,6 +41,16 @@ func (sm *StorerMock) Get(key []byte) ([]byte, error) {
 	return val, nil
 }
 
+// Remove -
+func (sm *StorerMock) Remove(key []byte) error {
+	sm.mut.Lock()
+	defer sm.mut.Unlock()
+
+	delete(sm.data, string(key))
+
+	return nil
+}
+
 // Close -
 func (sm *StorerMock) Close() error {
 	return nil
@@ -0,0 +1,25 @@
+package testsCommon
+
+import (
+	"context"
+	"math/big"
+)
+
+// TransactionCostResolverStub -
+type TransactionCostResolverStub struct {
+	GetTransactionCostCalled func(ctx context.Context, txHash string) (uint64, *big.Int, error)
+}
+
+// GetTransactionCost -
+func (stub *TransactionCostResolverStub) GetTransactionCost(ctx context.Context, txHash string) (uint64, *big.Int, error) {
+	if stub.GetTransactionCostCalled != nil {
+		return stub.GetTransactionCostCalled(ctx, txHash)
+	}
+
+	return 0, big.NewInt(0), nil
+}
+
+// IsInterfaceNil -
+func (stub *TransactionCostResolverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+import "github.com/multiversx/mx-bridge-eth-go/core"
+
+// TransactionsRecorderStub -
+type TransactionsRecorderStub struct {
+	RecordTransactionCalled func(batchID uint64, direction string, action core.RelayerTxAction, txHash string)
+}
+
+// RecordTransaction -
+func (stub *TransactionsRecorderStub) RecordTransaction(batchID uint64, direction string, action core.RelayerTxAction, txHash string) {
+	if stub.RecordTransactionCalled != nil {
+		stub.RecordTransactionCalled(batchID, direction, action, txHash)
+	}
+}
+
+// IsInterfaceNil -
+func (stub *TransactionsRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -12,6 +12,7 @@ type TxNonceHandlerV2Stub struct {
 	ApplyNonceAndGasPriceCalled func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error
 	SendTransactionCalled       func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error)
 	ForceNonceReFetchCalled     func(address core.AddressHandler) error
+	DropTransactionsCalled      func(address core.AddressHandler) error
 	CloseCalled                 func() error
 }
 
@@ -33,6 +34,15 @@ func (stub *TxNonceHandlerV2Stub) SendTransaction(ctx context.Context, tx *trans
 	return "", nil
 }
 
+// DropTransactions -
+func (stub *TxNonceHandlerV2Stub) DropTransactions(address core.AddressHandler) error {
+	if stub.DropTransactionsCalled != nil {
+		return stub.DropTransactionsCalled(address)
+	}
+
+	return nil
+}
+
 // Close -
 func (stub *TxNonceHandlerV2Stub) Close() error {
 	if stub.CloseCalled != nil {